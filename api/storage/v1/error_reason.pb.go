@@ -24,25 +24,46 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_INTERNAL_ERROR    ErrorReason = 0
-	ErrorReason_VALIDATION_FAILED ErrorReason = 1
-	ErrorReason_UNAUTHORIZED      ErrorReason = 2
-	ErrorReason_ACCESS_DENIED     ErrorReason = 3
+	ErrorReason_INTERNAL_ERROR        ErrorReason = 0
+	ErrorReason_VALIDATION_FAILED     ErrorReason = 1
+	ErrorReason_UNAUTHORIZED          ErrorReason = 2
+	ErrorReason_ACCESS_DENIED         ErrorReason = 3
+	ErrorReason_NOT_FOUND             ErrorReason = 4
+	ErrorReason_CONFLICT              ErrorReason = 5
+	ErrorReason_RANGE_NOT_SATISFIABLE ErrorReason = 6
+	ErrorReason_GONE                  ErrorReason = 7
+	ErrorReason_QUOTA_EXCEEDED        ErrorReason = 8
+	ErrorReason_PAYLOAD_TOO_LARGE     ErrorReason = 9
+	ErrorReason_QUARANTINED           ErrorReason = 10
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "INTERNAL_ERROR",
-		1: "VALIDATION_FAILED",
-		2: "UNAUTHORIZED",
-		3: "ACCESS_DENIED",
+		0:  "INTERNAL_ERROR",
+		1:  "VALIDATION_FAILED",
+		2:  "UNAUTHORIZED",
+		3:  "ACCESS_DENIED",
+		4:  "NOT_FOUND",
+		5:  "CONFLICT",
+		6:  "RANGE_NOT_SATISFIABLE",
+		7:  "GONE",
+		8:  "QUOTA_EXCEEDED",
+		9:  "PAYLOAD_TOO_LARGE",
+		10: "QUARANTINED",
 	}
 	ErrorReason_value = map[string]int32{
-		"INTERNAL_ERROR":    0,
-		"VALIDATION_FAILED": 1,
-		"UNAUTHORIZED":      2,
-		"ACCESS_DENIED":     3,
+		"INTERNAL_ERROR":        0,
+		"VALIDATION_FAILED":     1,
+		"UNAUTHORIZED":          2,
+		"ACCESS_DENIED":         3,
+		"NOT_FOUND":             4,
+		"CONFLICT":              5,
+		"RANGE_NOT_SATISFIABLE": 6,
+		"GONE":                  7,
+		"QUOTA_EXCEEDED":        8,
+		"PAYLOAD_TOO_LARGE":     9,
+		"QUARANTINED":           10,
 	}
 )
 
@@ -80,17 +101,27 @@ var file_storage_v1_error_reason_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0x7b, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
-	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48,
-	0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x17,
-	0x0a, 0x0d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10,
-	0x03, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1b, 0x5a,
-	0x19, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2a, 0xa3, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12,
+	0x17, 0x0a, 0x0d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
+	0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x13, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x12, 0x0a,
+	0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x99,
+	0x03, 0x12, 0x1f, 0x0a, 0x15, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53,
+	0x41, 0x54, 0x49, 0x53, 0x46, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45,
+	0xa0, 0x03, 0x12, 0x0e, 0x0a, 0x04, 0x47, 0x4f, 0x4e, 0x45, 0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45,
+	0x9a, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xfb, 0x03, 0x12, 0x1b, 0x0a, 0x11,
+	0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47,
+	0x45, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x9d, 0x03, 0x12, 0x15, 0x0a, 0x0b, 0x51, 0x55, 0x41,
+	0x52, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0xa7, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1b, 0x5a, 0x19, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
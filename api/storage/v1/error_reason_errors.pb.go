@@ -58,3 +58,87 @@ func IsAccessDenied(err error) bool {
 func ErrorAccessDenied(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, ErrorReason_ACCESS_DENIED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRangeNotSatisfiable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RANGE_NOT_SATISFIABLE.String() && e.Code == 416
+}
+
+func ErrorRangeNotSatisfiable(format string, args ...interface{}) *errors.Error {
+	return errors.New(416, ErrorReason_RANGE_NOT_SATISFIABLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGone(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GONE.String() && e.Code == 410
+}
+
+func ErrorGone(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, ErrorReason_GONE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_QUOTA_EXCEEDED.String() && e.Code == 507
+}
+
+func ErrorQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(507, ErrorReason_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPayloadTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PAYLOAD_TOO_LARGE.String() && e.Code == 413
+}
+
+func ErrorPayloadTooLarge(format string, args ...interface{}) *errors.Error {
+	return errors.New(413, ErrorReason_PAYLOAD_TOO_LARGE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsQuarantined(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_QUARANTINED.String() && e.Code == 423
+}
+
+func ErrorQuarantined(format string, args ...interface{}) *errors.Error {
+	return errors.New(423, ErrorReason_QUARANTINED.String(), fmt.Sprintf(format, args...))
+}
@@ -19,9 +19,12 @@ import (
 	"github.com/phlx-ru/hatchet/runtime"
 	"github.com/phlx-ru/hatchet/sentry"
 
+	"storage/internal/biz"
 	"storage/internal/clients/auth"
+	"storage/internal/clients/clamd"
 	"storage/internal/clients/minio"
 	"storage/internal/conf"
+	"storage/internal/server"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -34,6 +37,10 @@ var (
 	flagconf string
 	// dotenv is loaded from config path .env file
 	dotenv string
+	// migrateKeys runs migration of object keys to configured layout instead of server
+	migrateKeys bool
+	// dryRun only reports what migration would change
+	dryRun bool
 
 	id, _ = os.Hostname()
 )
@@ -41,6 +48,8 @@ var (
 func init() {
 	flag.StringVar(&flagconf, "conf", "./configs", "config path, eg: -conf config.yaml")
 	flag.StringVar(&dotenv, "dotenv", ".env", ".env file, eg: -dotenv .env")
+	flag.BoolVar(&migrateKeys, "migrate-keys", false, "rewrite object keys of stored files by configured layout and exit")
+	flag.BoolVar(&dryRun, "dry-run", false, "report files which keys would be rewritten by -migrate-keys without changes")
 }
 
 func main() {
@@ -120,13 +129,34 @@ func run() error {
 		return err
 	}
 
-	s3 := selectS3Config(bc.S3)
-	minioClient, err := minio.New(s3.Endpoint, s3.BucketLocation, s3.BucketName, s3.AccessKeyID, s3.SecretAccessKey, metric, logs)
+	minioClient, err := newMinioClient(selectS3Config(bc.S3, bc.Storage), metric, logs)
 	if err != nil {
 		return err
 	}
 
-	app, err := wireApp(ctx, database, bc.Server, bc.Auth, authClient, minioClient, metric, logs)
+	scanner, err := newScanner(bc.Clamd, metric, logs)
+	if err != nil {
+		return err
+	}
+
+	if migrateKeys {
+		usecase := wireStorageUsecase(database, bc.Auth, bc.Storage, bc.Policy, authClient, minioClient, scanner, metric, logs)
+		return migrateObjectKeys(ctx, usecase, !dryRun, logs)
+	}
+
+	app, err := wireApp(
+		ctx,
+		database,
+		bc.Server,
+		bc.Auth,
+		bc.Storage,
+		bc.Policy,
+		authClient,
+		minioClient,
+		scanner,
+		metric,
+		logs,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -135,6 +165,8 @@ func run() error {
 }
 
 type S3Config struct {
+	Local           bool
+	LocalPath       string
 	Endpoint        string
 	BucketLocation  string
 	BucketName      string
@@ -142,8 +174,13 @@ type S3Config struct {
 	SecretAccessKey string
 }
 
-func selectS3Config(s3 *conf.S3) *S3Config {
+func selectS3Config(s3 *conf.S3, storage *conf.Storage) *S3Config {
 	switch s3.Current {
+	case `local`:
+		return &S3Config{
+			Local:     true,
+			LocalPath: storage.Path,
+		}
 	case `vk`:
 		return &S3Config{
 			Endpoint:        s3.Vk.Endpoint,
@@ -164,7 +201,47 @@ func selectS3Config(s3 *conf.S3) *S3Config {
 	panic(fmt.Sprintf(`unknown s3 current value: %s`, s3.Current))
 }
 
-func newApp(ctx context.Context, logger log.Logger, hs *http.Server) *kratos.App {
+func newMinioClient(s3 *S3Config, metric metrics.Metrics, logs log.Logger) (minio.Client, error) {
+	if s3.Local {
+		return minio.NewLocal(s3.LocalPath, metric, logs)
+	}
+	return minio.New(s3.Endpoint, s3.BucketLocation, s3.BucketName, s3.AccessKeyID, s3.SecretAccessKey, metric, logs)
+}
+
+// newScanner makes clamd client which scans uploaded files, nil client disables scanning
+func newScanner(c *conf.Clamd, metric metrics.Metrics, logs log.Logger) (clamd.Client, error) {
+	if !c.GetEnabled() {
+		return nil, nil
+	}
+	return clamd.New(c.GetNetwork(), c.GetAddress(), c.GetTimeout().AsDuration(), metric, logs)
+}
+
+// migrateObjectKeys moves objects of stored files to keys of configured layout
+func migrateObjectKeys(ctx context.Context, usecase *biz.StorageUsecase, apply bool, logs log.Logger) error {
+	report, err := usecase.MigrateObjectKeys(ctx, apply)
+	if err != nil {
+		return err
+	}
+	logger.NewHelper(logs).Infof(
+		`object keys are migrated (applied: %t): migrated files %d, failed files %v`,
+		report.Applied,
+		len(report.Migrated),
+		report.Failed,
+	)
+	if len(report.Failed) > 0 {
+		return fmt.Errorf(`object keys of %d files are not migrated`, len(report.Failed))
+	}
+	return nil
+}
+
+func newApp(
+	ctx context.Context,
+	logger log.Logger,
+	hs *http.Server,
+	rs *server.ReconcileServer,
+	ps *server.PurgeServer,
+	ss *server.ScanServer,
+) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -174,6 +251,9 @@ func newApp(ctx context.Context, logger log.Logger, hs *http.Server) *kratos.App
 		kratos.Context(ctx),
 		kratos.Server(
 			hs,
+			rs,
+			ps,
+			ss,
 		),
 	)
 }
@@ -14,6 +14,7 @@ import (
 
 	"storage/internal/biz"
 	"storage/internal/clients/auth"
+	"storage/internal/clients/clamd"
 	"storage/internal/clients/minio"
 	"storage/internal/conf"
 	"storage/internal/data"
@@ -26,14 +27,40 @@ func wireData(*conf.Data, log.Logger) (data.Database, func(), error) {
 	panic(wire.Build(data.ProviderDataSet))
 }
 
+// wireStorageUsecase init storage usecase for commands which run without servers
+func wireStorageUsecase(
+	data.Database,
+	*conf.Auth,
+	*conf.Storage,
+	*conf.Policy,
+	auth.Client,
+	minio.Client,
+	clamd.Client,
+	metrics.Metrics,
+	log.Logger,
+) *biz.StorageUsecase {
+	panic(wire.Build(
+		data.ProviderRepoSet,
+		biz.ProviderSet,
+
+		biz.BindFileRepository,
+		biz.BindMultipartRepository,
+		biz.BindBlobRepository,
+		biz.BindShareLinkRepository,
+	))
+}
+
 // wireApp init kratos application.
 func wireApp(
 	context.Context,
 	data.Database,
 	*conf.Server,
 	*conf.Auth,
+	*conf.Storage,
+	*conf.Policy,
 	auth.Client,
 	minio.Client,
+	clamd.Client,
 	metrics.Metrics,
 	log.Logger,
 ) (
@@ -48,5 +75,8 @@ func wireApp(
 		newApp,
 
 		biz.BindFileRepository,
+		biz.BindMultipartRepository,
+		biz.BindBlobRepository,
+		biz.BindShareLinkRepository,
 	))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/phlx-ru/hatchet/metrics"
 	"storage/internal/biz"
 	"storage/internal/clients/auth"
+	"storage/internal/clients/clamd"
 	"storage/internal/clients/minio"
 	"storage/internal/conf"
 	"storage/internal/data"
@@ -33,12 +34,28 @@ func wireData(confData *conf.Data, logger log.Logger) (data.Database, func(), er
 	}, nil
 }
 
+// wireStorageUsecase init storage usecase for commands which run without servers
+func wireStorageUsecase(database data.Database, confAuth *conf.Auth, storage *conf.Storage, policy *conf.Policy, client auth.Client, minioClient minio.Client, clamdClient clamd.Client, metricsMetrics metrics.Metrics, logger log.Logger) *biz.StorageUsecase {
+	fileRepo := data.NewFileRepo(database, logger, metricsMetrics)
+	multipartRepo := data.NewMultipartRepo(database, logger, metricsMetrics)
+	blobRepo := data.NewBlobRepo(database, logger, metricsMetrics)
+	shareLinkRepo := data.NewShareLinkRepo(database, logger, metricsMetrics)
+	storageUsecase := biz.NewStorageUsecase(client, minioClient, clamdClient, fileRepo, multipartRepo, blobRepo, shareLinkRepo, confAuth, storage, policy, metricsMetrics, logger)
+	return storageUsecase
+}
+
 // wireApp init kratos application.
-func wireApp(contextContext context.Context, database data.Database, confServer *conf.Server, confAuth *conf.Auth, client auth.Client, minioClient minio.Client, metricsMetrics metrics.Metrics, logger log.Logger) (*kratos.App, error) {
+func wireApp(contextContext context.Context, database data.Database, confServer *conf.Server, confAuth *conf.Auth, storage *conf.Storage, policy *conf.Policy, client auth.Client, minioClient minio.Client, clamdClient clamd.Client, metricsMetrics metrics.Metrics, logger log.Logger) (*kratos.App, error) {
 	fileRepo := data.NewFileRepo(database, logger, metricsMetrics)
-	storageUsecase := biz.NewStorageUsecase(client, minioClient, fileRepo, confAuth, metricsMetrics, logger)
+	multipartRepo := data.NewMultipartRepo(database, logger, metricsMetrics)
+	blobRepo := data.NewBlobRepo(database, logger, metricsMetrics)
+	shareLinkRepo := data.NewShareLinkRepo(database, logger, metricsMetrics)
+	storageUsecase := biz.NewStorageUsecase(client, minioClient, clamdClient, fileRepo, multipartRepo, blobRepo, shareLinkRepo, confAuth, storage, policy, metricsMetrics, logger)
 	storageService := service.NewGatewayService(storageUsecase, metricsMetrics, logger)
 	httpServer := server.NewHTTPServer(confServer, storageService, metricsMetrics)
-	app := newApp(contextContext, logger, httpServer)
+	reconcileServer := server.NewReconcileServer(storage, storageUsecase, logger)
+	purgeServer := server.NewPurgeServer(storage, storageUsecase, logger)
+	scanServer := server.NewScanServer(storage, storageUsecase, logger)
+	app := newApp(contextContext, logger, httpServer, reconcileServer, purgeServer, scanServer)
 	return app, nil
 }
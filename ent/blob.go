@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"storage/ent/blob"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Blob is the model entity for the Blob schema.
+type Blob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// hex encoded sha-256 checksum of content, object path is derived from it
+	Sha256 string `json:"sha256,omitempty"`
+	// path to content object in s3 storage
+	ObjectPath string `json:"object_path,omitempty"`
+	// size of content in bytes
+	Size int `json:"size,omitempty"`
+	// etag of content object in s3 storage
+	Etag string `json:"etag,omitempty"`
+	// count of files referencing the blob, object is removed with the last reference
+	RefCount int `json:"ref_count,omitempty"`
+	// creation time of blob
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of blob
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Blob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldID, blob.FieldSize, blob.FieldRefCount:
+			values[i] = new(sql.NullInt64)
+		case blob.FieldSha256, blob.FieldObjectPath, blob.FieldEtag:
+			values[i] = new(sql.NullString)
+		case blob.FieldCreatedAt, blob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Blob", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Blob fields.
+func (b *Blob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case blob.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				b.Sha256 = value.String
+			}
+		case blob.FieldObjectPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_path", values[i])
+			} else if value.Valid {
+				b.ObjectPath = value.String
+			}
+		case blob.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				b.Size = int(value.Int64)
+			}
+		case blob.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				b.Etag = value.String
+			}
+		case blob.FieldRefCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ref_count", values[i])
+			} else if value.Valid {
+				b.RefCount = int(value.Int64)
+			}
+		case blob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case blob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Blob.
+// Note that you need to call Blob.Unwrap() before calling this method if this Blob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Blob) Update() *BlobUpdateOne {
+	return NewBlobClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Blob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Blob) Unwrap() *Blob {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Blob is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Blob) String() string {
+	var builder strings.Builder
+	builder.WriteString("Blob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("sha256=")
+	builder.WriteString(b.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("object_path=")
+	builder.WriteString(b.ObjectPath)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", b.Size))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(b.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("ref_count=")
+	builder.WriteString(fmt.Sprintf("%v", b.RefCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blobs is a parsable slice of Blob.
+type Blobs []*Blob
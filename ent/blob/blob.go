@@ -0,0 +1,65 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the blob type in the database.
+	Label = "blob"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldObjectPath holds the string denoting the object_path field in the database.
+	FieldObjectPath = "object_path"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
+	// FieldRefCount holds the string denoting the ref_count field in the database.
+	FieldRefCount = "ref_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the blob in the database.
+	Table = "blobs"
+)
+
+// Columns holds all SQL columns for blob fields.
+var Columns = []string{
+	FieldID,
+	FieldSha256,
+	FieldObjectPath,
+	FieldSize,
+	FieldEtag,
+	FieldRefCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag string
+	// DefaultRefCount holds the default value on creation for the "ref_count" field.
+	DefaultRefCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
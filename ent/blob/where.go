@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldID, id))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSha256, v))
+}
+
+// ObjectPath applies equality check predicate on the "object_path" field. It's identical to ObjectPathEQ.
+func ObjectPath(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldObjectPath, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldEtag, v))
+}
+
+// RefCount applies equality check predicate on the "ref_count" field. It's identical to RefCountEQ.
+func RefCount(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// ObjectPathEQ applies the EQ predicate on the "object_path" field.
+func ObjectPathEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldObjectPath, v))
+}
+
+// ObjectPathNEQ applies the NEQ predicate on the "object_path" field.
+func ObjectPathNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldObjectPath, v))
+}
+
+// ObjectPathIn applies the In predicate on the "object_path" field.
+func ObjectPathIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldObjectPath, vs...))
+}
+
+// ObjectPathNotIn applies the NotIn predicate on the "object_path" field.
+func ObjectPathNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldObjectPath, vs...))
+}
+
+// ObjectPathGT applies the GT predicate on the "object_path" field.
+func ObjectPathGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldObjectPath, v))
+}
+
+// ObjectPathGTE applies the GTE predicate on the "object_path" field.
+func ObjectPathGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldObjectPath, v))
+}
+
+// ObjectPathLT applies the LT predicate on the "object_path" field.
+func ObjectPathLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldObjectPath, v))
+}
+
+// ObjectPathLTE applies the LTE predicate on the "object_path" field.
+func ObjectPathLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldObjectPath, v))
+}
+
+// ObjectPathContains applies the Contains predicate on the "object_path" field.
+func ObjectPathContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldObjectPath, v))
+}
+
+// ObjectPathHasPrefix applies the HasPrefix predicate on the "object_path" field.
+func ObjectPathHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldObjectPath, v))
+}
+
+// ObjectPathHasSuffix applies the HasSuffix predicate on the "object_path" field.
+func ObjectPathHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldObjectPath, v))
+}
+
+// ObjectPathEqualFold applies the EqualFold predicate on the "object_path" field.
+func ObjectPathEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldObjectPath, v))
+}
+
+// ObjectPathContainsFold applies the ContainsFold predicate on the "object_path" field.
+func ObjectPathContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldObjectPath, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldSize, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Blob {
+	return predicate.Blob(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Blob {
+	return predicate.Blob(sql.FieldNotNull(FieldEtag))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// RefCountEQ applies the EQ predicate on the "ref_count" field.
+func RefCountEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// RefCountNEQ applies the NEQ predicate on the "ref_count" field.
+func RefCountNEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldRefCount, v))
+}
+
+// RefCountIn applies the In predicate on the "ref_count" field.
+func RefCountIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldRefCount, vs...))
+}
+
+// RefCountNotIn applies the NotIn predicate on the "ref_count" field.
+func RefCountNotIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldRefCount, vs...))
+}
+
+// RefCountGT applies the GT predicate on the "ref_count" field.
+func RefCountGT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldRefCount, v))
+}
+
+// RefCountGTE applies the GTE predicate on the "ref_count" field.
+func RefCountGTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldRefCount, v))
+}
+
+// RefCountLT applies the LT predicate on the "ref_count" field.
+func RefCountLT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldRefCount, v))
+}
+
+// RefCountLTE applies the LTE predicate on the "ref_count" field.
+func RefCountLTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldRefCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Blob) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
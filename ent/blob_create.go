@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/blob"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlobCreate is the builder for creating a Blob entity.
+type BlobCreate struct {
+	config
+	mutation *BlobMutation
+	hooks    []Hook
+}
+
+// SetSha256 sets the "sha256" field.
+func (bc *BlobCreate) SetSha256(s string) *BlobCreate {
+	bc.mutation.SetSha256(s)
+	return bc
+}
+
+// SetObjectPath sets the "object_path" field.
+func (bc *BlobCreate) SetObjectPath(s string) *BlobCreate {
+	bc.mutation.SetObjectPath(s)
+	return bc
+}
+
+// SetSize sets the "size" field.
+func (bc *BlobCreate) SetSize(i int) *BlobCreate {
+	bc.mutation.SetSize(i)
+	return bc
+}
+
+// SetEtag sets the "etag" field.
+func (bc *BlobCreate) SetEtag(s string) *BlobCreate {
+	bc.mutation.SetEtag(s)
+	return bc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableEtag(s *string) *BlobCreate {
+	if s != nil {
+		bc.SetEtag(*s)
+	}
+	return bc
+}
+
+// SetRefCount sets the "ref_count" field.
+func (bc *BlobCreate) SetRefCount(i int) *BlobCreate {
+	bc.mutation.SetRefCount(i)
+	return bc
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableRefCount(i *int) *BlobCreate {
+	if i != nil {
+		bc.SetRefCount(*i)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BlobCreate) SetCreatedAt(t time.Time) *BlobCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableCreatedAt(t *time.Time) *BlobCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BlobCreate) SetUpdatedAt(t time.Time) *BlobCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableUpdatedAt(t *time.Time) *BlobCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bc *BlobCreate) Mutation() *BlobMutation {
+	return bc.mutation
+}
+
+// Save creates the Blob in the database.
+func (bc *BlobCreate) Save(ctx context.Context) (*Blob, error) {
+	bc.defaults()
+	return withHooks[*Blob, BlobMutation](ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BlobCreate) SaveX(ctx context.Context) *Blob {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BlobCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BlobCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BlobCreate) defaults() {
+	if _, ok := bc.mutation.Etag(); !ok {
+		v := blob.DefaultEtag
+		bc.mutation.SetEtag(v)
+	}
+	if _, ok := bc.mutation.RefCount(); !ok {
+		v := blob.DefaultRefCount
+		bc.mutation.SetRefCount(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := blob.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := blob.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BlobCreate) check() error {
+	if _, ok := bc.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "Blob.sha256"`)}
+	}
+	if _, ok := bc.mutation.ObjectPath(); !ok {
+		return &ValidationError{Name: "object_path", err: errors.New(`ent: missing required field "Blob.object_path"`)}
+	}
+	if _, ok := bc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Blob.size"`)}
+	}
+	if _, ok := bc.mutation.RefCount(); !ok {
+		return &ValidationError{Name: "ref_count", err: errors.New(`ent: missing required field "Blob.ref_count"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blob.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Blob.updated_at"`)}
+	}
+	return nil
+}
+
+func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Blob{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(blob.Table, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	)
+	if value, ok := bc.mutation.Sha256(); ok {
+		_spec.SetField(blob.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := bc.mutation.ObjectPath(); ok {
+		_spec.SetField(blob.FieldObjectPath, field.TypeString, value)
+		_node.ObjectPath = value
+	}
+	if value, ok := bc.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := bc.mutation.Etag(); ok {
+		_spec.SetField(blob.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := bc.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+		_node.RefCount = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(blob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BlobCreateBulk is the builder for creating many Blob entities in bulk.
+type BlobCreateBulk struct {
+	config
+	builders []*BlobCreate
+}
+
+// Save creates the Blob entities in the database.
+func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Blob, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BlobCreateBulk) SaveX(ctx context.Context) []*Blob {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BlobCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/blob"
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (bu *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetSha256 sets the "sha256" field.
+func (bu *BlobUpdate) SetSha256(s string) *BlobUpdate {
+	bu.mutation.SetSha256(s)
+	return bu
+}
+
+// SetObjectPath sets the "object_path" field.
+func (bu *BlobUpdate) SetObjectPath(s string) *BlobUpdate {
+	bu.mutation.SetObjectPath(s)
+	return bu
+}
+
+// SetSize sets the "size" field.
+func (bu *BlobUpdate) SetSize(i int) *BlobUpdate {
+	bu.mutation.ResetSize()
+	bu.mutation.SetSize(i)
+	return bu
+}
+
+// AddSize adds i to the "size" field.
+func (bu *BlobUpdate) AddSize(i int) *BlobUpdate {
+	bu.mutation.AddSize(i)
+	return bu
+}
+
+// SetEtag sets the "etag" field.
+func (bu *BlobUpdate) SetEtag(s string) *BlobUpdate {
+	bu.mutation.SetEtag(s)
+	return bu
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableEtag(s *string) *BlobUpdate {
+	if s != nil {
+		bu.SetEtag(*s)
+	}
+	return bu
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (bu *BlobUpdate) ClearEtag() *BlobUpdate {
+	bu.mutation.ClearEtag()
+	return bu
+}
+
+// SetRefCount sets the "ref_count" field.
+func (bu *BlobUpdate) SetRefCount(i int) *BlobUpdate {
+	bu.mutation.ResetRefCount()
+	bu.mutation.SetRefCount(i)
+	return bu
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableRefCount(i *int) *BlobUpdate {
+	if i != nil {
+		bu.SetRefCount(*i)
+	}
+	return bu
+}
+
+// AddRefCount adds i to the "ref_count" field.
+func (bu *BlobUpdate) AddRefCount(i int) *BlobUpdate {
+	bu.mutation.AddRefCount(i)
+	return bu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BlobUpdate) SetUpdatedAt(t time.Time) *BlobUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bu *BlobUpdate) Mutation() *BlobMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
+	bu.defaults()
+	return withHooks[int, BlobMutation](ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlobUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BlobUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := blob.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Sha256(); ok {
+		_spec.SetField(blob.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.ObjectPath(); ok {
+		_spec.SetField(blob.FieldObjectPath, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.Etag(); ok {
+		_spec.SetField(blob.FieldEtag, field.TypeString, value)
+	}
+	if bu.mutation.EtagCleared() {
+		_spec.ClearField(blob.FieldEtag, field.TypeString)
+	}
+	if value, ok := bu.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedRefCount(); ok {
+		_spec.AddField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.SetField(blob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// SetSha256 sets the "sha256" field.
+func (buo *BlobUpdateOne) SetSha256(s string) *BlobUpdateOne {
+	buo.mutation.SetSha256(s)
+	return buo
+}
+
+// SetObjectPath sets the "object_path" field.
+func (buo *BlobUpdateOne) SetObjectPath(s string) *BlobUpdateOne {
+	buo.mutation.SetObjectPath(s)
+	return buo
+}
+
+// SetSize sets the "size" field.
+func (buo *BlobUpdateOne) SetSize(i int) *BlobUpdateOne {
+	buo.mutation.ResetSize()
+	buo.mutation.SetSize(i)
+	return buo
+}
+
+// AddSize adds i to the "size" field.
+func (buo *BlobUpdateOne) AddSize(i int) *BlobUpdateOne {
+	buo.mutation.AddSize(i)
+	return buo
+}
+
+// SetEtag sets the "etag" field.
+func (buo *BlobUpdateOne) SetEtag(s string) *BlobUpdateOne {
+	buo.mutation.SetEtag(s)
+	return buo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableEtag(s *string) *BlobUpdateOne {
+	if s != nil {
+		buo.SetEtag(*s)
+	}
+	return buo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (buo *BlobUpdateOne) ClearEtag() *BlobUpdateOne {
+	buo.mutation.ClearEtag()
+	return buo
+}
+
+// SetRefCount sets the "ref_count" field.
+func (buo *BlobUpdateOne) SetRefCount(i int) *BlobUpdateOne {
+	buo.mutation.ResetRefCount()
+	buo.mutation.SetRefCount(i)
+	return buo
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableRefCount(i *int) *BlobUpdateOne {
+	if i != nil {
+		buo.SetRefCount(*i)
+	}
+	return buo
+}
+
+// AddRefCount adds i to the "ref_count" field.
+func (buo *BlobUpdateOne) AddRefCount(i int) *BlobUpdateOne {
+	buo.mutation.AddRefCount(i)
+	return buo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BlobUpdateOne) SetUpdatedAt(t time.Time) *BlobUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (buo *BlobUpdateOne) Mutation() *BlobMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (buo *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	buo.defaults()
+	return withHooks[*Blob, BlobMutation](ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BlobUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := blob.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Sha256(); ok {
+		_spec.SetField(blob.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.ObjectPath(); ok {
+		_spec.SetField(blob.FieldObjectPath, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.Etag(); ok {
+		_spec.SetField(blob.FieldEtag, field.TypeString, value)
+	}
+	if buo.mutation.EtagCleared() {
+		_spec.ClearField(blob.FieldEtag, field.TypeString)
+	}
+	if value, ok := buo.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedRefCount(); ok {
+		_spec.AddField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Blob{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -10,11 +10,16 @@ import (
 
 	"storage/ent/migrate"
 
+	"storage/ent/blob"
 	"storage/ent/file"
+	"storage/ent/multipart"
+	"storage/ent/multipartpart"
+	"storage/ent/sharelink"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +27,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// Multipart is the client for interacting with the Multipart builders.
+	Multipart *MultipartClient
+	// MultipartPart is the client for interacting with the MultipartPart builders.
+	MultipartPart *MultipartPartClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,7 +50,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Blob = NewBlobClient(c.config)
 	c.File = NewFileClient(c.config)
+	c.Multipart = NewMultipartClient(c.config)
+	c.MultipartPart = NewMultipartPartClient(c.config)
+	c.ShareLink = NewShareLinkClient(c.config)
 }
 
 type (
@@ -118,9 +135,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		File:   NewFileClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Blob:          NewBlobClient(cfg),
+		File:          NewFileClient(cfg),
+		Multipart:     NewMultipartClient(cfg),
+		MultipartPart: NewMultipartPartClient(cfg),
+		ShareLink:     NewShareLinkClient(cfg),
 	}, nil
 }
 
@@ -138,16 +159,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		File:   NewFileClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Blob:          NewBlobClient(cfg),
+		File:          NewFileClient(cfg),
+		Multipart:     NewMultipartClient(cfg),
+		MultipartPart: NewMultipartPartClient(cfg),
+		ShareLink:     NewShareLinkClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		File.
+//		Blob.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -169,25 +194,159 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Blob.Use(hooks...)
 	c.File.Use(hooks...)
+	c.Multipart.Use(hooks...)
+	c.MultipartPart.Use(hooks...)
+	c.ShareLink.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Blob.Intercept(interceptors...)
 	c.File.Intercept(interceptors...)
+	c.Multipart.Intercept(interceptors...)
+	c.MultipartPart.Intercept(interceptors...)
+	c.ShareLink.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BlobMutation:
+		return c.Blob.mutate(ctx, m)
 	case *FileMutation:
 		return c.File.mutate(ctx, m)
+	case *MultipartMutation:
+		return c.Multipart.mutate(ctx, m)
+	case *MultipartPartMutation:
+		return c.MultipartPart.mutate(ctx, m)
+	case *ShareLinkMutation:
+		return c.ShareLink.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// BlobClient is a client for the Blob schema.
+type BlobClient struct {
+	config
+}
+
+// NewBlobClient returns a client for the Blob from the given config.
+func NewBlobClient(c config) *BlobClient {
+	return &BlobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blob.Hooks(f(g(h())))`.
+func (c *BlobClient) Use(hooks ...Hook) {
+	c.hooks.Blob = append(c.hooks.Blob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
+}
+
+// Create returns a builder for creating a Blob entity.
+func (c *BlobClient) Create() *BlobCreate {
+	mutation := newBlobMutation(c.config, OpCreate)
+	return &BlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Blob entities.
+func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Blob.
+func (c *BlobClient) Update() *BlobUpdate {
+	mutation := newBlobMutation(c.config, OpUpdate)
+	return &BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlobClient) UpdateOne(b *Blob) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlob(b))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlobClient) UpdateOneID(id int) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlobID(id))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Blob.
+func (c *BlobClient) Delete() *BlobDelete {
+	mutation := newBlobMutation(c.config, OpDelete)
+	return &BlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlobClient) DeleteOne(b *Blob) *BlobDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlobClient) DeleteOneID(id int) *BlobDeleteOne {
+	builder := c.Delete().Where(blob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlobDeleteOne{builder}
+}
+
+// Query returns a query builder for Blob.
+func (c *BlobClient) Query() *BlobQuery {
+	return &BlobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Blob entity by its id.
+func (c *BlobClient) Get(ctx context.Context, id int) (*Blob, error) {
+	return c.Query().Where(blob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlobClient) GetX(ctx context.Context, id int) *Blob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlobClient) Hooks() []Hook {
+	return c.hooks.Blob
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
+}
+
+func (c *BlobClient) mutate(ctx context.Context, m *BlobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Blob mutation op: %q", m.Op())
+	}
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -281,6 +440,22 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 	return obj
 }
 
+// QueryBlob queries the blob edge of a File.
+func (c *FileClient) QueryBlob(f *File) *BlobQuery {
+	query := (&BlobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, file.BlobTable, file.BlobColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
@@ -306,12 +481,382 @@ func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error)
 	}
 }
 
+// MultipartClient is a client for the Multipart schema.
+type MultipartClient struct {
+	config
+}
+
+// NewMultipartClient returns a client for the Multipart from the given config.
+func NewMultipartClient(c config) *MultipartClient {
+	return &MultipartClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `multipart.Hooks(f(g(h())))`.
+func (c *MultipartClient) Use(hooks ...Hook) {
+	c.hooks.Multipart = append(c.hooks.Multipart, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `multipart.Intercept(f(g(h())))`.
+func (c *MultipartClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Multipart = append(c.inters.Multipart, interceptors...)
+}
+
+// Create returns a builder for creating a Multipart entity.
+func (c *MultipartClient) Create() *MultipartCreate {
+	mutation := newMultipartMutation(c.config, OpCreate)
+	return &MultipartCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Multipart entities.
+func (c *MultipartClient) CreateBulk(builders ...*MultipartCreate) *MultipartCreateBulk {
+	return &MultipartCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Multipart.
+func (c *MultipartClient) Update() *MultipartUpdate {
+	mutation := newMultipartMutation(c.config, OpUpdate)
+	return &MultipartUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MultipartClient) UpdateOne(m *Multipart) *MultipartUpdateOne {
+	mutation := newMultipartMutation(c.config, OpUpdateOne, withMultipart(m))
+	return &MultipartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MultipartClient) UpdateOneID(id int) *MultipartUpdateOne {
+	mutation := newMultipartMutation(c.config, OpUpdateOne, withMultipartID(id))
+	return &MultipartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Multipart.
+func (c *MultipartClient) Delete() *MultipartDelete {
+	mutation := newMultipartMutation(c.config, OpDelete)
+	return &MultipartDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MultipartClient) DeleteOne(m *Multipart) *MultipartDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MultipartClient) DeleteOneID(id int) *MultipartDeleteOne {
+	builder := c.Delete().Where(multipart.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MultipartDeleteOne{builder}
+}
+
+// Query returns a query builder for Multipart.
+func (c *MultipartClient) Query() *MultipartQuery {
+	return &MultipartQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMultipart},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Multipart entity by its id.
+func (c *MultipartClient) Get(ctx context.Context, id int) (*Multipart, error) {
+	return c.Query().Where(multipart.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MultipartClient) GetX(ctx context.Context, id int) *Multipart {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MultipartClient) Hooks() []Hook {
+	return c.hooks.Multipart
+}
+
+// Interceptors returns the client interceptors.
+func (c *MultipartClient) Interceptors() []Interceptor {
+	return c.inters.Multipart
+}
+
+func (c *MultipartClient) mutate(ctx context.Context, m *MultipartMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MultipartCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MultipartUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MultipartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MultipartDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Multipart mutation op: %q", m.Op())
+	}
+}
+
+// MultipartPartClient is a client for the MultipartPart schema.
+type MultipartPartClient struct {
+	config
+}
+
+// NewMultipartPartClient returns a client for the MultipartPart from the given config.
+func NewMultipartPartClient(c config) *MultipartPartClient {
+	return &MultipartPartClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `multipartpart.Hooks(f(g(h())))`.
+func (c *MultipartPartClient) Use(hooks ...Hook) {
+	c.hooks.MultipartPart = append(c.hooks.MultipartPart, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `multipartpart.Intercept(f(g(h())))`.
+func (c *MultipartPartClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MultipartPart = append(c.inters.MultipartPart, interceptors...)
+}
+
+// Create returns a builder for creating a MultipartPart entity.
+func (c *MultipartPartClient) Create() *MultipartPartCreate {
+	mutation := newMultipartPartMutation(c.config, OpCreate)
+	return &MultipartPartCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MultipartPart entities.
+func (c *MultipartPartClient) CreateBulk(builders ...*MultipartPartCreate) *MultipartPartCreateBulk {
+	return &MultipartPartCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MultipartPart.
+func (c *MultipartPartClient) Update() *MultipartPartUpdate {
+	mutation := newMultipartPartMutation(c.config, OpUpdate)
+	return &MultipartPartUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MultipartPartClient) UpdateOne(mp *MultipartPart) *MultipartPartUpdateOne {
+	mutation := newMultipartPartMutation(c.config, OpUpdateOne, withMultipartPart(mp))
+	return &MultipartPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MultipartPartClient) UpdateOneID(id int) *MultipartPartUpdateOne {
+	mutation := newMultipartPartMutation(c.config, OpUpdateOne, withMultipartPartID(id))
+	return &MultipartPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MultipartPart.
+func (c *MultipartPartClient) Delete() *MultipartPartDelete {
+	mutation := newMultipartPartMutation(c.config, OpDelete)
+	return &MultipartPartDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MultipartPartClient) DeleteOne(mp *MultipartPart) *MultipartPartDeleteOne {
+	return c.DeleteOneID(mp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MultipartPartClient) DeleteOneID(id int) *MultipartPartDeleteOne {
+	builder := c.Delete().Where(multipartpart.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MultipartPartDeleteOne{builder}
+}
+
+// Query returns a query builder for MultipartPart.
+func (c *MultipartPartClient) Query() *MultipartPartQuery {
+	return &MultipartPartQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMultipartPart},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MultipartPart entity by its id.
+func (c *MultipartPartClient) Get(ctx context.Context, id int) (*MultipartPart, error) {
+	return c.Query().Where(multipartpart.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MultipartPartClient) GetX(ctx context.Context, id int) *MultipartPart {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MultipartPartClient) Hooks() []Hook {
+	return c.hooks.MultipartPart
+}
+
+// Interceptors returns the client interceptors.
+func (c *MultipartPartClient) Interceptors() []Interceptor {
+	return c.inters.MultipartPart
+}
+
+func (c *MultipartPartClient) mutate(ctx context.Context, m *MultipartPartMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MultipartPartCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MultipartPartUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MultipartPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MultipartPartDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MultipartPart mutation op: %q", m.Op())
+	}
+}
+
+// ShareLinkClient is a client for the ShareLink schema.
+type ShareLinkClient struct {
+	config
+}
+
+// NewShareLinkClient returns a client for the ShareLink from the given config.
+func NewShareLinkClient(c config) *ShareLinkClient {
+	return &ShareLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharelink.Hooks(f(g(h())))`.
+func (c *ShareLinkClient) Use(hooks ...Hook) {
+	c.hooks.ShareLink = append(c.hooks.ShareLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharelink.Intercept(f(g(h())))`.
+func (c *ShareLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareLink = append(c.inters.ShareLink, interceptors...)
+}
+
+// Create returns a builder for creating a ShareLink entity.
+func (c *ShareLinkClient) Create() *ShareLinkCreate {
+	mutation := newShareLinkMutation(c.config, OpCreate)
+	return &ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareLink entities.
+func (c *ShareLinkClient) CreateBulk(builders ...*ShareLinkCreate) *ShareLinkCreateBulk {
+	return &ShareLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareLink.
+func (c *ShareLinkClient) Update() *ShareLinkUpdate {
+	mutation := newShareLinkMutation(c.config, OpUpdate)
+	return &ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareLinkClient) UpdateOne(sl *ShareLink) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLink(sl))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareLinkClient) UpdateOneID(id int) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLinkID(id))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareLink.
+func (c *ShareLinkClient) Delete() *ShareLinkDelete {
+	mutation := newShareLinkMutation(c.config, OpDelete)
+	return &ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareLinkClient) DeleteOne(sl *ShareLink) *ShareLinkDeleteOne {
+	return c.DeleteOneID(sl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareLinkClient) DeleteOneID(id int) *ShareLinkDeleteOne {
+	builder := c.Delete().Where(sharelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareLink.
+func (c *ShareLinkClient) Query() *ShareLinkQuery {
+	return &ShareLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShareLink entity by its id.
+func (c *ShareLinkClient) Get(ctx context.Context, id int) (*ShareLink, error) {
+	return c.Query().Where(sharelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareLinkClient) GetX(ctx context.Context, id int) *ShareLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a ShareLink.
+func (c *ShareLinkClient) QueryFile(sl *ShareLink) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sharelink.FileTable, sharelink.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareLinkClient) Hooks() []Hook {
+	return c.hooks.ShareLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareLinkClient) Interceptors() []Interceptor {
+	return c.inters.ShareLink
+}
+
+func (c *ShareLinkClient) mutate(ctx context.Context, m *ShareLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShareLink mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		File []ent.Hook
+		Blob, File, Multipart, MultipartPart, ShareLink []ent.Hook
 	}
 	inters struct {
-		File []ent.Interceptor
+		Blob, File, Multipart, MultipartPart, ShareLink []ent.Interceptor
 	}
 )
@@ -7,7 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"storage/ent/blob"
 	"storage/ent/file"
+	"storage/ent/multipart"
+	"storage/ent/multipartpart"
+	"storage/ent/sharelink"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -65,7 +69,11 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		file.Table: file.ValidColumn,
+		blob.Table:          blob.ValidColumn,
+		file.Table:          file.ValidColumn,
+		multipart.Table:     multipart.ValidColumn,
+		multipartpart.Table: multipartpart.ValidColumn,
+		sharelink.Table:     sharelink.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"storage/ent/blob"
 	"storage/ent/file"
 	"strings"
 	"time"
@@ -25,16 +26,67 @@ type File struct {
 	Filename string `json:"filename,omitempty"`
 	// path to file object in s3 storage
 	ObjectPath string `json:"object_path,omitempty"`
+	// path of file shared by all its versions, object path of the first version
+	LogicalPath string `json:"logical_path,omitempty"`
+	// number of file version, the latest active version is served by default
+	Version int `json:"version,omitempty"`
 	// size of file in bytes
 	Size int `json:"size,omitempty"`
-	// file mime type
+	// file mime type declared by extension of filename, files are served with it
 	MimeType string `json:"mime_type,omitempty"`
+	// file mime type detected by first bytes of content, empty for empty files and older uploads
+	DetectedMimeType string `json:"detected_mime_type,omitempty"`
+	// etag of file object in s3 storage
+	Etag string `json:"etag,omitempty"`
+	// last modification time of file object in s3 storage
+	LastModified *time.Time `json:"last_modified,omitempty"`
+	// hex encoded sha-256 checksum of file content
+	Sha256 string `json:"sha256,omitempty"`
+	// hex encoded md5 checksum of file content
+	Md5 string `json:"md5,omitempty"`
+	// identifier of content blob shared by files with the same content, empty for own objects
+	BlobID *int `json:"blob_id,omitempty"`
+	// status of file, transitions between statuses are checked by repository
+	Status file.Status `json:"status,omitempty"`
+	// who may download file: anyone, authenticated users, owner or owner and holders of share links
+	Visibility file.Visibility `json:"visibility,omitempty"`
+	// antivirus scan status, quarantined and infected files are not downloadable, skipped ones are not scanned
+	ScanStatus file.ScanStatus `json:"scan_status,omitempty"`
+	// signature of malware found by the last scan or error of the last failed scan
+	ScanResult string `json:"scan_result,omitempty"`
+	// time of the last antivirus scan
+	ScannedAt *time.Time `json:"scanned_at,omitempty"`
 	// creation time of file
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// last update time of file
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// time of file deletion
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileQuery when eager-loading is set.
+	Edges FileEdges `json:"edges"`
+}
+
+// FileEdges holds the relations/edges for other nodes in the graph.
+type FileEdges struct {
+	// content blob of deduplicated file
+	Blob *Blob `json:"blob,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BlobOrErr returns the Blob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) BlobOrErr() (*Blob, error) {
+	if e.loadedTypes[0] {
+		if e.Blob == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: blob.Label}
+		}
+		return e.Blob, nil
+	}
+	return nil, &NotLoadedError{edge: "blob"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -42,11 +94,11 @@ func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldID, file.FieldUserID, file.FieldSize:
+		case file.FieldID, file.FieldUserID, file.FieldVersion, file.FieldSize, file.FieldBlobID:
 			values[i] = new(sql.NullInt64)
-		case file.FieldFilename, file.FieldObjectPath, file.FieldMimeType:
+		case file.FieldFilename, file.FieldObjectPath, file.FieldLogicalPath, file.FieldMimeType, file.FieldDetectedMimeType, file.FieldEtag, file.FieldSha256, file.FieldMd5, file.FieldStatus, file.FieldVisibility, file.FieldScanStatus, file.FieldScanResult:
 			values[i] = new(sql.NullString)
-		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
+		case file.FieldLastModified, file.FieldScannedAt, file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case file.FieldUID:
 			values[i] = new(uuid.UUID)
@@ -95,6 +147,18 @@ func (f *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.ObjectPath = value.String
 			}
+		case file.FieldLogicalPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logical_path", values[i])
+			} else if value.Valid {
+				f.LogicalPath = value.String
+			}
+		case file.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				f.Version = int(value.Int64)
+			}
 		case file.FieldSize:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field size", values[i])
@@ -107,6 +171,75 @@ func (f *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.MimeType = value.String
 			}
+		case file.FieldDetectedMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_mime_type", values[i])
+			} else if value.Valid {
+				f.DetectedMimeType = value.String
+			}
+		case file.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				f.Etag = value.String
+			}
+		case file.FieldLastModified:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_modified", values[i])
+			} else if value.Valid {
+				f.LastModified = new(time.Time)
+				*f.LastModified = value.Time
+			}
+		case file.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				f.Sha256 = value.String
+			}
+		case file.FieldMd5:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field md5", values[i])
+			} else if value.Valid {
+				f.Md5 = value.String
+			}
+		case file.FieldBlobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_id", values[i])
+			} else if value.Valid {
+				f.BlobID = new(int)
+				*f.BlobID = int(value.Int64)
+			}
+		case file.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				f.Status = file.Status(value.String)
+			}
+		case file.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				f.Visibility = file.Visibility(value.String)
+			}
+		case file.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				f.ScanStatus = file.ScanStatus(value.String)
+			}
+		case file.FieldScanResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_result", values[i])
+			} else if value.Valid {
+				f.ScanResult = value.String
+			}
+		case file.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				f.ScannedAt = new(time.Time)
+				*f.ScannedAt = value.Time
+			}
 		case file.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -131,6 +264,11 @@ func (f *File) assignValues(columns []string, values []any) error {
 	return nil
 }
 
+// QueryBlob queries the "blob" edge of the File entity.
+func (f *File) QueryBlob() *BlobQuery {
+	return NewFileClient(f.config).QueryBlob(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -166,12 +304,57 @@ func (f *File) String() string {
 	builder.WriteString("object_path=")
 	builder.WriteString(f.ObjectPath)
 	builder.WriteString(", ")
+	builder.WriteString("logical_path=")
+	builder.WriteString(f.LogicalPath)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", f.Version))
+	builder.WriteString(", ")
 	builder.WriteString("size=")
 	builder.WriteString(fmt.Sprintf("%v", f.Size))
 	builder.WriteString(", ")
 	builder.WriteString("mime_type=")
 	builder.WriteString(f.MimeType)
 	builder.WriteString(", ")
+	builder.WriteString("detected_mime_type=")
+	builder.WriteString(f.DetectedMimeType)
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(f.Etag)
+	builder.WriteString(", ")
+	if v := f.LastModified; v != nil {
+		builder.WriteString("last_modified=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(f.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("md5=")
+	builder.WriteString(f.Md5)
+	builder.WriteString(", ")
+	if v := f.BlobID; v != nil {
+		builder.WriteString("blob_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", f.Status))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", f.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("scan_status=")
+	builder.WriteString(fmt.Sprintf("%v", f.ScanStatus))
+	builder.WriteString(", ")
+	builder.WriteString("scan_result=")
+	builder.WriteString(f.ScanResult)
+	builder.WriteString(", ")
+	if v := f.ScannedAt; v != nil {
+		builder.WriteString("scanned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
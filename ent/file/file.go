@@ -3,6 +3,7 @@
 package file
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,18 +22,53 @@ const (
 	FieldFilename = "filename"
 	// FieldObjectPath holds the string denoting the object_path field in the database.
 	FieldObjectPath = "object_path"
+	// FieldLogicalPath holds the string denoting the logical_path field in the database.
+	FieldLogicalPath = "logical_path"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldSize holds the string denoting the size field in the database.
 	FieldSize = "size"
 	// FieldMimeType holds the string denoting the mime_type field in the database.
 	FieldMimeType = "mime_type"
+	// FieldDetectedMimeType holds the string denoting the detected_mime_type field in the database.
+	FieldDetectedMimeType = "detected_mime_type"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
+	// FieldLastModified holds the string denoting the last_modified field in the database.
+	FieldLastModified = "last_modified"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldMd5 holds the string denoting the md5 field in the database.
+	FieldMd5 = "md5"
+	// FieldBlobID holds the string denoting the blob_id field in the database.
+	FieldBlobID = "blob_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanResult holds the string denoting the scan_result field in the database.
+	FieldScanResult = "scan_result"
+	// FieldScannedAt holds the string denoting the scanned_at field in the database.
+	FieldScannedAt = "scanned_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// EdgeBlob holds the string denoting the blob edge name in mutations.
+	EdgeBlob = "blob"
 	// Table holds the table name of the file in the database.
 	Table = "files"
+	// BlobTable is the table that holds the blob relation/edge.
+	BlobTable = "files"
+	// BlobInverseTable is the table name for the Blob entity.
+	// It exists in this package in order to avoid circular dependency with the "blob" package.
+	BlobInverseTable = "blobs"
+	// BlobColumn is the table column denoting the blob relation/edge.
+	BlobColumn = "blob_id"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -42,8 +78,21 @@ var Columns = []string{
 	FieldUserID,
 	FieldFilename,
 	FieldObjectPath,
+	FieldLogicalPath,
+	FieldVersion,
 	FieldSize,
 	FieldMimeType,
+	FieldDetectedMimeType,
+	FieldEtag,
+	FieldLastModified,
+	FieldSha256,
+	FieldMd5,
+	FieldBlobID,
+	FieldStatus,
+	FieldVisibility,
+	FieldScanStatus,
+	FieldScanResult,
+	FieldScannedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -62,6 +111,20 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultUID holds the default value on creation for the "uid" field.
 	DefaultUID func() uuid.UUID
+	// DefaultLogicalPath holds the default value on creation for the "logical_path" field.
+	DefaultLogicalPath string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultDetectedMimeType holds the default value on creation for the "detected_mime_type" field.
+	DefaultDetectedMimeType string
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag string
+	// DefaultSha256 holds the default value on creation for the "sha256" field.
+	DefaultSha256 string
+	// DefaultMd5 holds the default value on creation for the "md5" field.
+	DefaultMd5 string
+	// DefaultScanResult holds the default value on creation for the "scan_result" field.
+	DefaultScanResult string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -69,3 +132,88 @@ var (
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
 )
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusActive  Status = "active"
+	StatusFailed  Status = "failed"
+	StatusDeleted Status = "deleted"
+	StatusPurged  Status = "purged"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusActive, StatusFailed, StatusDeleted, StatusPurged:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for status field: %q", s)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPublic is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPublic
+
+// Visibility values.
+const (
+	VisibilityPublic        Visibility = "public"
+	VisibilityAuthenticated Visibility = "authenticated"
+	VisibilityOwner         Visibility = "owner"
+	VisibilityShared        Visibility = "shared"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityAuthenticated, VisibilityOwner, VisibilityShared:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for visibility field: %q", v)
+	}
+}
+
+// ScanStatus defines the type for the "scan_status" enum field.
+type ScanStatus string
+
+// ScanStatusSkipped is the default value of the ScanStatus enum.
+const DefaultScanStatus = ScanStatusSkipped
+
+// ScanStatus values.
+const (
+	ScanStatusSkipped     ScanStatus = "skipped"
+	ScanStatusQuarantined ScanStatus = "quarantined"
+	ScanStatusClean       ScanStatus = "clean"
+	ScanStatusInfected    ScanStatus = "infected"
+)
+
+func (ss ScanStatus) String() string {
+	return string(ss)
+}
+
+// ScanStatusValidator is a validator for the "scan_status" field enum values. It is called by the builders before save.
+func ScanStatusValidator(ss ScanStatus) error {
+	switch ss {
+	case ScanStatusSkipped, ScanStatusQuarantined, ScanStatusClean, ScanStatusInfected:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for scan_status field: %q", ss)
+	}
+}
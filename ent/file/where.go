@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -75,6 +76,16 @@ func ObjectPath(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldObjectPath, v))
 }
 
+// LogicalPath applies equality check predicate on the "logical_path" field. It's identical to LogicalPathEQ.
+func LogicalPath(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLogicalPath, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersion, v))
+}
+
 // Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
 func Size(v int) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldSize, v))
@@ -85,6 +96,46 @@ func MimeType(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldMimeType, v))
 }
 
+// DetectedMimeType applies equality check predicate on the "detected_mime_type" field. It's identical to DetectedMimeTypeEQ.
+func DetectedMimeType(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEtag, v))
+}
+
+// LastModified applies equality check predicate on the "last_modified" field. It's identical to LastModifiedEQ.
+func LastModified(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastModified, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Md5 applies equality check predicate on the "md5" field. It's identical to Md5EQ.
+func Md5(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMd5, v))
+}
+
+// BlobID applies equality check predicate on the "blob_id" field. It's identical to BlobIDEQ.
+func BlobID(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBlobID, v))
+}
+
+// ScanResult applies equality check predicate on the "scan_result" field. It's identical to ScanResultEQ.
+func ScanResult(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanResult, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScannedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -310,6 +361,121 @@ func ObjectPathContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldObjectPath, v))
 }
 
+// LogicalPathEQ applies the EQ predicate on the "logical_path" field.
+func LogicalPathEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLogicalPath, v))
+}
+
+// LogicalPathNEQ applies the NEQ predicate on the "logical_path" field.
+func LogicalPathNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLogicalPath, v))
+}
+
+// LogicalPathIn applies the In predicate on the "logical_path" field.
+func LogicalPathIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLogicalPath, vs...))
+}
+
+// LogicalPathNotIn applies the NotIn predicate on the "logical_path" field.
+func LogicalPathNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLogicalPath, vs...))
+}
+
+// LogicalPathGT applies the GT predicate on the "logical_path" field.
+func LogicalPathGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLogicalPath, v))
+}
+
+// LogicalPathGTE applies the GTE predicate on the "logical_path" field.
+func LogicalPathGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLogicalPath, v))
+}
+
+// LogicalPathLT applies the LT predicate on the "logical_path" field.
+func LogicalPathLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLogicalPath, v))
+}
+
+// LogicalPathLTE applies the LTE predicate on the "logical_path" field.
+func LogicalPathLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLogicalPath, v))
+}
+
+// LogicalPathContains applies the Contains predicate on the "logical_path" field.
+func LogicalPathContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldLogicalPath, v))
+}
+
+// LogicalPathHasPrefix applies the HasPrefix predicate on the "logical_path" field.
+func LogicalPathHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldLogicalPath, v))
+}
+
+// LogicalPathHasSuffix applies the HasSuffix predicate on the "logical_path" field.
+func LogicalPathHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldLogicalPath, v))
+}
+
+// LogicalPathIsNil applies the IsNil predicate on the "logical_path" field.
+func LogicalPathIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLogicalPath))
+}
+
+// LogicalPathNotNil applies the NotNil predicate on the "logical_path" field.
+func LogicalPathNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLogicalPath))
+}
+
+// LogicalPathEqualFold applies the EqualFold predicate on the "logical_path" field.
+func LogicalPathEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldLogicalPath, v))
+}
+
+// LogicalPathContainsFold applies the ContainsFold predicate on the "logical_path" field.
+func LogicalPathContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldLogicalPath, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.File {
+	return predicate.File(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.File {
+	return predicate.File(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldVersion, v))
+}
+
 // SizeEQ applies the EQ predicate on the "size" field.
 func SizeEQ(v int) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldSize, v))
@@ -415,6 +581,571 @@ func MimeTypeContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldMimeType, v))
 }
 
+// DetectedMimeTypeEQ applies the EQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeNEQ applies the NEQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIn applies the In predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeNotIn applies the NotIn predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeGT applies the GT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeGTE applies the GTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLT applies the LT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLTE applies the LTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContains applies the Contains predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasPrefix applies the HasPrefix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasSuffix applies the HasSuffix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIsNil applies the IsNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeNotNil applies the NotNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeEqualFold applies the EqualFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContainsFold applies the ContainsFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldDetectedMimeType, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldEtag))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// LastModifiedEQ applies the EQ predicate on the "last_modified" field.
+func LastModifiedEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastModified, v))
+}
+
+// LastModifiedNEQ applies the NEQ predicate on the "last_modified" field.
+func LastModifiedNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLastModified, v))
+}
+
+// LastModifiedIn applies the In predicate on the "last_modified" field.
+func LastModifiedIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLastModified, vs...))
+}
+
+// LastModifiedNotIn applies the NotIn predicate on the "last_modified" field.
+func LastModifiedNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLastModified, vs...))
+}
+
+// LastModifiedGT applies the GT predicate on the "last_modified" field.
+func LastModifiedGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLastModified, v))
+}
+
+// LastModifiedGTE applies the GTE predicate on the "last_modified" field.
+func LastModifiedGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLastModified, v))
+}
+
+// LastModifiedLT applies the LT predicate on the "last_modified" field.
+func LastModifiedLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLastModified, v))
+}
+
+// LastModifiedLTE applies the LTE predicate on the "last_modified" field.
+func LastModifiedLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLastModified, v))
+}
+
+// LastModifiedIsNil applies the IsNil predicate on the "last_modified" field.
+func LastModifiedIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLastModified))
+}
+
+// LastModifiedNotNil applies the NotNil predicate on the "last_modified" field.
+func LastModifiedNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLastModified))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256IsNil applies the IsNil predicate on the "sha256" field.
+func Sha256IsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldSha256))
+}
+
+// Sha256NotNil applies the NotNil predicate on the "sha256" field.
+func Sha256NotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldSha256))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// Md5EQ applies the EQ predicate on the "md5" field.
+func Md5EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldMd5, v))
+}
+
+// Md5NEQ applies the NEQ predicate on the "md5" field.
+func Md5NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldMd5, v))
+}
+
+// Md5In applies the In predicate on the "md5" field.
+func Md5In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldMd5, vs...))
+}
+
+// Md5NotIn applies the NotIn predicate on the "md5" field.
+func Md5NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldMd5, vs...))
+}
+
+// Md5GT applies the GT predicate on the "md5" field.
+func Md5GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldMd5, v))
+}
+
+// Md5GTE applies the GTE predicate on the "md5" field.
+func Md5GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldMd5, v))
+}
+
+// Md5LT applies the LT predicate on the "md5" field.
+func Md5LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldMd5, v))
+}
+
+// Md5LTE applies the LTE predicate on the "md5" field.
+func Md5LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldMd5, v))
+}
+
+// Md5Contains applies the Contains predicate on the "md5" field.
+func Md5Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldMd5, v))
+}
+
+// Md5HasPrefix applies the HasPrefix predicate on the "md5" field.
+func Md5HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldMd5, v))
+}
+
+// Md5HasSuffix applies the HasSuffix predicate on the "md5" field.
+func Md5HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldMd5, v))
+}
+
+// Md5IsNil applies the IsNil predicate on the "md5" field.
+func Md5IsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldMd5))
+}
+
+// Md5NotNil applies the NotNil predicate on the "md5" field.
+func Md5NotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldMd5))
+}
+
+// Md5EqualFold applies the EqualFold predicate on the "md5" field.
+func Md5EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldMd5, v))
+}
+
+// Md5ContainsFold applies the ContainsFold predicate on the "md5" field.
+func Md5ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldMd5, v))
+}
+
+// BlobIDEQ applies the EQ predicate on the "blob_id" field.
+func BlobIDEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBlobID, v))
+}
+
+// BlobIDNEQ applies the NEQ predicate on the "blob_id" field.
+func BlobIDNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldBlobID, v))
+}
+
+// BlobIDIn applies the In predicate on the "blob_id" field.
+func BlobIDIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldBlobID, vs...))
+}
+
+// BlobIDNotIn applies the NotIn predicate on the "blob_id" field.
+func BlobIDNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldBlobID, vs...))
+}
+
+// BlobIDIsNil applies the IsNil predicate on the "blob_id" field.
+func BlobIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldBlobID))
+}
+
+// BlobIDNotNil applies the NotNil predicate on the "blob_id" field.
+func BlobIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldBlobID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.File {
+	return predicate.File(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.File {
+	return predicate.File(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanResultEQ applies the EQ predicate on the "scan_result" field.
+func ScanResultEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanResult, v))
+}
+
+// ScanResultNEQ applies the NEQ predicate on the "scan_result" field.
+func ScanResultNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanResult, v))
+}
+
+// ScanResultIn applies the In predicate on the "scan_result" field.
+func ScanResultIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanResult, vs...))
+}
+
+// ScanResultNotIn applies the NotIn predicate on the "scan_result" field.
+func ScanResultNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanResult, vs...))
+}
+
+// ScanResultGT applies the GT predicate on the "scan_result" field.
+func ScanResultGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanResult, v))
+}
+
+// ScanResultGTE applies the GTE predicate on the "scan_result" field.
+func ScanResultGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanResult, v))
+}
+
+// ScanResultLT applies the LT predicate on the "scan_result" field.
+func ScanResultLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanResult, v))
+}
+
+// ScanResultLTE applies the LTE predicate on the "scan_result" field.
+func ScanResultLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanResult, v))
+}
+
+// ScanResultContains applies the Contains predicate on the "scan_result" field.
+func ScanResultContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanResult, v))
+}
+
+// ScanResultHasPrefix applies the HasPrefix predicate on the "scan_result" field.
+func ScanResultHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanResult, v))
+}
+
+// ScanResultHasSuffix applies the HasSuffix predicate on the "scan_result" field.
+func ScanResultHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanResult, v))
+}
+
+// ScanResultIsNil applies the IsNil predicate on the "scan_result" field.
+func ScanResultIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanResult))
+}
+
+// ScanResultNotNil applies the NotNil predicate on the "scan_result" field.
+func ScanResultNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanResult))
+}
+
+// ScanResultEqualFold applies the EqualFold predicate on the "scan_result" field.
+func ScanResultEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanResult, v))
+}
+
+// ScanResultContainsFold applies the ContainsFold predicate on the "scan_result" field.
+func ScanResultContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanResult, v))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// ScannedAtIsNil applies the IsNil predicate on the "scanned_at" field.
+func ScannedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScannedAt))
+}
+
+// ScannedAtNotNil applies the NotNil predicate on the "scanned_at" field.
+func ScannedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScannedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -545,6 +1276,33 @@ func DeletedAtNotNil() predicate.File {
 	return predicate.File(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// HasBlob applies the HasEdge predicate on the "blob" edge.
+func HasBlob() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BlobTable, BlobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobWith applies the HasEdge predicate on the "blob" edge with a given conditions (other predicates).
+func HasBlobWith(preds ...predicate.Blob) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BlobInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BlobTable, BlobColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
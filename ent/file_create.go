@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"storage/ent/blob"
 	"storage/ent/file"
 	"time"
 
@@ -53,6 +54,34 @@ func (fc *FileCreate) SetObjectPath(s string) *FileCreate {
 	return fc
 }
 
+// SetLogicalPath sets the "logical_path" field.
+func (fc *FileCreate) SetLogicalPath(s string) *FileCreate {
+	fc.mutation.SetLogicalPath(s)
+	return fc
+}
+
+// SetNillableLogicalPath sets the "logical_path" field if the given value is not nil.
+func (fc *FileCreate) SetNillableLogicalPath(s *string) *FileCreate {
+	if s != nil {
+		fc.SetLogicalPath(*s)
+	}
+	return fc
+}
+
+// SetVersion sets the "version" field.
+func (fc *FileCreate) SetVersion(i int) *FileCreate {
+	fc.mutation.SetVersion(i)
+	return fc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (fc *FileCreate) SetNillableVersion(i *int) *FileCreate {
+	if i != nil {
+		fc.SetVersion(*i)
+	}
+	return fc
+}
+
 // SetSize sets the "size" field.
 func (fc *FileCreate) SetSize(i int) *FileCreate {
 	fc.mutation.SetSize(i)
@@ -65,6 +94,160 @@ func (fc *FileCreate) SetMimeType(s string) *FileCreate {
 	return fc
 }
 
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (fc *FileCreate) SetDetectedMimeType(s string) *FileCreate {
+	fc.mutation.SetDetectedMimeType(s)
+	return fc
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (fc *FileCreate) SetNillableDetectedMimeType(s *string) *FileCreate {
+	if s != nil {
+		fc.SetDetectedMimeType(*s)
+	}
+	return fc
+}
+
+// SetEtag sets the "etag" field.
+func (fc *FileCreate) SetEtag(s string) *FileCreate {
+	fc.mutation.SetEtag(s)
+	return fc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fc *FileCreate) SetNillableEtag(s *string) *FileCreate {
+	if s != nil {
+		fc.SetEtag(*s)
+	}
+	return fc
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fc *FileCreate) SetLastModified(t time.Time) *FileCreate {
+	fc.mutation.SetLastModified(t)
+	return fc
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fc *FileCreate) SetNillableLastModified(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetLastModified(*t)
+	}
+	return fc
+}
+
+// SetSha256 sets the "sha256" field.
+func (fc *FileCreate) SetSha256(s string) *FileCreate {
+	fc.mutation.SetSha256(s)
+	return fc
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fc *FileCreate) SetNillableSha256(s *string) *FileCreate {
+	if s != nil {
+		fc.SetSha256(*s)
+	}
+	return fc
+}
+
+// SetMd5 sets the "md5" field.
+func (fc *FileCreate) SetMd5(s string) *FileCreate {
+	fc.mutation.SetMd5(s)
+	return fc
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (fc *FileCreate) SetNillableMd5(s *string) *FileCreate {
+	if s != nil {
+		fc.SetMd5(*s)
+	}
+	return fc
+}
+
+// SetBlobID sets the "blob_id" field.
+func (fc *FileCreate) SetBlobID(i int) *FileCreate {
+	fc.mutation.SetBlobID(i)
+	return fc
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableBlobID(i *int) *FileCreate {
+	if i != nil {
+		fc.SetBlobID(*i)
+	}
+	return fc
+}
+
+// SetStatus sets the "status" field.
+func (fc *FileCreate) SetStatus(f file.Status) *FileCreate {
+	fc.mutation.SetStatus(f)
+	return fc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fc *FileCreate) SetNillableStatus(f *file.Status) *FileCreate {
+	if f != nil {
+		fc.SetStatus(*f)
+	}
+	return fc
+}
+
+// SetVisibility sets the "visibility" field.
+func (fc *FileCreate) SetVisibility(f file.Visibility) *FileCreate {
+	fc.mutation.SetVisibility(f)
+	return fc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (fc *FileCreate) SetNillableVisibility(f *file.Visibility) *FileCreate {
+	if f != nil {
+		fc.SetVisibility(*f)
+	}
+	return fc
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (fc *FileCreate) SetScanStatus(fs file.ScanStatus) *FileCreate {
+	fc.mutation.SetScanStatus(fs)
+	return fc
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (fc *FileCreate) SetNillableScanStatus(fs *file.ScanStatus) *FileCreate {
+	if fs != nil {
+		fc.SetScanStatus(*fs)
+	}
+	return fc
+}
+
+// SetScanResult sets the "scan_result" field.
+func (fc *FileCreate) SetScanResult(s string) *FileCreate {
+	fc.mutation.SetScanResult(s)
+	return fc
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (fc *FileCreate) SetNillableScanResult(s *string) *FileCreate {
+	if s != nil {
+		fc.SetScanResult(*s)
+	}
+	return fc
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (fc *FileCreate) SetScannedAt(t time.Time) *FileCreate {
+	fc.mutation.SetScannedAt(t)
+	return fc
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (fc *FileCreate) SetNillableScannedAt(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetScannedAt(*t)
+	}
+	return fc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fc *FileCreate) SetCreatedAt(t time.Time) *FileCreate {
 	fc.mutation.SetCreatedAt(t)
@@ -107,6 +290,11 @@ func (fc *FileCreate) SetNillableDeletedAt(t *time.Time) *FileCreate {
 	return fc
 }
 
+// SetBlob sets the "blob" edge to the Blob entity.
+func (fc *FileCreate) SetBlob(b *Blob) *FileCreate {
+	return fc.SetBlobID(b.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fc *FileCreate) Mutation() *FileMutation {
 	return fc.mutation
@@ -146,6 +334,46 @@ func (fc *FileCreate) defaults() {
 		v := file.DefaultUID()
 		fc.mutation.SetUID(v)
 	}
+	if _, ok := fc.mutation.LogicalPath(); !ok {
+		v := file.DefaultLogicalPath
+		fc.mutation.SetLogicalPath(v)
+	}
+	if _, ok := fc.mutation.Version(); !ok {
+		v := file.DefaultVersion
+		fc.mutation.SetVersion(v)
+	}
+	if _, ok := fc.mutation.DetectedMimeType(); !ok {
+		v := file.DefaultDetectedMimeType
+		fc.mutation.SetDetectedMimeType(v)
+	}
+	if _, ok := fc.mutation.Etag(); !ok {
+		v := file.DefaultEtag
+		fc.mutation.SetEtag(v)
+	}
+	if _, ok := fc.mutation.Sha256(); !ok {
+		v := file.DefaultSha256
+		fc.mutation.SetSha256(v)
+	}
+	if _, ok := fc.mutation.Md5(); !ok {
+		v := file.DefaultMd5
+		fc.mutation.SetMd5(v)
+	}
+	if _, ok := fc.mutation.Status(); !ok {
+		v := file.DefaultStatus
+		fc.mutation.SetStatus(v)
+	}
+	if _, ok := fc.mutation.Visibility(); !ok {
+		v := file.DefaultVisibility
+		fc.mutation.SetVisibility(v)
+	}
+	if _, ok := fc.mutation.ScanStatus(); !ok {
+		v := file.DefaultScanStatus
+		fc.mutation.SetScanStatus(v)
+	}
+	if _, ok := fc.mutation.ScanResult(); !ok {
+		v := file.DefaultScanResult
+		fc.mutation.SetScanResult(v)
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		v := file.DefaultCreatedAt()
 		fc.mutation.SetCreatedAt(v)
@@ -170,12 +398,39 @@ func (fc *FileCreate) check() error {
 	if _, ok := fc.mutation.ObjectPath(); !ok {
 		return &ValidationError{Name: "object_path", err: errors.New(`ent: missing required field "File.object_path"`)}
 	}
+	if _, ok := fc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "File.version"`)}
+	}
 	if _, ok := fc.mutation.Size(); !ok {
 		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
 	}
 	if _, ok := fc.mutation.MimeType(); !ok {
 		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "File.mime_type"`)}
 	}
+	if _, ok := fc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "File.status"`)}
+	}
+	if v, ok := fc.mutation.Status(); ok {
+		if err := file.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "File.status": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "File.visibility"`)}
+	}
+	if v, ok := fc.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.ScanStatus(); !ok {
+		return &ValidationError{Name: "scan_status", err: errors.New(`ent: missing required field "File.scan_status"`)}
+	}
+	if v, ok := fc.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "File.created_at"`)}
 	}
@@ -224,6 +479,14 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldObjectPath, field.TypeString, value)
 		_node.ObjectPath = value
 	}
+	if value, ok := fc.mutation.LogicalPath(); ok {
+		_spec.SetField(file.FieldLogicalPath, field.TypeString, value)
+		_node.LogicalPath = value
+	}
+	if value, ok := fc.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := fc.mutation.Size(); ok {
 		_spec.SetField(file.FieldSize, field.TypeInt, value)
 		_node.Size = value
@@ -232,6 +495,46 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldMimeType, field.TypeString, value)
 		_node.MimeType = value
 	}
+	if value, ok := fc.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+		_node.DetectedMimeType = value
+	}
+	if value, ok := fc.mutation.Etag(); ok {
+		_spec.SetField(file.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := fc.mutation.LastModified(); ok {
+		_spec.SetField(file.FieldLastModified, field.TypeTime, value)
+		_node.LastModified = &value
+	}
+	if value, ok := fc.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := fc.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+		_node.Md5 = value
+	}
+	if value, ok := fc.mutation.Status(); ok {
+		_spec.SetField(file.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := fc.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := fc.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = value
+	}
+	if value, ok := fc.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+		_node.ScanResult = value
+	}
+	if value, ok := fc.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = &value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -244,6 +547,26 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if nodes := fc.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.BlobTable,
+			Columns: []string{file.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlobID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"storage/ent/blob"
 	"storage/ent/file"
 	"storage/ent/predicate"
 
@@ -21,6 +22,7 @@ type FileQuery struct {
 	order      []OrderFunc
 	inters     []Interceptor
 	predicates []predicate.File
+	withBlob   *BlobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -57,6 +59,28 @@ func (fq *FileQuery) Order(o ...OrderFunc) *FileQuery {
 	return fq
 }
 
+// QueryBlob chains the current query on the "blob" edge.
+func (fq *FileQuery) QueryBlob() *BlobQuery {
+	query := (&BlobClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, file.BlobTable, file.BlobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity from the query.
 // Returns a *NotFoundError when no File was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
@@ -249,12 +273,24 @@ func (fq *FileQuery) Clone() *FileQuery {
 		order:      append([]OrderFunc{}, fq.order...),
 		inters:     append([]Interceptor{}, fq.inters...),
 		predicates: append([]predicate.File{}, fq.predicates...),
+		withBlob:   fq.withBlob.Clone(),
 		// clone intermediate query.
 		sql:  fq.sql.Clone(),
 		path: fq.path,
 	}
 }
 
+// WithBlob tells the query-builder to eager-load the nodes that are connected to
+// the "blob" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithBlob(opts ...func(*BlobQuery)) *FileQuery {
+	query := (&BlobClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withBlob = query
+	return fq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -331,8 +367,11 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 
 func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, error) {
 	var (
-		nodes = []*File{}
-		_spec = fq.querySpec()
+		nodes       = []*File{}
+		_spec       = fq.querySpec()
+		loadedTypes = [1]bool{
+			fq.withBlob != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*File).scanValues(nil, columns)
@@ -340,6 +379,7 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &File{config: fq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -351,9 +391,48 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := fq.withBlob; query != nil {
+		if err := fq.loadBlob(ctx, query, nodes, nil,
+			func(n *File, e *Blob) { n.Edges.Blob = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (fq *FileQuery) loadBlob(ctx context.Context, query *BlobQuery, nodes []*File, init func(*File), assign func(*File, *Blob)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*File)
+	for i := range nodes {
+		if nodes[i].BlobID == nil {
+			continue
+		}
+		fk := *nodes[i].BlobID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(blob.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blob_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
 	_spec.Node.Columns = fq.ctx.Fields
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"storage/ent/blob"
 	"storage/ent/file"
 	"storage/ent/predicate"
 	"time"
@@ -68,6 +69,47 @@ func (fu *FileUpdate) SetObjectPath(s string) *FileUpdate {
 	return fu
 }
 
+// SetLogicalPath sets the "logical_path" field.
+func (fu *FileUpdate) SetLogicalPath(s string) *FileUpdate {
+	fu.mutation.SetLogicalPath(s)
+	return fu
+}
+
+// SetNillableLogicalPath sets the "logical_path" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableLogicalPath(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetLogicalPath(*s)
+	}
+	return fu
+}
+
+// ClearLogicalPath clears the value of the "logical_path" field.
+func (fu *FileUpdate) ClearLogicalPath() *FileUpdate {
+	fu.mutation.ClearLogicalPath()
+	return fu
+}
+
+// SetVersion sets the "version" field.
+func (fu *FileUpdate) SetVersion(i int) *FileUpdate {
+	fu.mutation.ResetVersion()
+	fu.mutation.SetVersion(i)
+	return fu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableVersion(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetVersion(*i)
+	}
+	return fu
+}
+
+// AddVersion adds i to the "version" field.
+func (fu *FileUpdate) AddVersion(i int) *FileUpdate {
+	fu.mutation.AddVersion(i)
+	return fu
+}
+
 // SetSize sets the "size" field.
 func (fu *FileUpdate) SetSize(i int) *FileUpdate {
 	fu.mutation.ResetSize()
@@ -87,6 +129,208 @@ func (fu *FileUpdate) SetMimeType(s string) *FileUpdate {
 	return fu
 }
 
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (fu *FileUpdate) SetDetectedMimeType(s string) *FileUpdate {
+	fu.mutation.SetDetectedMimeType(s)
+	return fu
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableDetectedMimeType(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetDetectedMimeType(*s)
+	}
+	return fu
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (fu *FileUpdate) ClearDetectedMimeType() *FileUpdate {
+	fu.mutation.ClearDetectedMimeType()
+	return fu
+}
+
+// SetEtag sets the "etag" field.
+func (fu *FileUpdate) SetEtag(s string) *FileUpdate {
+	fu.mutation.SetEtag(s)
+	return fu
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableEtag(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetEtag(*s)
+	}
+	return fu
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (fu *FileUpdate) ClearEtag() *FileUpdate {
+	fu.mutation.ClearEtag()
+	return fu
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fu *FileUpdate) SetLastModified(t time.Time) *FileUpdate {
+	fu.mutation.SetLastModified(t)
+	return fu
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableLastModified(t *time.Time) *FileUpdate {
+	if t != nil {
+		fu.SetLastModified(*t)
+	}
+	return fu
+}
+
+// ClearLastModified clears the value of the "last_modified" field.
+func (fu *FileUpdate) ClearLastModified() *FileUpdate {
+	fu.mutation.ClearLastModified()
+	return fu
+}
+
+// SetSha256 sets the "sha256" field.
+func (fu *FileUpdate) SetSha256(s string) *FileUpdate {
+	fu.mutation.SetSha256(s)
+	return fu
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableSha256(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetSha256(*s)
+	}
+	return fu
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (fu *FileUpdate) ClearSha256() *FileUpdate {
+	fu.mutation.ClearSha256()
+	return fu
+}
+
+// SetMd5 sets the "md5" field.
+func (fu *FileUpdate) SetMd5(s string) *FileUpdate {
+	fu.mutation.SetMd5(s)
+	return fu
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableMd5(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetMd5(*s)
+	}
+	return fu
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (fu *FileUpdate) ClearMd5() *FileUpdate {
+	fu.mutation.ClearMd5()
+	return fu
+}
+
+// SetBlobID sets the "blob_id" field.
+func (fu *FileUpdate) SetBlobID(i int) *FileUpdate {
+	fu.mutation.SetBlobID(i)
+	return fu
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableBlobID(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetBlobID(*i)
+	}
+	return fu
+}
+
+// ClearBlobID clears the value of the "blob_id" field.
+func (fu *FileUpdate) ClearBlobID() *FileUpdate {
+	fu.mutation.ClearBlobID()
+	return fu
+}
+
+// SetStatus sets the "status" field.
+func (fu *FileUpdate) SetStatus(f file.Status) *FileUpdate {
+	fu.mutation.SetStatus(f)
+	return fu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableStatus(f *file.Status) *FileUpdate {
+	if f != nil {
+		fu.SetStatus(*f)
+	}
+	return fu
+}
+
+// SetVisibility sets the "visibility" field.
+func (fu *FileUpdate) SetVisibility(f file.Visibility) *FileUpdate {
+	fu.mutation.SetVisibility(f)
+	return fu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableVisibility(f *file.Visibility) *FileUpdate {
+	if f != nil {
+		fu.SetVisibility(*f)
+	}
+	return fu
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (fu *FileUpdate) SetScanStatus(fs file.ScanStatus) *FileUpdate {
+	fu.mutation.SetScanStatus(fs)
+	return fu
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableScanStatus(fs *file.ScanStatus) *FileUpdate {
+	if fs != nil {
+		fu.SetScanStatus(*fs)
+	}
+	return fu
+}
+
+// SetScanResult sets the "scan_result" field.
+func (fu *FileUpdate) SetScanResult(s string) *FileUpdate {
+	fu.mutation.SetScanResult(s)
+	return fu
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableScanResult(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetScanResult(*s)
+	}
+	return fu
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (fu *FileUpdate) ClearScanResult() *FileUpdate {
+	fu.mutation.ClearScanResult()
+	return fu
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (fu *FileUpdate) SetScannedAt(t time.Time) *FileUpdate {
+	fu.mutation.SetScannedAt(t)
+	return fu
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableScannedAt(t *time.Time) *FileUpdate {
+	if t != nil {
+		fu.SetScannedAt(*t)
+	}
+	return fu
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (fu *FileUpdate) ClearScannedAt() *FileUpdate {
+	fu.mutation.ClearScannedAt()
+	return fu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fu *FileUpdate) SetUpdatedAt(t time.Time) *FileUpdate {
 	fu.mutation.SetUpdatedAt(t)
@@ -113,11 +357,22 @@ func (fu *FileUpdate) ClearDeletedAt() *FileUpdate {
 	return fu
 }
 
+// SetBlob sets the "blob" edge to the Blob entity.
+func (fu *FileUpdate) SetBlob(b *Blob) *FileUpdate {
+	return fu.SetBlobID(b.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fu *FileUpdate) Mutation() *FileMutation {
 	return fu.mutation
 }
 
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (fu *FileUpdate) ClearBlob() *FileUpdate {
+	fu.mutation.ClearBlob()
+	return fu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	fu.defaults()
@@ -154,7 +409,30 @@ func (fu *FileUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fu *FileUpdate) check() error {
+	if v, ok := fu.mutation.Status(); ok {
+		if err := file.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "File.status": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt))
 	if ps := fu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -178,6 +456,18 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.ObjectPath(); ok {
 		_spec.SetField(file.FieldObjectPath, field.TypeString, value)
 	}
+	if value, ok := fu.mutation.LogicalPath(); ok {
+		_spec.SetField(file.FieldLogicalPath, field.TypeString, value)
+	}
+	if fu.mutation.LogicalPathCleared() {
+		_spec.ClearField(file.FieldLogicalPath, field.TypeString)
+	}
+	if value, ok := fu.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedVersion(); ok {
+		_spec.AddField(file.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := fu.mutation.Size(); ok {
 		_spec.SetField(file.FieldSize, field.TypeInt, value)
 	}
@@ -187,6 +477,57 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.MimeType(); ok {
 		_spec.SetField(file.FieldMimeType, field.TypeString, value)
 	}
+	if value, ok := fu.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if fu.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(file.FieldDetectedMimeType, field.TypeString)
+	}
+	if value, ok := fu.mutation.Etag(); ok {
+		_spec.SetField(file.FieldEtag, field.TypeString, value)
+	}
+	if fu.mutation.EtagCleared() {
+		_spec.ClearField(file.FieldEtag, field.TypeString)
+	}
+	if value, ok := fu.mutation.LastModified(); ok {
+		_spec.SetField(file.FieldLastModified, field.TypeTime, value)
+	}
+	if fu.mutation.LastModifiedCleared() {
+		_spec.ClearField(file.FieldLastModified, field.TypeTime)
+	}
+	if value, ok := fu.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if fu.mutation.Sha256Cleared() {
+		_spec.ClearField(file.FieldSha256, field.TypeString)
+	}
+	if value, ok := fu.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+	}
+	if fu.mutation.Md5Cleared() {
+		_spec.ClearField(file.FieldMd5, field.TypeString)
+	}
+	if value, ok := fu.mutation.Status(); ok {
+		_spec.SetField(file.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := fu.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+	}
+	if fu.mutation.ScanResultCleared() {
+		_spec.ClearField(file.FieldScanResult, field.TypeString)
+	}
+	if value, ok := fu.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+	}
+	if fu.mutation.ScannedAtCleared() {
+		_spec.ClearField(file.FieldScannedAt, field.TypeTime)
+	}
 	if value, ok := fu.mutation.UpdatedAt(); ok {
 		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -196,6 +537,41 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if fu.mutation.DeletedAtCleared() {
 		_spec.ClearField(file.FieldDeletedAt, field.TypeTime)
 	}
+	if fu.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.BlobTable,
+			Columns: []string{file.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.BlobTable,
+			Columns: []string{file.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -255,6 +631,47 @@ func (fuo *FileUpdateOne) SetObjectPath(s string) *FileUpdateOne {
 	return fuo
 }
 
+// SetLogicalPath sets the "logical_path" field.
+func (fuo *FileUpdateOne) SetLogicalPath(s string) *FileUpdateOne {
+	fuo.mutation.SetLogicalPath(s)
+	return fuo
+}
+
+// SetNillableLogicalPath sets the "logical_path" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableLogicalPath(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetLogicalPath(*s)
+	}
+	return fuo
+}
+
+// ClearLogicalPath clears the value of the "logical_path" field.
+func (fuo *FileUpdateOne) ClearLogicalPath() *FileUpdateOne {
+	fuo.mutation.ClearLogicalPath()
+	return fuo
+}
+
+// SetVersion sets the "version" field.
+func (fuo *FileUpdateOne) SetVersion(i int) *FileUpdateOne {
+	fuo.mutation.ResetVersion()
+	fuo.mutation.SetVersion(i)
+	return fuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableVersion(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetVersion(*i)
+	}
+	return fuo
+}
+
+// AddVersion adds i to the "version" field.
+func (fuo *FileUpdateOne) AddVersion(i int) *FileUpdateOne {
+	fuo.mutation.AddVersion(i)
+	return fuo
+}
+
 // SetSize sets the "size" field.
 func (fuo *FileUpdateOne) SetSize(i int) *FileUpdateOne {
 	fuo.mutation.ResetSize()
@@ -274,6 +691,208 @@ func (fuo *FileUpdateOne) SetMimeType(s string) *FileUpdateOne {
 	return fuo
 }
 
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (fuo *FileUpdateOne) SetDetectedMimeType(s string) *FileUpdateOne {
+	fuo.mutation.SetDetectedMimeType(s)
+	return fuo
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableDetectedMimeType(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetDetectedMimeType(*s)
+	}
+	return fuo
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (fuo *FileUpdateOne) ClearDetectedMimeType() *FileUpdateOne {
+	fuo.mutation.ClearDetectedMimeType()
+	return fuo
+}
+
+// SetEtag sets the "etag" field.
+func (fuo *FileUpdateOne) SetEtag(s string) *FileUpdateOne {
+	fuo.mutation.SetEtag(s)
+	return fuo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableEtag(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetEtag(*s)
+	}
+	return fuo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (fuo *FileUpdateOne) ClearEtag() *FileUpdateOne {
+	fuo.mutation.ClearEtag()
+	return fuo
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fuo *FileUpdateOne) SetLastModified(t time.Time) *FileUpdateOne {
+	fuo.mutation.SetLastModified(t)
+	return fuo
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableLastModified(t *time.Time) *FileUpdateOne {
+	if t != nil {
+		fuo.SetLastModified(*t)
+	}
+	return fuo
+}
+
+// ClearLastModified clears the value of the "last_modified" field.
+func (fuo *FileUpdateOne) ClearLastModified() *FileUpdateOne {
+	fuo.mutation.ClearLastModified()
+	return fuo
+}
+
+// SetSha256 sets the "sha256" field.
+func (fuo *FileUpdateOne) SetSha256(s string) *FileUpdateOne {
+	fuo.mutation.SetSha256(s)
+	return fuo
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableSha256(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetSha256(*s)
+	}
+	return fuo
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (fuo *FileUpdateOne) ClearSha256() *FileUpdateOne {
+	fuo.mutation.ClearSha256()
+	return fuo
+}
+
+// SetMd5 sets the "md5" field.
+func (fuo *FileUpdateOne) SetMd5(s string) *FileUpdateOne {
+	fuo.mutation.SetMd5(s)
+	return fuo
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableMd5(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetMd5(*s)
+	}
+	return fuo
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (fuo *FileUpdateOne) ClearMd5() *FileUpdateOne {
+	fuo.mutation.ClearMd5()
+	return fuo
+}
+
+// SetBlobID sets the "blob_id" field.
+func (fuo *FileUpdateOne) SetBlobID(i int) *FileUpdateOne {
+	fuo.mutation.SetBlobID(i)
+	return fuo
+}
+
+// SetNillableBlobID sets the "blob_id" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableBlobID(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetBlobID(*i)
+	}
+	return fuo
+}
+
+// ClearBlobID clears the value of the "blob_id" field.
+func (fuo *FileUpdateOne) ClearBlobID() *FileUpdateOne {
+	fuo.mutation.ClearBlobID()
+	return fuo
+}
+
+// SetStatus sets the "status" field.
+func (fuo *FileUpdateOne) SetStatus(f file.Status) *FileUpdateOne {
+	fuo.mutation.SetStatus(f)
+	return fuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableStatus(f *file.Status) *FileUpdateOne {
+	if f != nil {
+		fuo.SetStatus(*f)
+	}
+	return fuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (fuo *FileUpdateOne) SetVisibility(f file.Visibility) *FileUpdateOne {
+	fuo.mutation.SetVisibility(f)
+	return fuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableVisibility(f *file.Visibility) *FileUpdateOne {
+	if f != nil {
+		fuo.SetVisibility(*f)
+	}
+	return fuo
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (fuo *FileUpdateOne) SetScanStatus(fs file.ScanStatus) *FileUpdateOne {
+	fuo.mutation.SetScanStatus(fs)
+	return fuo
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableScanStatus(fs *file.ScanStatus) *FileUpdateOne {
+	if fs != nil {
+		fuo.SetScanStatus(*fs)
+	}
+	return fuo
+}
+
+// SetScanResult sets the "scan_result" field.
+func (fuo *FileUpdateOne) SetScanResult(s string) *FileUpdateOne {
+	fuo.mutation.SetScanResult(s)
+	return fuo
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableScanResult(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetScanResult(*s)
+	}
+	return fuo
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (fuo *FileUpdateOne) ClearScanResult() *FileUpdateOne {
+	fuo.mutation.ClearScanResult()
+	return fuo
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (fuo *FileUpdateOne) SetScannedAt(t time.Time) *FileUpdateOne {
+	fuo.mutation.SetScannedAt(t)
+	return fuo
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableScannedAt(t *time.Time) *FileUpdateOne {
+	if t != nil {
+		fuo.SetScannedAt(*t)
+	}
+	return fuo
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (fuo *FileUpdateOne) ClearScannedAt() *FileUpdateOne {
+	fuo.mutation.ClearScannedAt()
+	return fuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fuo *FileUpdateOne) SetUpdatedAt(t time.Time) *FileUpdateOne {
 	fuo.mutation.SetUpdatedAt(t)
@@ -300,11 +919,22 @@ func (fuo *FileUpdateOne) ClearDeletedAt() *FileUpdateOne {
 	return fuo
 }
 
+// SetBlob sets the "blob" edge to the Blob entity.
+func (fuo *FileUpdateOne) SetBlob(b *Blob) *FileUpdateOne {
+	return fuo.SetBlobID(b.ID)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fuo *FileUpdateOne) Mutation() *FileMutation {
 	return fuo.mutation
 }
 
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (fuo *FileUpdateOne) ClearBlob() *FileUpdateOne {
+	fuo.mutation.ClearBlob()
+	return fuo
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -354,7 +984,30 @@ func (fuo *FileUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FileUpdateOne) check() error {
+	if v, ok := fuo.mutation.Status(); ok {
+		if err := file.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "File.status": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Visibility(); ok {
+		if err := file.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "File.visibility": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt))
 	id, ok := fuo.mutation.ID()
 	if !ok {
@@ -395,6 +1048,18 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	if value, ok := fuo.mutation.ObjectPath(); ok {
 		_spec.SetField(file.FieldObjectPath, field.TypeString, value)
 	}
+	if value, ok := fuo.mutation.LogicalPath(); ok {
+		_spec.SetField(file.FieldLogicalPath, field.TypeString, value)
+	}
+	if fuo.mutation.LogicalPathCleared() {
+		_spec.ClearField(file.FieldLogicalPath, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Version(); ok {
+		_spec.SetField(file.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedVersion(); ok {
+		_spec.AddField(file.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := fuo.mutation.Size(); ok {
 		_spec.SetField(file.FieldSize, field.TypeInt, value)
 	}
@@ -404,6 +1069,57 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	if value, ok := fuo.mutation.MimeType(); ok {
 		_spec.SetField(file.FieldMimeType, field.TypeString, value)
 	}
+	if value, ok := fuo.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if fuo.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(file.FieldDetectedMimeType, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Etag(); ok {
+		_spec.SetField(file.FieldEtag, field.TypeString, value)
+	}
+	if fuo.mutation.EtagCleared() {
+		_spec.ClearField(file.FieldEtag, field.TypeString)
+	}
+	if value, ok := fuo.mutation.LastModified(); ok {
+		_spec.SetField(file.FieldLastModified, field.TypeTime, value)
+	}
+	if fuo.mutation.LastModifiedCleared() {
+		_spec.ClearField(file.FieldLastModified, field.TypeTime)
+	}
+	if value, ok := fuo.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if fuo.mutation.Sha256Cleared() {
+		_spec.ClearField(file.FieldSha256, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Md5(); ok {
+		_spec.SetField(file.FieldMd5, field.TypeString, value)
+	}
+	if fuo.mutation.Md5Cleared() {
+		_spec.ClearField(file.FieldMd5, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Status(); ok {
+		_spec.SetField(file.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.Visibility(); ok {
+		_spec.SetField(file.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := fuo.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+	}
+	if fuo.mutation.ScanResultCleared() {
+		_spec.ClearField(file.FieldScanResult, field.TypeString)
+	}
+	if value, ok := fuo.mutation.ScannedAt(); ok {
+		_spec.SetField(file.FieldScannedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.ScannedAtCleared() {
+		_spec.ClearField(file.FieldScannedAt, field.TypeTime)
+	}
 	if value, ok := fuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(file.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -413,6 +1129,41 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	if fuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(file.FieldDeletedAt, field.TypeTime)
 	}
+	if fuo.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.BlobTable,
+			Columns: []string{file.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   file.BlobTable,
+			Columns: []string{file.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &File{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
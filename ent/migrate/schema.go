@@ -8,6 +8,30 @@ import (
 )
 
 var (
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "object_path", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "etag", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "ref_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blob_sha256",
+				Unique:  true,
+				Columns: []*schema.Column{BlobsColumns[1]},
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,17 +39,38 @@ var (
 		{Name: "user_id", Type: field.TypeInt},
 		{Name: "filename", Type: field.TypeString},
 		{Name: "object_path", Type: field.TypeString},
+		{Name: "logical_path", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "size", Type: field.TypeInt},
 		{Name: "mime_type", Type: field.TypeString},
+		{Name: "detected_mime_type", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "etag", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "last_modified", Type: field.TypeTime, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "md5", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "failed", "deleted", "purged"}, Default: "active"},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "authenticated", "owner", "shared"}, Default: "public"},
+		{Name: "scan_status", Type: field.TypeEnum, Enums: []string{"skipped", "quarantined", "clean", "infected"}, Default: "skipped"},
+		{Name: "scan_result", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "scanned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "blob_id", Type: field.TypeInt, Nullable: true},
 	}
 	// FilesTable holds the schema information for the "files" table.
 	FilesTable = &schema.Table{
 		Name:       "files",
 		Columns:    FilesColumns,
 		PrimaryKey: []*schema.Column{FilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "files_blobs_blob",
+				Columns:    []*schema.Column{FilesColumns[22]},
+				RefColumns: []*schema.Column{BlobsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "file_uid",
@@ -40,7 +85,12 @@ var (
 			{
 				Name:    "file_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{FilesColumns[9]},
+				Columns: []*schema.Column{FilesColumns[21]},
+			},
+			{
+				Name:    "file_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[14]},
 			},
 			{
 				Name:    "file_filename",
@@ -49,16 +99,153 @@ var (
 			},
 			{
 				Name:    "file_object_path",
-				Unique:  true,
+				Unique:  false,
 				Columns: []*schema.Column{FilesColumns[4]},
 			},
+			{
+				Name:    "file_blob_id",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[22]},
+			},
+			{
+				Name:    "file_logical_path_version",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[5], FilesColumns[6]},
+			},
+			{
+				Name:    "file_scan_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[16]},
+			},
+		},
+	}
+	// MultipartsColumns holds the columns for the "multiparts" table.
+	MultipartsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "object_path", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "detected_mime_type", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "upload_id", Type: field.TypeString},
+		{Name: "upload_length", Type: field.TypeInt, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "authenticated", "owner", "shared"}, Default: "public"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "completed", "aborted"}, Default: "active"},
+		{Name: "file_uid", Type: field.TypeUUID, Nullable: true},
+		{Name: "lock_uid", Type: field.TypeUUID, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// MultipartsTable holds the schema information for the "multiparts" table.
+	MultipartsTable = &schema.Table{
+		Name:       "multiparts",
+		Columns:    MultipartsColumns,
+		PrimaryKey: []*schema.Column{MultipartsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "multipart_uid",
+				Unique:  true,
+				Columns: []*schema.Column{MultipartsColumns[1]},
+			},
+			{
+				Name:    "multipart_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MultipartsColumns[2]},
+			},
+			{
+				Name:    "multipart_status",
+				Unique:  false,
+				Columns: []*schema.Column{MultipartsColumns[10]},
+			},
+			{
+				Name:    "multipart_object_path",
+				Unique:  false,
+				Columns: []*schema.Column{MultipartsColumns[4]},
+			},
+		},
+	}
+	// MultipartPartsColumns holds the columns for the "multipart_parts" table.
+	MultipartPartsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "multipart_id", Type: field.TypeInt},
+		{Name: "part_number", Type: field.TypeInt},
+		{Name: "etag", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// MultipartPartsTable holds the schema information for the "multipart_parts" table.
+	MultipartPartsTable = &schema.Table{
+		Name:       "multipart_parts",
+		Columns:    MultipartPartsColumns,
+		PrimaryKey: []*schema.Column{MultipartPartsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "multipartpart_multipart_id_part_number",
+				Unique:  true,
+				Columns: []*schema.Column{MultipartPartsColumns[1], MultipartPartsColumns[2]},
+			},
+		},
+	}
+	// ShareLinksColumns holds the columns for the "share_links" table.
+	ShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeUUID},
+		{Name: "token", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_downloads", Type: field.TypeInt, Nullable: true},
+		{Name: "downloads", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "file_id", Type: field.TypeInt},
+	}
+	// ShareLinksTable holds the schema information for the "share_links" table.
+	ShareLinksTable = &schema.Table{
+		Name:       "share_links",
+		Columns:    ShareLinksColumns,
+		PrimaryKey: []*schema.Column{ShareLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_links_files_file",
+				Columns:    []*schema.Column{ShareLinksColumns[11]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharelink_uid",
+				Unique:  true,
+				Columns: []*schema.Column{ShareLinksColumns[1]},
+			},
+			{
+				Name:    "sharelink_token",
+				Unique:  true,
+				Columns: []*schema.Column{ShareLinksColumns[2]},
+			},
+			{
+				Name:    "sharelink_file_id",
+				Unique:  false,
+				Columns: []*schema.Column{ShareLinksColumns[11]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BlobsTable,
 		FilesTable,
+		MultipartsTable,
+		MultipartPartsTable,
+		ShareLinksTable,
 	}
 )
 
 func init() {
+	FilesTable.ForeignKeys[0].RefTable = BlobsTable
+	ShareLinksTable.ForeignKeys[0].RefTable = FilesTable
 }
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"storage/ent/multipart"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Multipart is the model entity for the Multipart schema.
+type Multipart struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// unique multipart upload identifier
+	UID uuid.UUID `json:"uid,omitempty"`
+	// user identification number
+	UserID int `json:"user_id,omitempty"`
+	// filename of uploading file
+	Filename string `json:"filename,omitempty"`
+	// path to file object in s3 storage
+	ObjectPath string `json:"object_path,omitempty"`
+	// file mime type declared by extension of filename
+	MimeType string `json:"mime_type,omitempty"`
+	// file mime type detected by first bytes of the first part
+	DetectedMimeType string `json:"detected_mime_type,omitempty"`
+	// multipart upload identifier in s3 storage
+	UploadID string `json:"upload_id,omitempty"`
+	// declared total size of file in bytes, known only for tus uploads
+	UploadLength *int `json:"upload_length,omitempty"`
+	// visibility of file created after completion
+	Visibility multipart.Visibility `json:"visibility,omitempty"`
+	// status of multipart upload
+	Status multipart.Status `json:"status,omitempty"`
+	// uid of file created after completion
+	FileUID *uuid.UUID `json:"file_uid,omitempty"`
+	// identifier of request which appends chunk to tus upload at the moment
+	LockUID *uuid.UUID `json:"lock_uid,omitempty"`
+	// time until which tus upload stays locked by request unless lock is renewed
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// creation time of multipart upload
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of multipart upload
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Multipart) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case multipart.FieldFileUID, multipart.FieldLockUID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case multipart.FieldID, multipart.FieldUserID, multipart.FieldUploadLength:
+			values[i] = new(sql.NullInt64)
+		case multipart.FieldFilename, multipart.FieldObjectPath, multipart.FieldMimeType, multipart.FieldDetectedMimeType, multipart.FieldUploadID, multipart.FieldVisibility, multipart.FieldStatus:
+			values[i] = new(sql.NullString)
+		case multipart.FieldLockedUntil, multipart.FieldCreatedAt, multipart.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case multipart.FieldUID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Multipart", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Multipart fields.
+func (m *Multipart) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case multipart.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case multipart.FieldUID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value != nil {
+				m.UID = *value
+			}
+		case multipart.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				m.UserID = int(value.Int64)
+			}
+		case multipart.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				m.Filename = value.String
+			}
+		case multipart.FieldObjectPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_path", values[i])
+			} else if value.Valid {
+				m.ObjectPath = value.String
+			}
+		case multipart.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				m.MimeType = value.String
+			}
+		case multipart.FieldDetectedMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_mime_type", values[i])
+			} else if value.Valid {
+				m.DetectedMimeType = value.String
+			}
+		case multipart.FieldUploadID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_id", values[i])
+			} else if value.Valid {
+				m.UploadID = value.String
+			}
+		case multipart.FieldUploadLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_length", values[i])
+			} else if value.Valid {
+				m.UploadLength = new(int)
+				*m.UploadLength = int(value.Int64)
+			}
+		case multipart.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				m.Visibility = multipart.Visibility(value.String)
+			}
+		case multipart.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				m.Status = multipart.Status(value.String)
+			}
+		case multipart.FieldFileUID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field file_uid", values[i])
+			} else if value.Valid {
+				m.FileUID = new(uuid.UUID)
+				*m.FileUID = *value.S.(*uuid.UUID)
+			}
+		case multipart.FieldLockUID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_uid", values[i])
+			} else if value.Valid {
+				m.LockUID = new(uuid.UUID)
+				*m.LockUID = *value.S.(*uuid.UUID)
+			}
+		case multipart.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				m.LockedUntil = new(time.Time)
+				*m.LockedUntil = value.Time
+			}
+		case multipart.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case multipart.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Multipart.
+// Note that you need to call Multipart.Unwrap() before calling this method if this Multipart
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Multipart) Update() *MultipartUpdateOne {
+	return NewMultipartClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Multipart entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Multipart) Unwrap() *Multipart {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Multipart is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Multipart) String() string {
+	var builder strings.Builder
+	builder.WriteString("Multipart(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("uid=")
+	builder.WriteString(fmt.Sprintf("%v", m.UID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("object_path=")
+	builder.WriteString(m.ObjectPath)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(m.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("detected_mime_type=")
+	builder.WriteString(m.DetectedMimeType)
+	builder.WriteString(", ")
+	builder.WriteString("upload_id=")
+	builder.WriteString(m.UploadID)
+	builder.WriteString(", ")
+	if v := m.UploadLength; v != nil {
+		builder.WriteString("upload_length=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", m.Status))
+	builder.WriteString(", ")
+	if v := m.FileUID; v != nil {
+		builder.WriteString("file_uid=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.LockUID; v != nil {
+		builder.WriteString("lock_uid=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Multiparts is a parsable slice of Multipart.
+type Multiparts []*Multipart
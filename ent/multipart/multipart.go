@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package multipart
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the multipart type in the database.
+	Label = "multipart"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldFilename holds the string denoting the filename field in the database.
+	FieldFilename = "filename"
+	// FieldObjectPath holds the string denoting the object_path field in the database.
+	FieldObjectPath = "object_path"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
+	// FieldDetectedMimeType holds the string denoting the detected_mime_type field in the database.
+	FieldDetectedMimeType = "detected_mime_type"
+	// FieldUploadID holds the string denoting the upload_id field in the database.
+	FieldUploadID = "upload_id"
+	// FieldUploadLength holds the string denoting the upload_length field in the database.
+	FieldUploadLength = "upload_length"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldFileUID holds the string denoting the file_uid field in the database.
+	FieldFileUID = "file_uid"
+	// FieldLockUID holds the string denoting the lock_uid field in the database.
+	FieldLockUID = "lock_uid"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the multipart in the database.
+	Table = "multiparts"
+)
+
+// Columns holds all SQL columns for multipart fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldUserID,
+	FieldFilename,
+	FieldObjectPath,
+	FieldMimeType,
+	FieldDetectedMimeType,
+	FieldUploadID,
+	FieldUploadLength,
+	FieldVisibility,
+	FieldStatus,
+	FieldFileUID,
+	FieldLockUID,
+	FieldLockedUntil,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUID holds the default value on creation for the "uid" field.
+	DefaultUID func() uuid.UUID
+	// DefaultDetectedMimeType holds the default value on creation for the "detected_mime_type" field.
+	DefaultDetectedMimeType string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPublic is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPublic
+
+// Visibility values.
+const (
+	VisibilityPublic        Visibility = "public"
+	VisibilityAuthenticated Visibility = "authenticated"
+	VisibilityOwner         Visibility = "owner"
+	VisibilityShared        Visibility = "shared"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityAuthenticated, VisibilityOwner, VisibilityShared:
+		return nil
+	default:
+		return fmt.Errorf("multipart: invalid enum value for visibility field: %q", v)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "active"
+	StatusCompleted Status = "completed"
+	StatusAborted   Status = "aborted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusCompleted, StatusAborted:
+		return nil
+	default:
+		return fmt.Errorf("multipart: invalid enum value for status field: %q", s)
+	}
+}
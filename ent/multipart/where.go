@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package multipart
+
+import (
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldID, id))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUserID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldFilename, v))
+}
+
+// ObjectPath applies equality check predicate on the "object_path" field. It's identical to ObjectPathEQ.
+func ObjectPath(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldObjectPath, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldMimeType, v))
+}
+
+// DetectedMimeType applies equality check predicate on the "detected_mime_type" field. It's identical to DetectedMimeTypeEQ.
+func DetectedMimeType(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
+// UploadID applies equality check predicate on the "upload_id" field. It's identical to UploadIDEQ.
+func UploadID(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUploadID, v))
+}
+
+// UploadLength applies equality check predicate on the "upload_length" field. It's identical to UploadLengthEQ.
+func UploadLength(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUploadLength, v))
+}
+
+// FileUID applies equality check predicate on the "file_uid" field. It's identical to FileUIDEQ.
+func FileUID(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldFileUID, v))
+}
+
+// LockUID applies equality check predicate on the "lock_uid" field. It's identical to LockUIDEQ.
+func LockUID(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldLockUID, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldUID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldUserID, v))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// ObjectPathEQ applies the EQ predicate on the "object_path" field.
+func ObjectPathEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldObjectPath, v))
+}
+
+// ObjectPathNEQ applies the NEQ predicate on the "object_path" field.
+func ObjectPathNEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldObjectPath, v))
+}
+
+// ObjectPathIn applies the In predicate on the "object_path" field.
+func ObjectPathIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldObjectPath, vs...))
+}
+
+// ObjectPathNotIn applies the NotIn predicate on the "object_path" field.
+func ObjectPathNotIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldObjectPath, vs...))
+}
+
+// ObjectPathGT applies the GT predicate on the "object_path" field.
+func ObjectPathGT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldObjectPath, v))
+}
+
+// ObjectPathGTE applies the GTE predicate on the "object_path" field.
+func ObjectPathGTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldObjectPath, v))
+}
+
+// ObjectPathLT applies the LT predicate on the "object_path" field.
+func ObjectPathLT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldObjectPath, v))
+}
+
+// ObjectPathLTE applies the LTE predicate on the "object_path" field.
+func ObjectPathLTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldObjectPath, v))
+}
+
+// ObjectPathContains applies the Contains predicate on the "object_path" field.
+func ObjectPathContains(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContains(FieldObjectPath, v))
+}
+
+// ObjectPathHasPrefix applies the HasPrefix predicate on the "object_path" field.
+func ObjectPathHasPrefix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasPrefix(FieldObjectPath, v))
+}
+
+// ObjectPathHasSuffix applies the HasSuffix predicate on the "object_path" field.
+func ObjectPathHasSuffix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasSuffix(FieldObjectPath, v))
+}
+
+// ObjectPathEqualFold applies the EqualFold predicate on the "object_path" field.
+func ObjectPathEqualFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEqualFold(FieldObjectPath, v))
+}
+
+// ObjectPathContainsFold applies the ContainsFold predicate on the "object_path" field.
+func ObjectPathContainsFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContainsFold(FieldObjectPath, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// DetectedMimeTypeEQ applies the EQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeNEQ applies the NEQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIn applies the In predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeNotIn applies the NotIn predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeGT applies the GT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeGTE applies the GTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLT applies the LT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLTE applies the LTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContains applies the Contains predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContains(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContains(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasPrefix applies the HasPrefix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasPrefix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasPrefix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasSuffix applies the HasSuffix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasSuffix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasSuffix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIsNil applies the IsNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIsNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldIsNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeNotNil applies the NotNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeEqualFold applies the EqualFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEqualFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEqualFold(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContainsFold applies the ContainsFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContainsFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContainsFold(FieldDetectedMimeType, v))
+}
+
+// UploadIDEQ applies the EQ predicate on the "upload_id" field.
+func UploadIDEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUploadID, v))
+}
+
+// UploadIDNEQ applies the NEQ predicate on the "upload_id" field.
+func UploadIDNEQ(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldUploadID, v))
+}
+
+// UploadIDIn applies the In predicate on the "upload_id" field.
+func UploadIDIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldUploadID, vs...))
+}
+
+// UploadIDNotIn applies the NotIn predicate on the "upload_id" field.
+func UploadIDNotIn(vs ...string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldUploadID, vs...))
+}
+
+// UploadIDGT applies the GT predicate on the "upload_id" field.
+func UploadIDGT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldUploadID, v))
+}
+
+// UploadIDGTE applies the GTE predicate on the "upload_id" field.
+func UploadIDGTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldUploadID, v))
+}
+
+// UploadIDLT applies the LT predicate on the "upload_id" field.
+func UploadIDLT(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldUploadID, v))
+}
+
+// UploadIDLTE applies the LTE predicate on the "upload_id" field.
+func UploadIDLTE(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldUploadID, v))
+}
+
+// UploadIDContains applies the Contains predicate on the "upload_id" field.
+func UploadIDContains(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContains(FieldUploadID, v))
+}
+
+// UploadIDHasPrefix applies the HasPrefix predicate on the "upload_id" field.
+func UploadIDHasPrefix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasPrefix(FieldUploadID, v))
+}
+
+// UploadIDHasSuffix applies the HasSuffix predicate on the "upload_id" field.
+func UploadIDHasSuffix(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldHasSuffix(FieldUploadID, v))
+}
+
+// UploadIDEqualFold applies the EqualFold predicate on the "upload_id" field.
+func UploadIDEqualFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEqualFold(FieldUploadID, v))
+}
+
+// UploadIDContainsFold applies the ContainsFold predicate on the "upload_id" field.
+func UploadIDContainsFold(v string) predicate.Multipart {
+	return predicate.Multipart(sql.FieldContainsFold(FieldUploadID, v))
+}
+
+// UploadLengthEQ applies the EQ predicate on the "upload_length" field.
+func UploadLengthEQ(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUploadLength, v))
+}
+
+// UploadLengthNEQ applies the NEQ predicate on the "upload_length" field.
+func UploadLengthNEQ(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldUploadLength, v))
+}
+
+// UploadLengthIn applies the In predicate on the "upload_length" field.
+func UploadLengthIn(vs ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldUploadLength, vs...))
+}
+
+// UploadLengthNotIn applies the NotIn predicate on the "upload_length" field.
+func UploadLengthNotIn(vs ...int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldUploadLength, vs...))
+}
+
+// UploadLengthGT applies the GT predicate on the "upload_length" field.
+func UploadLengthGT(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldUploadLength, v))
+}
+
+// UploadLengthGTE applies the GTE predicate on the "upload_length" field.
+func UploadLengthGTE(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldUploadLength, v))
+}
+
+// UploadLengthLT applies the LT predicate on the "upload_length" field.
+func UploadLengthLT(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldUploadLength, v))
+}
+
+// UploadLengthLTE applies the LTE predicate on the "upload_length" field.
+func UploadLengthLTE(v int) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldUploadLength, v))
+}
+
+// UploadLengthIsNil applies the IsNil predicate on the "upload_length" field.
+func UploadLengthIsNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldIsNull(FieldUploadLength))
+}
+
+// UploadLengthNotNil applies the NotNil predicate on the "upload_length" field.
+func UploadLengthNotNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotNull(FieldUploadLength))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// FileUIDEQ applies the EQ predicate on the "file_uid" field.
+func FileUIDEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldFileUID, v))
+}
+
+// FileUIDNEQ applies the NEQ predicate on the "file_uid" field.
+func FileUIDNEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldFileUID, v))
+}
+
+// FileUIDIn applies the In predicate on the "file_uid" field.
+func FileUIDIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldFileUID, vs...))
+}
+
+// FileUIDNotIn applies the NotIn predicate on the "file_uid" field.
+func FileUIDNotIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldFileUID, vs...))
+}
+
+// FileUIDGT applies the GT predicate on the "file_uid" field.
+func FileUIDGT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldFileUID, v))
+}
+
+// FileUIDGTE applies the GTE predicate on the "file_uid" field.
+func FileUIDGTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldFileUID, v))
+}
+
+// FileUIDLT applies the LT predicate on the "file_uid" field.
+func FileUIDLT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldFileUID, v))
+}
+
+// FileUIDLTE applies the LTE predicate on the "file_uid" field.
+func FileUIDLTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldFileUID, v))
+}
+
+// FileUIDIsNil applies the IsNil predicate on the "file_uid" field.
+func FileUIDIsNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldIsNull(FieldFileUID))
+}
+
+// FileUIDNotNil applies the NotNil predicate on the "file_uid" field.
+func FileUIDNotNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotNull(FieldFileUID))
+}
+
+// LockUIDEQ applies the EQ predicate on the "lock_uid" field.
+func LockUIDEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldLockUID, v))
+}
+
+// LockUIDNEQ applies the NEQ predicate on the "lock_uid" field.
+func LockUIDNEQ(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldLockUID, v))
+}
+
+// LockUIDIn applies the In predicate on the "lock_uid" field.
+func LockUIDIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldLockUID, vs...))
+}
+
+// LockUIDNotIn applies the NotIn predicate on the "lock_uid" field.
+func LockUIDNotIn(vs ...uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldLockUID, vs...))
+}
+
+// LockUIDGT applies the GT predicate on the "lock_uid" field.
+func LockUIDGT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldLockUID, v))
+}
+
+// LockUIDGTE applies the GTE predicate on the "lock_uid" field.
+func LockUIDGTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldLockUID, v))
+}
+
+// LockUIDLT applies the LT predicate on the "lock_uid" field.
+func LockUIDLT(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldLockUID, v))
+}
+
+// LockUIDLTE applies the LTE predicate on the "lock_uid" field.
+func LockUIDLTE(v uuid.UUID) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldLockUID, v))
+}
+
+// LockUIDIsNil applies the IsNil predicate on the "lock_uid" field.
+func LockUIDIsNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldIsNull(FieldLockUID))
+}
+
+// LockUIDNotNil applies the NotNil predicate on the "lock_uid" field.
+func LockUIDNotNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotNull(FieldLockUID))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Multipart {
+	return predicate.Multipart(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Multipart) predicate.Multipart {
+	return predicate.Multipart(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Multipart) predicate.Multipart {
+	return predicate.Multipart(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Multipart) predicate.Multipart {
+	return predicate.Multipart(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
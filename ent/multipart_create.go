@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/multipart"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MultipartCreate is the builder for creating a Multipart entity.
+type MultipartCreate struct {
+	config
+	mutation *MultipartMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (mc *MultipartCreate) SetUID(u uuid.UUID) *MultipartCreate {
+	mc.mutation.SetUID(u)
+	return mc
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableUID(u *uuid.UUID) *MultipartCreate {
+	if u != nil {
+		mc.SetUID(*u)
+	}
+	return mc
+}
+
+// SetUserID sets the "user_id" field.
+func (mc *MultipartCreate) SetUserID(i int) *MultipartCreate {
+	mc.mutation.SetUserID(i)
+	return mc
+}
+
+// SetFilename sets the "filename" field.
+func (mc *MultipartCreate) SetFilename(s string) *MultipartCreate {
+	mc.mutation.SetFilename(s)
+	return mc
+}
+
+// SetObjectPath sets the "object_path" field.
+func (mc *MultipartCreate) SetObjectPath(s string) *MultipartCreate {
+	mc.mutation.SetObjectPath(s)
+	return mc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (mc *MultipartCreate) SetMimeType(s string) *MultipartCreate {
+	mc.mutation.SetMimeType(s)
+	return mc
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (mc *MultipartCreate) SetDetectedMimeType(s string) *MultipartCreate {
+	mc.mutation.SetDetectedMimeType(s)
+	return mc
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableDetectedMimeType(s *string) *MultipartCreate {
+	if s != nil {
+		mc.SetDetectedMimeType(*s)
+	}
+	return mc
+}
+
+// SetUploadID sets the "upload_id" field.
+func (mc *MultipartCreate) SetUploadID(s string) *MultipartCreate {
+	mc.mutation.SetUploadID(s)
+	return mc
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (mc *MultipartCreate) SetUploadLength(i int) *MultipartCreate {
+	mc.mutation.SetUploadLength(i)
+	return mc
+}
+
+// SetNillableUploadLength sets the "upload_length" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableUploadLength(i *int) *MultipartCreate {
+	if i != nil {
+		mc.SetUploadLength(*i)
+	}
+	return mc
+}
+
+// SetVisibility sets the "visibility" field.
+func (mc *MultipartCreate) SetVisibility(m multipart.Visibility) *MultipartCreate {
+	mc.mutation.SetVisibility(m)
+	return mc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableVisibility(m *multipart.Visibility) *MultipartCreate {
+	if m != nil {
+		mc.SetVisibility(*m)
+	}
+	return mc
+}
+
+// SetStatus sets the "status" field.
+func (mc *MultipartCreate) SetStatus(m multipart.Status) *MultipartCreate {
+	mc.mutation.SetStatus(m)
+	return mc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableStatus(m *multipart.Status) *MultipartCreate {
+	if m != nil {
+		mc.SetStatus(*m)
+	}
+	return mc
+}
+
+// SetFileUID sets the "file_uid" field.
+func (mc *MultipartCreate) SetFileUID(u uuid.UUID) *MultipartCreate {
+	mc.mutation.SetFileUID(u)
+	return mc
+}
+
+// SetNillableFileUID sets the "file_uid" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableFileUID(u *uuid.UUID) *MultipartCreate {
+	if u != nil {
+		mc.SetFileUID(*u)
+	}
+	return mc
+}
+
+// SetLockUID sets the "lock_uid" field.
+func (mc *MultipartCreate) SetLockUID(u uuid.UUID) *MultipartCreate {
+	mc.mutation.SetLockUID(u)
+	return mc
+}
+
+// SetNillableLockUID sets the "lock_uid" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableLockUID(u *uuid.UUID) *MultipartCreate {
+	if u != nil {
+		mc.SetLockUID(*u)
+	}
+	return mc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (mc *MultipartCreate) SetLockedUntil(t time.Time) *MultipartCreate {
+	mc.mutation.SetLockedUntil(t)
+	return mc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableLockedUntil(t *time.Time) *MultipartCreate {
+	if t != nil {
+		mc.SetLockedUntil(*t)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MultipartCreate) SetCreatedAt(t time.Time) *MultipartCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableCreatedAt(t *time.Time) *MultipartCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MultipartCreate) SetUpdatedAt(t time.Time) *MultipartCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MultipartCreate) SetNillableUpdatedAt(t *time.Time) *MultipartCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// Mutation returns the MultipartMutation object of the builder.
+func (mc *MultipartCreate) Mutation() *MultipartMutation {
+	return mc.mutation
+}
+
+// Save creates the Multipart in the database.
+func (mc *MultipartCreate) Save(ctx context.Context) (*Multipart, error) {
+	mc.defaults()
+	return withHooks[*Multipart, MultipartMutation](ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MultipartCreate) SaveX(ctx context.Context) *Multipart {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MultipartCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MultipartCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MultipartCreate) defaults() {
+	if _, ok := mc.mutation.UID(); !ok {
+		v := multipart.DefaultUID()
+		mc.mutation.SetUID(v)
+	}
+	if _, ok := mc.mutation.DetectedMimeType(); !ok {
+		v := multipart.DefaultDetectedMimeType
+		mc.mutation.SetDetectedMimeType(v)
+	}
+	if _, ok := mc.mutation.Visibility(); !ok {
+		v := multipart.DefaultVisibility
+		mc.mutation.SetVisibility(v)
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		v := multipart.DefaultStatus
+		mc.mutation.SetStatus(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := multipart.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := multipart.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MultipartCreate) check() error {
+	if _, ok := mc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "Multipart.uid"`)}
+	}
+	if _, ok := mc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Multipart.user_id"`)}
+	}
+	if _, ok := mc.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "Multipart.filename"`)}
+	}
+	if _, ok := mc.mutation.ObjectPath(); !ok {
+		return &ValidationError{Name: "object_path", err: errors.New(`ent: missing required field "Multipart.object_path"`)}
+	}
+	if _, ok := mc.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "Multipart.mime_type"`)}
+	}
+	if _, ok := mc.mutation.UploadID(); !ok {
+		return &ValidationError{Name: "upload_id", err: errors.New(`ent: missing required field "Multipart.upload_id"`)}
+	}
+	if _, ok := mc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Multipart.visibility"`)}
+	}
+	if v, ok := mc.mutation.Visibility(); ok {
+		if err := multipart.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Multipart.visibility": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Multipart.status"`)}
+	}
+	if v, ok := mc.mutation.Status(); ok {
+		if err := multipart.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Multipart.status": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Multipart.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Multipart.updated_at"`)}
+	}
+	return nil
+}
+
+func (mc *MultipartCreate) sqlSave(ctx context.Context) (*Multipart, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MultipartCreate) createSpec() (*Multipart, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Multipart{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(multipart.Table, sqlgraph.NewFieldSpec(multipart.FieldID, field.TypeInt))
+	)
+	if value, ok := mc.mutation.UID(); ok {
+		_spec.SetField(multipart.FieldUID, field.TypeUUID, value)
+		_node.UID = value
+	}
+	if value, ok := mc.mutation.UserID(); ok {
+		_spec.SetField(multipart.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := mc.mutation.Filename(); ok {
+		_spec.SetField(multipart.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := mc.mutation.ObjectPath(); ok {
+		_spec.SetField(multipart.FieldObjectPath, field.TypeString, value)
+		_node.ObjectPath = value
+	}
+	if value, ok := mc.mutation.MimeType(); ok {
+		_spec.SetField(multipart.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := mc.mutation.DetectedMimeType(); ok {
+		_spec.SetField(multipart.FieldDetectedMimeType, field.TypeString, value)
+		_node.DetectedMimeType = value
+	}
+	if value, ok := mc.mutation.UploadID(); ok {
+		_spec.SetField(multipart.FieldUploadID, field.TypeString, value)
+		_node.UploadID = value
+	}
+	if value, ok := mc.mutation.UploadLength(); ok {
+		_spec.SetField(multipart.FieldUploadLength, field.TypeInt, value)
+		_node.UploadLength = &value
+	}
+	if value, ok := mc.mutation.Visibility(); ok {
+		_spec.SetField(multipart.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := mc.mutation.Status(); ok {
+		_spec.SetField(multipart.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mc.mutation.FileUID(); ok {
+		_spec.SetField(multipart.FieldFileUID, field.TypeUUID, value)
+		_node.FileUID = &value
+	}
+	if value, ok := mc.mutation.LockUID(); ok {
+		_spec.SetField(multipart.FieldLockUID, field.TypeUUID, value)
+		_node.LockUID = &value
+	}
+	if value, ok := mc.mutation.LockedUntil(); ok {
+		_spec.SetField(multipart.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(multipart.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipart.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MultipartCreateBulk is the builder for creating many Multipart entities in bulk.
+type MultipartCreateBulk struct {
+	config
+	builders []*MultipartCreate
+}
+
+// Save creates the Multipart entities in the database.
+func (mcb *MultipartCreateBulk) Save(ctx context.Context) ([]*Multipart, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Multipart, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MultipartMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MultipartCreateBulk) SaveX(ctx context.Context) []*Multipart {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MultipartCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MultipartCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,741 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/multipart"
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MultipartUpdate is the builder for updating Multipart entities.
+type MultipartUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MultipartMutation
+}
+
+// Where appends a list predicates to the MultipartUpdate builder.
+func (mu *MultipartUpdate) Where(ps ...predicate.Multipart) *MultipartUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUID sets the "uid" field.
+func (mu *MultipartUpdate) SetUID(u uuid.UUID) *MultipartUpdate {
+	mu.mutation.SetUID(u)
+	return mu
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableUID(u *uuid.UUID) *MultipartUpdate {
+	if u != nil {
+		mu.SetUID(*u)
+	}
+	return mu
+}
+
+// SetUserID sets the "user_id" field.
+func (mu *MultipartUpdate) SetUserID(i int) *MultipartUpdate {
+	mu.mutation.ResetUserID()
+	mu.mutation.SetUserID(i)
+	return mu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (mu *MultipartUpdate) AddUserID(i int) *MultipartUpdate {
+	mu.mutation.AddUserID(i)
+	return mu
+}
+
+// SetFilename sets the "filename" field.
+func (mu *MultipartUpdate) SetFilename(s string) *MultipartUpdate {
+	mu.mutation.SetFilename(s)
+	return mu
+}
+
+// SetObjectPath sets the "object_path" field.
+func (mu *MultipartUpdate) SetObjectPath(s string) *MultipartUpdate {
+	mu.mutation.SetObjectPath(s)
+	return mu
+}
+
+// SetMimeType sets the "mime_type" field.
+func (mu *MultipartUpdate) SetMimeType(s string) *MultipartUpdate {
+	mu.mutation.SetMimeType(s)
+	return mu
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (mu *MultipartUpdate) SetDetectedMimeType(s string) *MultipartUpdate {
+	mu.mutation.SetDetectedMimeType(s)
+	return mu
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableDetectedMimeType(s *string) *MultipartUpdate {
+	if s != nil {
+		mu.SetDetectedMimeType(*s)
+	}
+	return mu
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (mu *MultipartUpdate) ClearDetectedMimeType() *MultipartUpdate {
+	mu.mutation.ClearDetectedMimeType()
+	return mu
+}
+
+// SetUploadID sets the "upload_id" field.
+func (mu *MultipartUpdate) SetUploadID(s string) *MultipartUpdate {
+	mu.mutation.SetUploadID(s)
+	return mu
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (mu *MultipartUpdate) SetUploadLength(i int) *MultipartUpdate {
+	mu.mutation.ResetUploadLength()
+	mu.mutation.SetUploadLength(i)
+	return mu
+}
+
+// SetNillableUploadLength sets the "upload_length" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableUploadLength(i *int) *MultipartUpdate {
+	if i != nil {
+		mu.SetUploadLength(*i)
+	}
+	return mu
+}
+
+// AddUploadLength adds i to the "upload_length" field.
+func (mu *MultipartUpdate) AddUploadLength(i int) *MultipartUpdate {
+	mu.mutation.AddUploadLength(i)
+	return mu
+}
+
+// ClearUploadLength clears the value of the "upload_length" field.
+func (mu *MultipartUpdate) ClearUploadLength() *MultipartUpdate {
+	mu.mutation.ClearUploadLength()
+	return mu
+}
+
+// SetVisibility sets the "visibility" field.
+func (mu *MultipartUpdate) SetVisibility(m multipart.Visibility) *MultipartUpdate {
+	mu.mutation.SetVisibility(m)
+	return mu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableVisibility(m *multipart.Visibility) *MultipartUpdate {
+	if m != nil {
+		mu.SetVisibility(*m)
+	}
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *MultipartUpdate) SetStatus(m multipart.Status) *MultipartUpdate {
+	mu.mutation.SetStatus(m)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableStatus(m *multipart.Status) *MultipartUpdate {
+	if m != nil {
+		mu.SetStatus(*m)
+	}
+	return mu
+}
+
+// SetFileUID sets the "file_uid" field.
+func (mu *MultipartUpdate) SetFileUID(u uuid.UUID) *MultipartUpdate {
+	mu.mutation.SetFileUID(u)
+	return mu
+}
+
+// SetNillableFileUID sets the "file_uid" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableFileUID(u *uuid.UUID) *MultipartUpdate {
+	if u != nil {
+		mu.SetFileUID(*u)
+	}
+	return mu
+}
+
+// ClearFileUID clears the value of the "file_uid" field.
+func (mu *MultipartUpdate) ClearFileUID() *MultipartUpdate {
+	mu.mutation.ClearFileUID()
+	return mu
+}
+
+// SetLockUID sets the "lock_uid" field.
+func (mu *MultipartUpdate) SetLockUID(u uuid.UUID) *MultipartUpdate {
+	mu.mutation.SetLockUID(u)
+	return mu
+}
+
+// SetNillableLockUID sets the "lock_uid" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableLockUID(u *uuid.UUID) *MultipartUpdate {
+	if u != nil {
+		mu.SetLockUID(*u)
+	}
+	return mu
+}
+
+// ClearLockUID clears the value of the "lock_uid" field.
+func (mu *MultipartUpdate) ClearLockUID() *MultipartUpdate {
+	mu.mutation.ClearLockUID()
+	return mu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (mu *MultipartUpdate) SetLockedUntil(t time.Time) *MultipartUpdate {
+	mu.mutation.SetLockedUntil(t)
+	return mu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (mu *MultipartUpdate) SetNillableLockedUntil(t *time.Time) *MultipartUpdate {
+	if t != nil {
+		mu.SetLockedUntil(*t)
+	}
+	return mu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (mu *MultipartUpdate) ClearLockedUntil() *MultipartUpdate {
+	mu.mutation.ClearLockedUntil()
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MultipartUpdate) SetUpdatedAt(t time.Time) *MultipartUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// Mutation returns the MultipartMutation object of the builder.
+func (mu *MultipartUpdate) Mutation() *MultipartMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MultipartUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks[int, MultipartMutation](ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MultipartUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MultipartUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MultipartUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MultipartUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := multipart.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MultipartUpdate) check() error {
+	if v, ok := mu.mutation.Visibility(); ok {
+		if err := multipart.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Multipart.visibility": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Status(); ok {
+		if err := multipart.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Multipart.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MultipartUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(multipart.Table, multipart.Columns, sqlgraph.NewFieldSpec(multipart.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UID(); ok {
+		_spec.SetField(multipart.FieldUID, field.TypeUUID, value)
+	}
+	if value, ok := mu.mutation.UserID(); ok {
+		_spec.SetField(multipart.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedUserID(); ok {
+		_spec.AddField(multipart.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Filename(); ok {
+		_spec.SetField(multipart.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.ObjectPath(); ok {
+		_spec.SetField(multipart.FieldObjectPath, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.MimeType(); ok {
+		_spec.SetField(multipart.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.DetectedMimeType(); ok {
+		_spec.SetField(multipart.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if mu.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(multipart.FieldDetectedMimeType, field.TypeString)
+	}
+	if value, ok := mu.mutation.UploadID(); ok {
+		_spec.SetField(multipart.FieldUploadID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.UploadLength(); ok {
+		_spec.SetField(multipart.FieldUploadLength, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedUploadLength(); ok {
+		_spec.AddField(multipart.FieldUploadLength, field.TypeInt, value)
+	}
+	if mu.mutation.UploadLengthCleared() {
+		_spec.ClearField(multipart.FieldUploadLength, field.TypeInt)
+	}
+	if value, ok := mu.mutation.Visibility(); ok {
+		_spec.SetField(multipart.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.SetField(multipart.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.FileUID(); ok {
+		_spec.SetField(multipart.FieldFileUID, field.TypeUUID, value)
+	}
+	if mu.mutation.FileUIDCleared() {
+		_spec.ClearField(multipart.FieldFileUID, field.TypeUUID)
+	}
+	if value, ok := mu.mutation.LockUID(); ok {
+		_spec.SetField(multipart.FieldLockUID, field.TypeUUID, value)
+	}
+	if mu.mutation.LockUIDCleared() {
+		_spec.ClearField(multipart.FieldLockUID, field.TypeUUID)
+	}
+	if value, ok := mu.mutation.LockedUntil(); ok {
+		_spec.SetField(multipart.FieldLockedUntil, field.TypeTime, value)
+	}
+	if mu.mutation.LockedUntilCleared() {
+		_spec.ClearField(multipart.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipart.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{multipart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MultipartUpdateOne is the builder for updating a single Multipart entity.
+type MultipartUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MultipartMutation
+}
+
+// SetUID sets the "uid" field.
+func (muo *MultipartUpdateOne) SetUID(u uuid.UUID) *MultipartUpdateOne {
+	muo.mutation.SetUID(u)
+	return muo
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableUID(u *uuid.UUID) *MultipartUpdateOne {
+	if u != nil {
+		muo.SetUID(*u)
+	}
+	return muo
+}
+
+// SetUserID sets the "user_id" field.
+func (muo *MultipartUpdateOne) SetUserID(i int) *MultipartUpdateOne {
+	muo.mutation.ResetUserID()
+	muo.mutation.SetUserID(i)
+	return muo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (muo *MultipartUpdateOne) AddUserID(i int) *MultipartUpdateOne {
+	muo.mutation.AddUserID(i)
+	return muo
+}
+
+// SetFilename sets the "filename" field.
+func (muo *MultipartUpdateOne) SetFilename(s string) *MultipartUpdateOne {
+	muo.mutation.SetFilename(s)
+	return muo
+}
+
+// SetObjectPath sets the "object_path" field.
+func (muo *MultipartUpdateOne) SetObjectPath(s string) *MultipartUpdateOne {
+	muo.mutation.SetObjectPath(s)
+	return muo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (muo *MultipartUpdateOne) SetMimeType(s string) *MultipartUpdateOne {
+	muo.mutation.SetMimeType(s)
+	return muo
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (muo *MultipartUpdateOne) SetDetectedMimeType(s string) *MultipartUpdateOne {
+	muo.mutation.SetDetectedMimeType(s)
+	return muo
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableDetectedMimeType(s *string) *MultipartUpdateOne {
+	if s != nil {
+		muo.SetDetectedMimeType(*s)
+	}
+	return muo
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (muo *MultipartUpdateOne) ClearDetectedMimeType() *MultipartUpdateOne {
+	muo.mutation.ClearDetectedMimeType()
+	return muo
+}
+
+// SetUploadID sets the "upload_id" field.
+func (muo *MultipartUpdateOne) SetUploadID(s string) *MultipartUpdateOne {
+	muo.mutation.SetUploadID(s)
+	return muo
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (muo *MultipartUpdateOne) SetUploadLength(i int) *MultipartUpdateOne {
+	muo.mutation.ResetUploadLength()
+	muo.mutation.SetUploadLength(i)
+	return muo
+}
+
+// SetNillableUploadLength sets the "upload_length" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableUploadLength(i *int) *MultipartUpdateOne {
+	if i != nil {
+		muo.SetUploadLength(*i)
+	}
+	return muo
+}
+
+// AddUploadLength adds i to the "upload_length" field.
+func (muo *MultipartUpdateOne) AddUploadLength(i int) *MultipartUpdateOne {
+	muo.mutation.AddUploadLength(i)
+	return muo
+}
+
+// ClearUploadLength clears the value of the "upload_length" field.
+func (muo *MultipartUpdateOne) ClearUploadLength() *MultipartUpdateOne {
+	muo.mutation.ClearUploadLength()
+	return muo
+}
+
+// SetVisibility sets the "visibility" field.
+func (muo *MultipartUpdateOne) SetVisibility(m multipart.Visibility) *MultipartUpdateOne {
+	muo.mutation.SetVisibility(m)
+	return muo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableVisibility(m *multipart.Visibility) *MultipartUpdateOne {
+	if m != nil {
+		muo.SetVisibility(*m)
+	}
+	return muo
+}
+
+// SetStatus sets the "status" field.
+func (muo *MultipartUpdateOne) SetStatus(m multipart.Status) *MultipartUpdateOne {
+	muo.mutation.SetStatus(m)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableStatus(m *multipart.Status) *MultipartUpdateOne {
+	if m != nil {
+		muo.SetStatus(*m)
+	}
+	return muo
+}
+
+// SetFileUID sets the "file_uid" field.
+func (muo *MultipartUpdateOne) SetFileUID(u uuid.UUID) *MultipartUpdateOne {
+	muo.mutation.SetFileUID(u)
+	return muo
+}
+
+// SetNillableFileUID sets the "file_uid" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableFileUID(u *uuid.UUID) *MultipartUpdateOne {
+	if u != nil {
+		muo.SetFileUID(*u)
+	}
+	return muo
+}
+
+// ClearFileUID clears the value of the "file_uid" field.
+func (muo *MultipartUpdateOne) ClearFileUID() *MultipartUpdateOne {
+	muo.mutation.ClearFileUID()
+	return muo
+}
+
+// SetLockUID sets the "lock_uid" field.
+func (muo *MultipartUpdateOne) SetLockUID(u uuid.UUID) *MultipartUpdateOne {
+	muo.mutation.SetLockUID(u)
+	return muo
+}
+
+// SetNillableLockUID sets the "lock_uid" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableLockUID(u *uuid.UUID) *MultipartUpdateOne {
+	if u != nil {
+		muo.SetLockUID(*u)
+	}
+	return muo
+}
+
+// ClearLockUID clears the value of the "lock_uid" field.
+func (muo *MultipartUpdateOne) ClearLockUID() *MultipartUpdateOne {
+	muo.mutation.ClearLockUID()
+	return muo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (muo *MultipartUpdateOne) SetLockedUntil(t time.Time) *MultipartUpdateOne {
+	muo.mutation.SetLockedUntil(t)
+	return muo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (muo *MultipartUpdateOne) SetNillableLockedUntil(t *time.Time) *MultipartUpdateOne {
+	if t != nil {
+		muo.SetLockedUntil(*t)
+	}
+	return muo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (muo *MultipartUpdateOne) ClearLockedUntil() *MultipartUpdateOne {
+	muo.mutation.ClearLockedUntil()
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MultipartUpdateOne) SetUpdatedAt(t time.Time) *MultipartUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// Mutation returns the MultipartMutation object of the builder.
+func (muo *MultipartUpdateOne) Mutation() *MultipartMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MultipartUpdate builder.
+func (muo *MultipartUpdateOne) Where(ps ...predicate.Multipart) *MultipartUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MultipartUpdateOne) Select(field string, fields ...string) *MultipartUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Multipart entity.
+func (muo *MultipartUpdateOne) Save(ctx context.Context) (*Multipart, error) {
+	muo.defaults()
+	return withHooks[*Multipart, MultipartMutation](ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MultipartUpdateOne) SaveX(ctx context.Context) *Multipart {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MultipartUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MultipartUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MultipartUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := multipart.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MultipartUpdateOne) check() error {
+	if v, ok := muo.mutation.Visibility(); ok {
+		if err := multipart.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Multipart.visibility": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Status(); ok {
+		if err := multipart.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Multipart.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MultipartUpdateOne) sqlSave(ctx context.Context) (_node *Multipart, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(multipart.Table, multipart.Columns, sqlgraph.NewFieldSpec(multipart.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Multipart.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, multipart.FieldID)
+		for _, f := range fields {
+			if !multipart.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != multipart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UID(); ok {
+		_spec.SetField(multipart.FieldUID, field.TypeUUID, value)
+	}
+	if value, ok := muo.mutation.UserID(); ok {
+		_spec.SetField(multipart.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedUserID(); ok {
+		_spec.AddField(multipart.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Filename(); ok {
+		_spec.SetField(multipart.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.ObjectPath(); ok {
+		_spec.SetField(multipart.FieldObjectPath, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.MimeType(); ok {
+		_spec.SetField(multipart.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.DetectedMimeType(); ok {
+		_spec.SetField(multipart.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if muo.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(multipart.FieldDetectedMimeType, field.TypeString)
+	}
+	if value, ok := muo.mutation.UploadID(); ok {
+		_spec.SetField(multipart.FieldUploadID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.UploadLength(); ok {
+		_spec.SetField(multipart.FieldUploadLength, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedUploadLength(); ok {
+		_spec.AddField(multipart.FieldUploadLength, field.TypeInt, value)
+	}
+	if muo.mutation.UploadLengthCleared() {
+		_spec.ClearField(multipart.FieldUploadLength, field.TypeInt)
+	}
+	if value, ok := muo.mutation.Visibility(); ok {
+		_spec.SetField(multipart.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.SetField(multipart.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.FileUID(); ok {
+		_spec.SetField(multipart.FieldFileUID, field.TypeUUID, value)
+	}
+	if muo.mutation.FileUIDCleared() {
+		_spec.ClearField(multipart.FieldFileUID, field.TypeUUID)
+	}
+	if value, ok := muo.mutation.LockUID(); ok {
+		_spec.SetField(multipart.FieldLockUID, field.TypeUUID, value)
+	}
+	if muo.mutation.LockUIDCleared() {
+		_spec.ClearField(multipart.FieldLockUID, field.TypeUUID)
+	}
+	if value, ok := muo.mutation.LockedUntil(); ok {
+		_spec.SetField(multipart.FieldLockedUntil, field.TypeTime, value)
+	}
+	if muo.mutation.LockedUntilCleared() {
+		_spec.ClearField(multipart.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipart.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Multipart{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{multipart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
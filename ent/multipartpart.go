@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"storage/ent/multipartpart"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// MultipartPart is the model entity for the MultipartPart schema.
+type MultipartPart struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// identifier of multipart upload
+	MultipartID int `json:"multipart_id,omitempty"`
+	// number of part from 1 to 10000
+	PartNumber int `json:"part_number,omitempty"`
+	// entity tag of part returned by s3 storage
+	Etag string `json:"etag,omitempty"`
+	// size of part in bytes
+	Size int `json:"size,omitempty"`
+	// creation time of part
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of part
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MultipartPart) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case multipartpart.FieldID, multipartpart.FieldMultipartID, multipartpart.FieldPartNumber, multipartpart.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case multipartpart.FieldEtag:
+			values[i] = new(sql.NullString)
+		case multipartpart.FieldCreatedAt, multipartpart.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MultipartPart", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MultipartPart fields.
+func (mp *MultipartPart) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case multipartpart.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mp.ID = int(value.Int64)
+		case multipartpart.FieldMultipartID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field multipart_id", values[i])
+			} else if value.Valid {
+				mp.MultipartID = int(value.Int64)
+			}
+		case multipartpart.FieldPartNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field part_number", values[i])
+			} else if value.Valid {
+				mp.PartNumber = int(value.Int64)
+			}
+		case multipartpart.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				mp.Etag = value.String
+			}
+		case multipartpart.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				mp.Size = int(value.Int64)
+			}
+		case multipartpart.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mp.CreatedAt = value.Time
+			}
+		case multipartpart.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mp.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MultipartPart.
+// Note that you need to call MultipartPart.Unwrap() before calling this method if this MultipartPart
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mp *MultipartPart) Update() *MultipartPartUpdateOne {
+	return NewMultipartPartClient(mp.config).UpdateOne(mp)
+}
+
+// Unwrap unwraps the MultipartPart entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mp *MultipartPart) Unwrap() *MultipartPart {
+	_tx, ok := mp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MultipartPart is not a transactional entity")
+	}
+	mp.config.driver = _tx.drv
+	return mp
+}
+
+// String implements the fmt.Stringer.
+func (mp *MultipartPart) String() string {
+	var builder strings.Builder
+	builder.WriteString("MultipartPart(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mp.ID))
+	builder.WriteString("multipart_id=")
+	builder.WriteString(fmt.Sprintf("%v", mp.MultipartID))
+	builder.WriteString(", ")
+	builder.WriteString("part_number=")
+	builder.WriteString(fmt.Sprintf("%v", mp.PartNumber))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(mp.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Size))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MultipartParts is a parsable slice of MultipartPart.
+type MultipartParts []*MultipartPart
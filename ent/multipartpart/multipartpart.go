@@ -0,0 +1,58 @@
+// Code generated by ent, DO NOT EDIT.
+
+package multipartpart
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the multipartpart type in the database.
+	Label = "multipart_part"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMultipartID holds the string denoting the multipart_id field in the database.
+	FieldMultipartID = "multipart_id"
+	// FieldPartNumber holds the string denoting the part_number field in the database.
+	FieldPartNumber = "part_number"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the multipartpart in the database.
+	Table = "multipart_parts"
+)
+
+// Columns holds all SQL columns for multipartpart fields.
+var Columns = []string{
+	FieldID,
+	FieldMultipartID,
+	FieldPartNumber,
+	FieldEtag,
+	FieldSize,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
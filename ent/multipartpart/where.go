@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package multipartpart
+
+import (
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldID, id))
+}
+
+// MultipartID applies equality check predicate on the "multipart_id" field. It's identical to MultipartIDEQ.
+func MultipartID(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldMultipartID, v))
+}
+
+// PartNumber applies equality check predicate on the "part_number" field. It's identical to PartNumberEQ.
+func PartNumber(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldPartNumber, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldEtag, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldSize, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MultipartIDEQ applies the EQ predicate on the "multipart_id" field.
+func MultipartIDEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldMultipartID, v))
+}
+
+// MultipartIDNEQ applies the NEQ predicate on the "multipart_id" field.
+func MultipartIDNEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldMultipartID, v))
+}
+
+// MultipartIDIn applies the In predicate on the "multipart_id" field.
+func MultipartIDIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldMultipartID, vs...))
+}
+
+// MultipartIDNotIn applies the NotIn predicate on the "multipart_id" field.
+func MultipartIDNotIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldMultipartID, vs...))
+}
+
+// MultipartIDGT applies the GT predicate on the "multipart_id" field.
+func MultipartIDGT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldMultipartID, v))
+}
+
+// MultipartIDGTE applies the GTE predicate on the "multipart_id" field.
+func MultipartIDGTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldMultipartID, v))
+}
+
+// MultipartIDLT applies the LT predicate on the "multipart_id" field.
+func MultipartIDLT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldMultipartID, v))
+}
+
+// MultipartIDLTE applies the LTE predicate on the "multipart_id" field.
+func MultipartIDLTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldMultipartID, v))
+}
+
+// PartNumberEQ applies the EQ predicate on the "part_number" field.
+func PartNumberEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldPartNumber, v))
+}
+
+// PartNumberNEQ applies the NEQ predicate on the "part_number" field.
+func PartNumberNEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldPartNumber, v))
+}
+
+// PartNumberIn applies the In predicate on the "part_number" field.
+func PartNumberIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldPartNumber, vs...))
+}
+
+// PartNumberNotIn applies the NotIn predicate on the "part_number" field.
+func PartNumberNotIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldPartNumber, vs...))
+}
+
+// PartNumberGT applies the GT predicate on the "part_number" field.
+func PartNumberGT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldPartNumber, v))
+}
+
+// PartNumberGTE applies the GTE predicate on the "part_number" field.
+func PartNumberGTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldPartNumber, v))
+}
+
+// PartNumberLT applies the LT predicate on the "part_number" field.
+func PartNumberLT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldPartNumber, v))
+}
+
+// PartNumberLTE applies the LTE predicate on the "part_number" field.
+func PartNumberLTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldPartNumber, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldSize, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MultipartPart {
+	return predicate.MultipartPart(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MultipartPart) predicate.MultipartPart {
+	return predicate.MultipartPart(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MultipartPart) predicate.MultipartPart {
+	return predicate.MultipartPart(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MultipartPart) predicate.MultipartPart {
+	return predicate.MultipartPart(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
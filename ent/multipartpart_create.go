@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/multipartpart"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MultipartPartCreate is the builder for creating a MultipartPart entity.
+type MultipartPartCreate struct {
+	config
+	mutation *MultipartPartMutation
+	hooks    []Hook
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (mpc *MultipartPartCreate) SetMultipartID(i int) *MultipartPartCreate {
+	mpc.mutation.SetMultipartID(i)
+	return mpc
+}
+
+// SetPartNumber sets the "part_number" field.
+func (mpc *MultipartPartCreate) SetPartNumber(i int) *MultipartPartCreate {
+	mpc.mutation.SetPartNumber(i)
+	return mpc
+}
+
+// SetEtag sets the "etag" field.
+func (mpc *MultipartPartCreate) SetEtag(s string) *MultipartPartCreate {
+	mpc.mutation.SetEtag(s)
+	return mpc
+}
+
+// SetSize sets the "size" field.
+func (mpc *MultipartPartCreate) SetSize(i int) *MultipartPartCreate {
+	mpc.mutation.SetSize(i)
+	return mpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mpc *MultipartPartCreate) SetCreatedAt(t time.Time) *MultipartPartCreate {
+	mpc.mutation.SetCreatedAt(t)
+	return mpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mpc *MultipartPartCreate) SetNillableCreatedAt(t *time.Time) *MultipartPartCreate {
+	if t != nil {
+		mpc.SetCreatedAt(*t)
+	}
+	return mpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpc *MultipartPartCreate) SetUpdatedAt(t time.Time) *MultipartPartCreate {
+	mpc.mutation.SetUpdatedAt(t)
+	return mpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mpc *MultipartPartCreate) SetNillableUpdatedAt(t *time.Time) *MultipartPartCreate {
+	if t != nil {
+		mpc.SetUpdatedAt(*t)
+	}
+	return mpc
+}
+
+// Mutation returns the MultipartPartMutation object of the builder.
+func (mpc *MultipartPartCreate) Mutation() *MultipartPartMutation {
+	return mpc.mutation
+}
+
+// Save creates the MultipartPart in the database.
+func (mpc *MultipartPartCreate) Save(ctx context.Context) (*MultipartPart, error) {
+	mpc.defaults()
+	return withHooks[*MultipartPart, MultipartPartMutation](ctx, mpc.sqlSave, mpc.mutation, mpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpc *MultipartPartCreate) SaveX(ctx context.Context) *MultipartPart {
+	v, err := mpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpc *MultipartPartCreate) Exec(ctx context.Context) error {
+	_, err := mpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpc *MultipartPartCreate) ExecX(ctx context.Context) {
+	if err := mpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpc *MultipartPartCreate) defaults() {
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		v := multipartpart.DefaultCreatedAt()
+		mpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mpc.mutation.UpdatedAt(); !ok {
+		v := multipartpart.DefaultUpdatedAt()
+		mpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpc *MultipartPartCreate) check() error {
+	if _, ok := mpc.mutation.MultipartID(); !ok {
+		return &ValidationError{Name: "multipart_id", err: errors.New(`ent: missing required field "MultipartPart.multipart_id"`)}
+	}
+	if _, ok := mpc.mutation.PartNumber(); !ok {
+		return &ValidationError{Name: "part_number", err: errors.New(`ent: missing required field "MultipartPart.part_number"`)}
+	}
+	if _, ok := mpc.mutation.Etag(); !ok {
+		return &ValidationError{Name: "etag", err: errors.New(`ent: missing required field "MultipartPart.etag"`)}
+	}
+	if _, ok := mpc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "MultipartPart.size"`)}
+	}
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MultipartPart.created_at"`)}
+	}
+	if _, ok := mpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MultipartPart.updated_at"`)}
+	}
+	return nil
+}
+
+func (mpc *MultipartPartCreate) sqlSave(ctx context.Context) (*MultipartPart, error) {
+	if err := mpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mpc.mutation.id = &_node.ID
+	mpc.mutation.done = true
+	return _node, nil
+}
+
+func (mpc *MultipartPartCreate) createSpec() (*MultipartPart, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MultipartPart{config: mpc.config}
+		_spec = sqlgraph.NewCreateSpec(multipartpart.Table, sqlgraph.NewFieldSpec(multipartpart.FieldID, field.TypeInt))
+	)
+	if value, ok := mpc.mutation.MultipartID(); ok {
+		_spec.SetField(multipartpart.FieldMultipartID, field.TypeInt, value)
+		_node.MultipartID = value
+	}
+	if value, ok := mpc.mutation.PartNumber(); ok {
+		_spec.SetField(multipartpart.FieldPartNumber, field.TypeInt, value)
+		_node.PartNumber = value
+	}
+	if value, ok := mpc.mutation.Etag(); ok {
+		_spec.SetField(multipartpart.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := mpc.mutation.Size(); ok {
+		_spec.SetField(multipartpart.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := mpc.mutation.CreatedAt(); ok {
+		_spec.SetField(multipartpart.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipartpart.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MultipartPartCreateBulk is the builder for creating many MultipartPart entities in bulk.
+type MultipartPartCreateBulk struct {
+	config
+	builders []*MultipartPartCreate
+}
+
+// Save creates the MultipartPart entities in the database.
+func (mpcb *MultipartPartCreateBulk) Save(ctx context.Context) ([]*MultipartPart, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mpcb.builders))
+	nodes := make([]*MultipartPart, len(mpcb.builders))
+	mutators := make([]Mutator, len(mpcb.builders))
+	for i := range mpcb.builders {
+		func(i int, root context.Context) {
+			builder := mpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MultipartPartMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpcb *MultipartPartCreateBulk) SaveX(ctx context.Context) []*MultipartPart {
+	v, err := mpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpcb *MultipartPartCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpcb *MultipartPartCreateBulk) ExecX(ctx context.Context) {
+	if err := mpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
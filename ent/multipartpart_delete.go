@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"storage/ent/multipartpart"
+	"storage/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MultipartPartDelete is the builder for deleting a MultipartPart entity.
+type MultipartPartDelete struct {
+	config
+	hooks    []Hook
+	mutation *MultipartPartMutation
+}
+
+// Where appends a list predicates to the MultipartPartDelete builder.
+func (mpd *MultipartPartDelete) Where(ps ...predicate.MultipartPart) *MultipartPartDelete {
+	mpd.mutation.Where(ps...)
+	return mpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpd *MultipartPartDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, MultipartPartMutation](ctx, mpd.sqlExec, mpd.mutation, mpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpd *MultipartPartDelete) ExecX(ctx context.Context) int {
+	n, err := mpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpd *MultipartPartDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(multipartpart.Table, sqlgraph.NewFieldSpec(multipartpart.FieldID, field.TypeInt))
+	if ps := mpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpd.mutation.done = true
+	return affected, err
+}
+
+// MultipartPartDeleteOne is the builder for deleting a single MultipartPart entity.
+type MultipartPartDeleteOne struct {
+	mpd *MultipartPartDelete
+}
+
+// Where appends a list predicates to the MultipartPartDelete builder.
+func (mpdo *MultipartPartDeleteOne) Where(ps ...predicate.MultipartPart) *MultipartPartDeleteOne {
+	mpdo.mpd.mutation.Where(ps...)
+	return mpdo
+}
+
+// Exec executes the deletion query.
+func (mpdo *MultipartPartDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpdo.mpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{multipartpart.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpdo *MultipartPartDeleteOne) ExecX(ctx context.Context) {
+	if err := mpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"storage/ent/multipartpart"
+	"storage/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MultipartPartQuery is the builder for querying MultipartPart entities.
+type MultipartPartQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.MultipartPart
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MultipartPartQuery builder.
+func (mpq *MultipartPartQuery) Where(ps ...predicate.MultipartPart) *MultipartPartQuery {
+	mpq.predicates = append(mpq.predicates, ps...)
+	return mpq
+}
+
+// Limit the number of records to be returned by this query.
+func (mpq *MultipartPartQuery) Limit(limit int) *MultipartPartQuery {
+	mpq.ctx.Limit = &limit
+	return mpq
+}
+
+// Offset to start from.
+func (mpq *MultipartPartQuery) Offset(offset int) *MultipartPartQuery {
+	mpq.ctx.Offset = &offset
+	return mpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mpq *MultipartPartQuery) Unique(unique bool) *MultipartPartQuery {
+	mpq.ctx.Unique = &unique
+	return mpq
+}
+
+// Order specifies how the records should be ordered.
+func (mpq *MultipartPartQuery) Order(o ...OrderFunc) *MultipartPartQuery {
+	mpq.order = append(mpq.order, o...)
+	return mpq
+}
+
+// First returns the first MultipartPart entity from the query.
+// Returns a *NotFoundError when no MultipartPart was found.
+func (mpq *MultipartPartQuery) First(ctx context.Context) (*MultipartPart, error) {
+	nodes, err := mpq.Limit(1).All(setContextOp(ctx, mpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{multipartpart.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mpq *MultipartPartQuery) FirstX(ctx context.Context) *MultipartPart {
+	node, err := mpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MultipartPart ID from the query.
+// Returns a *NotFoundError when no MultipartPart ID was found.
+func (mpq *MultipartPartQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(1).IDs(setContextOp(ctx, mpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{multipartpart.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mpq *MultipartPartQuery) FirstIDX(ctx context.Context) int {
+	id, err := mpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MultipartPart entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MultipartPart entity is found.
+// Returns a *NotFoundError when no MultipartPart entities are found.
+func (mpq *MultipartPartQuery) Only(ctx context.Context) (*MultipartPart, error) {
+	nodes, err := mpq.Limit(2).All(setContextOp(ctx, mpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{multipartpart.Label}
+	default:
+		return nil, &NotSingularError{multipartpart.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mpq *MultipartPartQuery) OnlyX(ctx context.Context) *MultipartPart {
+	node, err := mpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MultipartPart ID in the query.
+// Returns a *NotSingularError when more than one MultipartPart ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mpq *MultipartPartQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(2).IDs(setContextOp(ctx, mpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{multipartpart.Label}
+	default:
+		err = &NotSingularError{multipartpart.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mpq *MultipartPartQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MultipartParts.
+func (mpq *MultipartPartQuery) All(ctx context.Context) ([]*MultipartPart, error) {
+	ctx = setContextOp(ctx, mpq.ctx, "All")
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MultipartPart, *MultipartPartQuery]()
+	return withInterceptors[[]*MultipartPart](ctx, mpq, qr, mpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mpq *MultipartPartQuery) AllX(ctx context.Context) []*MultipartPart {
+	nodes, err := mpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MultipartPart IDs.
+func (mpq *MultipartPartQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mpq.ctx.Unique == nil && mpq.path != nil {
+		mpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mpq.ctx, "IDs")
+	if err = mpq.Select(multipartpart.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mpq *MultipartPartQuery) IDsX(ctx context.Context) []int {
+	ids, err := mpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mpq *MultipartPartQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mpq.ctx, "Count")
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mpq, querierCount[*MultipartPartQuery](), mpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mpq *MultipartPartQuery) CountX(ctx context.Context) int {
+	count, err := mpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mpq *MultipartPartQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mpq.ctx, "Exist")
+	switch _, err := mpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mpq *MultipartPartQuery) ExistX(ctx context.Context) bool {
+	exist, err := mpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MultipartPartQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mpq *MultipartPartQuery) Clone() *MultipartPartQuery {
+	if mpq == nil {
+		return nil
+	}
+	return &MultipartPartQuery{
+		config:     mpq.config,
+		ctx:        mpq.ctx.Clone(),
+		order:      append([]OrderFunc{}, mpq.order...),
+		inters:     append([]Interceptor{}, mpq.inters...),
+		predicates: append([]predicate.MultipartPart{}, mpq.predicates...),
+		// clone intermediate query.
+		sql:  mpq.sql.Clone(),
+		path: mpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MultipartID int `json:"multipart_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MultipartPart.Query().
+//		GroupBy(multipartpart.FieldMultipartID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mpq *MultipartPartQuery) GroupBy(field string, fields ...string) *MultipartPartGroupBy {
+	mpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MultipartPartGroupBy{build: mpq}
+	grbuild.flds = &mpq.ctx.Fields
+	grbuild.label = multipartpart.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MultipartID int `json:"multipart_id,omitempty"`
+//	}
+//
+//	client.MultipartPart.Query().
+//		Select(multipartpart.FieldMultipartID).
+//		Scan(ctx, &v)
+func (mpq *MultipartPartQuery) Select(fields ...string) *MultipartPartSelect {
+	mpq.ctx.Fields = append(mpq.ctx.Fields, fields...)
+	sbuild := &MultipartPartSelect{MultipartPartQuery: mpq}
+	sbuild.label = multipartpart.Label
+	sbuild.flds, sbuild.scan = &mpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MultipartPartSelect configured with the given aggregations.
+func (mpq *MultipartPartQuery) Aggregate(fns ...AggregateFunc) *MultipartPartSelect {
+	return mpq.Select().Aggregate(fns...)
+}
+
+func (mpq *MultipartPartQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mpq.ctx.Fields {
+		if !multipartpart.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mpq.path != nil {
+		prev, err := mpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mpq.sql = prev
+	}
+	return nil
+}
+
+func (mpq *MultipartPartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MultipartPart, error) {
+	var (
+		nodes = []*MultipartPart{}
+		_spec = mpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MultipartPart).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MultipartPart{config: mpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mpq *MultipartPartQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mpq.querySpec()
+	_spec.Node.Columns = mpq.ctx.Fields
+	if len(mpq.ctx.Fields) > 0 {
+		_spec.Unique = mpq.ctx.Unique != nil && *mpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mpq.driver, _spec)
+}
+
+func (mpq *MultipartPartQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(multipartpart.Table, multipartpart.Columns, sqlgraph.NewFieldSpec(multipartpart.FieldID, field.TypeInt))
+	_spec.From = mpq.sql
+	if unique := mpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, multipartpart.FieldID)
+		for i := range fields {
+			if fields[i] != multipartpart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mpq *MultipartPartQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mpq.driver.Dialect())
+	t1 := builder.Table(multipartpart.Table)
+	columns := mpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = multipartpart.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mpq.sql != nil {
+		selector = mpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mpq.ctx.Unique != nil && *mpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mpq.predicates {
+		p(selector)
+	}
+	for _, p := range mpq.order {
+		p(selector)
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MultipartPartGroupBy is the group-by builder for MultipartPart entities.
+type MultipartPartGroupBy struct {
+	selector
+	build *MultipartPartQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mpgb *MultipartPartGroupBy) Aggregate(fns ...AggregateFunc) *MultipartPartGroupBy {
+	mpgb.fns = append(mpgb.fns, fns...)
+	return mpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpgb *MultipartPartGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpgb.build.ctx, "GroupBy")
+	if err := mpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MultipartPartQuery, *MultipartPartGroupBy](ctx, mpgb.build, mpgb, mpgb.build.inters, v)
+}
+
+func (mpgb *MultipartPartGroupBy) sqlScan(ctx context.Context, root *MultipartPartQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mpgb.fns))
+	for _, fn := range mpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mpgb.flds)+len(mpgb.fns))
+		for _, f := range *mpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MultipartPartSelect is the builder for selecting fields of MultipartPart entities.
+type MultipartPartSelect struct {
+	*MultipartPartQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mps *MultipartPartSelect) Aggregate(fns ...AggregateFunc) *MultipartPartSelect {
+	mps.fns = append(mps.fns, fns...)
+	return mps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mps *MultipartPartSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mps.ctx, "Select")
+	if err := mps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MultipartPartQuery, *MultipartPartSelect](ctx, mps.MultipartPartQuery, mps, mps.inters, v)
+}
+
+func (mps *MultipartPartSelect) sqlScan(ctx context.Context, root *MultipartPartQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mps.fns))
+	for _, fn := range mps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
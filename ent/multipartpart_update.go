@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/multipartpart"
+	"storage/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MultipartPartUpdate is the builder for updating MultipartPart entities.
+type MultipartPartUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MultipartPartMutation
+}
+
+// Where appends a list predicates to the MultipartPartUpdate builder.
+func (mpu *MultipartPartUpdate) Where(ps ...predicate.MultipartPart) *MultipartPartUpdate {
+	mpu.mutation.Where(ps...)
+	return mpu
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (mpu *MultipartPartUpdate) SetMultipartID(i int) *MultipartPartUpdate {
+	mpu.mutation.ResetMultipartID()
+	mpu.mutation.SetMultipartID(i)
+	return mpu
+}
+
+// AddMultipartID adds i to the "multipart_id" field.
+func (mpu *MultipartPartUpdate) AddMultipartID(i int) *MultipartPartUpdate {
+	mpu.mutation.AddMultipartID(i)
+	return mpu
+}
+
+// SetPartNumber sets the "part_number" field.
+func (mpu *MultipartPartUpdate) SetPartNumber(i int) *MultipartPartUpdate {
+	mpu.mutation.ResetPartNumber()
+	mpu.mutation.SetPartNumber(i)
+	return mpu
+}
+
+// AddPartNumber adds i to the "part_number" field.
+func (mpu *MultipartPartUpdate) AddPartNumber(i int) *MultipartPartUpdate {
+	mpu.mutation.AddPartNumber(i)
+	return mpu
+}
+
+// SetEtag sets the "etag" field.
+func (mpu *MultipartPartUpdate) SetEtag(s string) *MultipartPartUpdate {
+	mpu.mutation.SetEtag(s)
+	return mpu
+}
+
+// SetSize sets the "size" field.
+func (mpu *MultipartPartUpdate) SetSize(i int) *MultipartPartUpdate {
+	mpu.mutation.ResetSize()
+	mpu.mutation.SetSize(i)
+	return mpu
+}
+
+// AddSize adds i to the "size" field.
+func (mpu *MultipartPartUpdate) AddSize(i int) *MultipartPartUpdate {
+	mpu.mutation.AddSize(i)
+	return mpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpu *MultipartPartUpdate) SetUpdatedAt(t time.Time) *MultipartPartUpdate {
+	mpu.mutation.SetUpdatedAt(t)
+	return mpu
+}
+
+// Mutation returns the MultipartPartMutation object of the builder.
+func (mpu *MultipartPartUpdate) Mutation() *MultipartPartMutation {
+	return mpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpu *MultipartPartUpdate) Save(ctx context.Context) (int, error) {
+	mpu.defaults()
+	return withHooks[int, MultipartPartMutation](ctx, mpu.sqlSave, mpu.mutation, mpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpu *MultipartPartUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpu *MultipartPartUpdate) Exec(ctx context.Context) error {
+	_, err := mpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpu *MultipartPartUpdate) ExecX(ctx context.Context) {
+	if err := mpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpu *MultipartPartUpdate) defaults() {
+	if _, ok := mpu.mutation.UpdatedAt(); !ok {
+		v := multipartpart.UpdateDefaultUpdatedAt()
+		mpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (mpu *MultipartPartUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(multipartpart.Table, multipartpart.Columns, sqlgraph.NewFieldSpec(multipartpart.FieldID, field.TypeInt))
+	if ps := mpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpu.mutation.MultipartID(); ok {
+		_spec.SetField(multipartpart.FieldMultipartID, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.AddedMultipartID(); ok {
+		_spec.AddField(multipartpart.FieldMultipartID, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.PartNumber(); ok {
+		_spec.SetField(multipartpart.FieldPartNumber, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.AddedPartNumber(); ok {
+		_spec.AddField(multipartpart.FieldPartNumber, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.Etag(); ok {
+		_spec.SetField(multipartpart.FieldEtag, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.Size(); ok {
+		_spec.SetField(multipartpart.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.AddedSize(); ok {
+		_spec.AddField(multipartpart.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipartpart.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{multipartpart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpu.mutation.done = true
+	return n, nil
+}
+
+// MultipartPartUpdateOne is the builder for updating a single MultipartPart entity.
+type MultipartPartUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MultipartPartMutation
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (mpuo *MultipartPartUpdateOne) SetMultipartID(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.ResetMultipartID()
+	mpuo.mutation.SetMultipartID(i)
+	return mpuo
+}
+
+// AddMultipartID adds i to the "multipart_id" field.
+func (mpuo *MultipartPartUpdateOne) AddMultipartID(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.AddMultipartID(i)
+	return mpuo
+}
+
+// SetPartNumber sets the "part_number" field.
+func (mpuo *MultipartPartUpdateOne) SetPartNumber(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.ResetPartNumber()
+	mpuo.mutation.SetPartNumber(i)
+	return mpuo
+}
+
+// AddPartNumber adds i to the "part_number" field.
+func (mpuo *MultipartPartUpdateOne) AddPartNumber(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.AddPartNumber(i)
+	return mpuo
+}
+
+// SetEtag sets the "etag" field.
+func (mpuo *MultipartPartUpdateOne) SetEtag(s string) *MultipartPartUpdateOne {
+	mpuo.mutation.SetEtag(s)
+	return mpuo
+}
+
+// SetSize sets the "size" field.
+func (mpuo *MultipartPartUpdateOne) SetSize(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.ResetSize()
+	mpuo.mutation.SetSize(i)
+	return mpuo
+}
+
+// AddSize adds i to the "size" field.
+func (mpuo *MultipartPartUpdateOne) AddSize(i int) *MultipartPartUpdateOne {
+	mpuo.mutation.AddSize(i)
+	return mpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpuo *MultipartPartUpdateOne) SetUpdatedAt(t time.Time) *MultipartPartUpdateOne {
+	mpuo.mutation.SetUpdatedAt(t)
+	return mpuo
+}
+
+// Mutation returns the MultipartPartMutation object of the builder.
+func (mpuo *MultipartPartUpdateOne) Mutation() *MultipartPartMutation {
+	return mpuo.mutation
+}
+
+// Where appends a list predicates to the MultipartPartUpdate builder.
+func (mpuo *MultipartPartUpdateOne) Where(ps ...predicate.MultipartPart) *MultipartPartUpdateOne {
+	mpuo.mutation.Where(ps...)
+	return mpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpuo *MultipartPartUpdateOne) Select(field string, fields ...string) *MultipartPartUpdateOne {
+	mpuo.fields = append([]string{field}, fields...)
+	return mpuo
+}
+
+// Save executes the query and returns the updated MultipartPart entity.
+func (mpuo *MultipartPartUpdateOne) Save(ctx context.Context) (*MultipartPart, error) {
+	mpuo.defaults()
+	return withHooks[*MultipartPart, MultipartPartMutation](ctx, mpuo.sqlSave, mpuo.mutation, mpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpuo *MultipartPartUpdateOne) SaveX(ctx context.Context) *MultipartPart {
+	node, err := mpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpuo *MultipartPartUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpuo *MultipartPartUpdateOne) ExecX(ctx context.Context) {
+	if err := mpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpuo *MultipartPartUpdateOne) defaults() {
+	if _, ok := mpuo.mutation.UpdatedAt(); !ok {
+		v := multipartpart.UpdateDefaultUpdatedAt()
+		mpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (mpuo *MultipartPartUpdateOne) sqlSave(ctx context.Context) (_node *MultipartPart, err error) {
+	_spec := sqlgraph.NewUpdateSpec(multipartpart.Table, multipartpart.Columns, sqlgraph.NewFieldSpec(multipartpart.FieldID, field.TypeInt))
+	id, ok := mpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MultipartPart.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, multipartpart.FieldID)
+		for _, f := range fields {
+			if !multipartpart.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != multipartpart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpuo.mutation.MultipartID(); ok {
+		_spec.SetField(multipartpart.FieldMultipartID, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.AddedMultipartID(); ok {
+		_spec.AddField(multipartpart.FieldMultipartID, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.PartNumber(); ok {
+		_spec.SetField(multipartpart.FieldPartNumber, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.AddedPartNumber(); ok {
+		_spec.AddField(multipartpart.FieldPartNumber, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.Etag(); ok {
+		_spec.SetField(multipartpart.FieldEtag, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.Size(); ok {
+		_spec.SetField(multipartpart.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.AddedSize(); ok {
+		_spec.AddField(multipartpart.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(multipartpart.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MultipartPart{config: mpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{multipartpart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpuo.mutation.done = true
+	return _node, nil
+}
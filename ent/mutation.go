@@ -6,8 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"storage/ent/blob"
 	"storage/ent/file"
+	"storage/ent/multipart"
+	"storage/ent/multipartpart"
 	"storage/ent/predicate"
+	"storage/ent/sharelink"
 	"sync"
 	"time"
 
@@ -25,43 +29,4548 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile = "File"
+	TypeBlob          = "Blob"
+	TypeFile          = "File"
+	TypeMultipart     = "Multipart"
+	TypeMultipartPart = "MultipartPart"
+	TypeShareLink     = "ShareLink"
 )
 
+// BlobMutation represents an operation that mutates the Blob nodes in the graph.
+type BlobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	sha256        *string
+	object_path   *string
+	size          *int
+	addsize       *int
+	etag          *string
+	ref_count     *int
+	addref_count  *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Blob, error)
+	predicates    []predicate.Blob
+}
+
+var _ ent.Mutation = (*BlobMutation)(nil)
+
+// blobOption allows management of the mutation configuration using functional options.
+type blobOption func(*BlobMutation)
+
+// newBlobMutation creates new mutation for the Blob entity.
+func newBlobMutation(c config, op Op, opts ...blobOption) *BlobMutation {
+	m := &BlobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlobID sets the ID field of the mutation.
+func withBlobID(id int) blobOption {
+	return func(m *BlobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Blob
+		)
+		m.oldValue = func(ctx context.Context) (*Blob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Blob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlob sets the old Blob of the mutation.
+func withBlob(node *Blob) blobOption {
+	return func(m *BlobMutation) {
+		m.oldValue = func(context.Context) (*Blob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Blob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *BlobMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *BlobMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *BlobMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
+// SetObjectPath sets the "object_path" field.
+func (m *BlobMutation) SetObjectPath(s string) {
+	m.object_path = &s
+}
+
+// ObjectPath returns the value of the "object_path" field in the mutation.
+func (m *BlobMutation) ObjectPath() (r string, exists bool) {
+	v := m.object_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectPath returns the old "object_path" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldObjectPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectPath: %w", err)
+	}
+	return oldValue.ObjectPath, nil
+}
+
+// ResetObjectPath resets all changes to the "object_path" field.
+func (m *BlobMutation) ResetObjectPath() {
+	m.object_path = nil
+}
+
+// SetSize sets the "size" field.
+func (m *BlobMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *BlobMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *BlobMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BlobMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *BlobMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetEtag sets the "etag" field.
+func (m *BlobMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *BlobMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (m *BlobMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[blob.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *BlobMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[blob.FieldEtag]
+	return ok
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *BlobMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, blob.FieldEtag)
+}
+
+// SetRefCount sets the "ref_count" field.
+func (m *BlobMutation) SetRefCount(i int) {
+	m.ref_count = &i
+	m.addref_count = nil
+}
+
+// RefCount returns the value of the "ref_count" field in the mutation.
+func (m *BlobMutation) RefCount() (r int, exists bool) {
+	v := m.ref_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefCount returns the old "ref_count" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldRefCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefCount: %w", err)
+	}
+	return oldValue.RefCount, nil
+}
+
+// AddRefCount adds i to the "ref_count" field.
+func (m *BlobMutation) AddRefCount(i int) {
+	if m.addref_count != nil {
+		*m.addref_count += i
+	} else {
+		m.addref_count = &i
+	}
+}
+
+// AddedRefCount returns the value that was added to the "ref_count" field in this mutation.
+func (m *BlobMutation) AddedRefCount() (r int, exists bool) {
+	v := m.addref_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRefCount resets all changes to the "ref_count" field.
+func (m *BlobMutation) ResetRefCount() {
+	m.ref_count = nil
+	m.addref_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BlobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BlobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BlobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the BlobMutation builder.
+func (m *BlobMutation) Where(ps ...predicate.Blob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Blob).
+func (m *BlobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlobMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.sha256 != nil {
+		fields = append(fields, blob.FieldSha256)
+	}
+	if m.object_path != nil {
+		fields = append(fields, blob.FieldObjectPath)
+	}
+	if m.size != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	if m.etag != nil {
+		fields = append(fields, blob.FieldEtag)
+	}
+	if m.ref_count != nil {
+		fields = append(fields, blob.FieldRefCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, blob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, blob.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSha256:
+		return m.Sha256()
+	case blob.FieldObjectPath:
+		return m.ObjectPath()
+	case blob.FieldSize:
+		return m.Size()
+	case blob.FieldEtag:
+		return m.Etag()
+	case blob.FieldRefCount:
+		return m.RefCount()
+	case blob.FieldCreatedAt:
+		return m.CreatedAt()
+	case blob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blob.FieldSha256:
+		return m.OldSha256(ctx)
+	case blob.FieldObjectPath:
+		return m.OldObjectPath(ctx)
+	case blob.FieldSize:
+		return m.OldSize(ctx)
+	case blob.FieldEtag:
+		return m.OldEtag(ctx)
+	case blob.FieldRefCount:
+		return m.OldRefCount(ctx)
+	case blob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case blob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Blob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case blob.FieldObjectPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectPath(v)
+		return nil
+	case blob.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case blob.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case blob.FieldRefCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefCount(v)
+		return nil
+	case blob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case blob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlobMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	if m.addref_count != nil {
+		fields = append(fields, blob.FieldRefCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSize:
+		return m.AddedSize()
+	case blob.FieldRefCount:
+		return m.AddedRefCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case blob.FieldRefCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blob.FieldEtag) {
+		fields = append(fields, blob.FieldEtag)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlobMutation) ClearField(name string) error {
+	switch name {
+	case blob.FieldEtag:
+		m.ClearEtag()
+		return nil
+	}
+	return fmt.Errorf("unknown Blob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlobMutation) ResetField(name string) error {
+	switch name {
+	case blob.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case blob.FieldObjectPath:
+		m.ResetObjectPath()
+		return nil
+	case blob.FieldSize:
+		m.ResetSize()
+		return nil
+	case blob.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case blob.FieldRefCount:
+		m.ResetRefCount()
+		return nil
+	case blob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case blob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Blob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Blob edge %s", name)
+}
+
 // FileMutation represents an operation that mutates the File nodes in the graph.
 type FileMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	uid           *uuid.UUID
-	user_id       *int
-	adduser_id    *int
-	filename      *string
-	object_path   *string
-	size          *int
-	addsize       *int
-	mime_type     *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*File, error)
-	predicates    []predicate.File
+	op                 Op
+	typ                string
+	id                 *int
+	uid                *uuid.UUID
+	user_id            *int
+	adduser_id         *int
+	filename           *string
+	object_path        *string
+	logical_path       *string
+	version            *int
+	addversion         *int
+	size               *int
+	addsize            *int
+	mime_type          *string
+	detected_mime_type *string
+	etag               *string
+	last_modified      *time.Time
+	sha256             *string
+	md5                *string
+	status             *file.Status
+	visibility         *file.Visibility
+	scan_status        *file.ScanStatus
+	scan_result        *string
+	scanned_at         *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	deleted_at         *time.Time
+	clearedFields      map[string]struct{}
+	blob               *int
+	clearedblob        bool
+	done               bool
+	oldValue           func(context.Context) (*File, error)
+	predicates         []predicate.File
+}
+
+var _ ent.Mutation = (*FileMutation)(nil)
+
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
+
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileID sets the ID field of the mutation.
+func withFileID(id int) fileOption {
+	return func(m *FileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *File
+		)
+		m.oldValue = func(ctx context.Context) (*File, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().File.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUID sets the "uid" field.
+func (m *FileMutation) SetUID(u uuid.UUID) {
+	m.uid = &u
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *FileMutation) UID() (r uuid.UUID, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *FileMutation) ResetUID() {
+	m.uid = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FileMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FileMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *FileMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *FileMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FileMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *FileMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *FileMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *FileMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetObjectPath sets the "object_path" field.
+func (m *FileMutation) SetObjectPath(s string) {
+	m.object_path = &s
+}
+
+// ObjectPath returns the value of the "object_path" field in the mutation.
+func (m *FileMutation) ObjectPath() (r string, exists bool) {
+	v := m.object_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectPath returns the old "object_path" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldObjectPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectPath: %w", err)
+	}
+	return oldValue.ObjectPath, nil
+}
+
+// ResetObjectPath resets all changes to the "object_path" field.
+func (m *FileMutation) ResetObjectPath() {
+	m.object_path = nil
+}
+
+// SetLogicalPath sets the "logical_path" field.
+func (m *FileMutation) SetLogicalPath(s string) {
+	m.logical_path = &s
+}
+
+// LogicalPath returns the value of the "logical_path" field in the mutation.
+func (m *FileMutation) LogicalPath() (r string, exists bool) {
+	v := m.logical_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogicalPath returns the old "logical_path" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLogicalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogicalPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogicalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogicalPath: %w", err)
+	}
+	return oldValue.LogicalPath, nil
+}
+
+// ClearLogicalPath clears the value of the "logical_path" field.
+func (m *FileMutation) ClearLogicalPath() {
+	m.logical_path = nil
+	m.clearedFields[file.FieldLogicalPath] = struct{}{}
+}
+
+// LogicalPathCleared returns if the "logical_path" field was cleared in this mutation.
+func (m *FileMutation) LogicalPathCleared() bool {
+	_, ok := m.clearedFields[file.FieldLogicalPath]
+	return ok
+}
+
+// ResetLogicalPath resets all changes to the "logical_path" field.
+func (m *FileMutation) ResetLogicalPath() {
+	m.logical_path = nil
+	delete(m.clearedFields, file.FieldLogicalPath)
+}
+
+// SetVersion sets the "version" field.
+func (m *FileMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *FileMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *FileMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *FileMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *FileMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *FileMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *FileMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *FileMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *FileMutation) ResetMimeType() {
+	m.mime_type = nil
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (m *FileMutation) SetDetectedMimeType(s string) {
+	m.detected_mime_type = &s
+}
+
+// DetectedMimeType returns the value of the "detected_mime_type" field in the mutation.
+func (m *FileMutation) DetectedMimeType() (r string, exists bool) {
+	v := m.detected_mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedMimeType returns the old "detected_mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDetectedMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedMimeType: %w", err)
+	}
+	return oldValue.DetectedMimeType, nil
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (m *FileMutation) ClearDetectedMimeType() {
+	m.detected_mime_type = nil
+	m.clearedFields[file.FieldDetectedMimeType] = struct{}{}
+}
+
+// DetectedMimeTypeCleared returns if the "detected_mime_type" field was cleared in this mutation.
+func (m *FileMutation) DetectedMimeTypeCleared() bool {
+	_, ok := m.clearedFields[file.FieldDetectedMimeType]
+	return ok
+}
+
+// ResetDetectedMimeType resets all changes to the "detected_mime_type" field.
+func (m *FileMutation) ResetDetectedMimeType() {
+	m.detected_mime_type = nil
+	delete(m.clearedFields, file.FieldDetectedMimeType)
+}
+
+// SetEtag sets the "etag" field.
+func (m *FileMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *FileMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (m *FileMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[file.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *FileMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[file.FieldEtag]
+	return ok
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *FileMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, file.FieldEtag)
+}
+
+// SetLastModified sets the "last_modified" field.
+func (m *FileMutation) SetLastModified(t time.Time) {
+	m.last_modified = &t
+}
+
+// LastModified returns the value of the "last_modified" field in the mutation.
+func (m *FileMutation) LastModified() (r time.Time, exists bool) {
+	v := m.last_modified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastModified returns the old "last_modified" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLastModified(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastModified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastModified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastModified: %w", err)
+	}
+	return oldValue.LastModified, nil
+}
+
+// ClearLastModified clears the value of the "last_modified" field.
+func (m *FileMutation) ClearLastModified() {
+	m.last_modified = nil
+	m.clearedFields[file.FieldLastModified] = struct{}{}
+}
+
+// LastModifiedCleared returns if the "last_modified" field was cleared in this mutation.
+func (m *FileMutation) LastModifiedCleared() bool {
+	_, ok := m.clearedFields[file.FieldLastModified]
+	return ok
+}
+
+// ResetLastModified resets all changes to the "last_modified" field.
+func (m *FileMutation) ResetLastModified() {
+	m.last_modified = nil
+	delete(m.clearedFields, file.FieldLastModified)
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *FileMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *FileMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (m *FileMutation) ClearSha256() {
+	m.sha256 = nil
+	m.clearedFields[file.FieldSha256] = struct{}{}
+}
+
+// Sha256Cleared returns if the "sha256" field was cleared in this mutation.
+func (m *FileMutation) Sha256Cleared() bool {
+	_, ok := m.clearedFields[file.FieldSha256]
+	return ok
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *FileMutation) ResetSha256() {
+	m.sha256 = nil
+	delete(m.clearedFields, file.FieldSha256)
+}
+
+// SetMd5 sets the "md5" field.
+func (m *FileMutation) SetMd5(s string) {
+	m.md5 = &s
+}
+
+// Md5 returns the value of the "md5" field in the mutation.
+func (m *FileMutation) Md5() (r string, exists bool) {
+	v := m.md5
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMd5 returns the old "md5" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldMd5(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMd5 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMd5 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMd5: %w", err)
+	}
+	return oldValue.Md5, nil
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (m *FileMutation) ClearMd5() {
+	m.md5 = nil
+	m.clearedFields[file.FieldMd5] = struct{}{}
+}
+
+// Md5Cleared returns if the "md5" field was cleared in this mutation.
+func (m *FileMutation) Md5Cleared() bool {
+	_, ok := m.clearedFields[file.FieldMd5]
+	return ok
+}
+
+// ResetMd5 resets all changes to the "md5" field.
+func (m *FileMutation) ResetMd5() {
+	m.md5 = nil
+	delete(m.clearedFields, file.FieldMd5)
+}
+
+// SetBlobID sets the "blob_id" field.
+func (m *FileMutation) SetBlobID(i int) {
+	m.blob = &i
+}
+
+// BlobID returns the value of the "blob_id" field in the mutation.
+func (m *FileMutation) BlobID() (r int, exists bool) {
+	v := m.blob
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlobID returns the old "blob_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldBlobID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlobID: %w", err)
+	}
+	return oldValue.BlobID, nil
+}
+
+// ClearBlobID clears the value of the "blob_id" field.
+func (m *FileMutation) ClearBlobID() {
+	m.blob = nil
+	m.clearedFields[file.FieldBlobID] = struct{}{}
+}
+
+// BlobIDCleared returns if the "blob_id" field was cleared in this mutation.
+func (m *FileMutation) BlobIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldBlobID]
+	return ok
+}
+
+// ResetBlobID resets all changes to the "blob_id" field.
+func (m *FileMutation) ResetBlobID() {
+	m.blob = nil
+	delete(m.clearedFields, file.FieldBlobID)
+}
+
+// SetStatus sets the "status" field.
+func (m *FileMutation) SetStatus(f file.Status) {
+	m.status = &f
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FileMutation) Status() (r file.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldStatus(ctx context.Context) (v file.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FileMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *FileMutation) SetVisibility(f file.Visibility) {
+	m.visibility = &f
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *FileMutation) Visibility() (r file.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldVisibility(ctx context.Context) (v file.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *FileMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (m *FileMutation) SetScanStatus(fs file.ScanStatus) {
+	m.scan_status = &fs
+}
+
+// ScanStatus returns the value of the "scan_status" field in the mutation.
+func (m *FileMutation) ScanStatus() (r file.ScanStatus, exists bool) {
+	v := m.scan_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanStatus returns the old "scan_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScanStatus(ctx context.Context) (v file.ScanStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanStatus: %w", err)
+	}
+	return oldValue.ScanStatus, nil
+}
+
+// ResetScanStatus resets all changes to the "scan_status" field.
+func (m *FileMutation) ResetScanStatus() {
+	m.scan_status = nil
+}
+
+// SetScanResult sets the "scan_result" field.
+func (m *FileMutation) SetScanResult(s string) {
+	m.scan_result = &s
+}
+
+// ScanResult returns the value of the "scan_result" field in the mutation.
+func (m *FileMutation) ScanResult() (r string, exists bool) {
+	v := m.scan_result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanResult returns the old "scan_result" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScanResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanResult: %w", err)
+	}
+	return oldValue.ScanResult, nil
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (m *FileMutation) ClearScanResult() {
+	m.scan_result = nil
+	m.clearedFields[file.FieldScanResult] = struct{}{}
+}
+
+// ScanResultCleared returns if the "scan_result" field was cleared in this mutation.
+func (m *FileMutation) ScanResultCleared() bool {
+	_, ok := m.clearedFields[file.FieldScanResult]
+	return ok
+}
+
+// ResetScanResult resets all changes to the "scan_result" field.
+func (m *FileMutation) ResetScanResult() {
+	m.scan_result = nil
+	delete(m.clearedFields, file.FieldScanResult)
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (m *FileMutation) SetScannedAt(t time.Time) {
+	m.scanned_at = &t
+}
+
+// ScannedAt returns the value of the "scanned_at" field in the mutation.
+func (m *FileMutation) ScannedAt() (r time.Time, exists bool) {
+	v := m.scanned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScannedAt returns the old "scanned_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScannedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
+	}
+	return oldValue.ScannedAt, nil
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (m *FileMutation) ClearScannedAt() {
+	m.scanned_at = nil
+	m.clearedFields[file.FieldScannedAt] = struct{}{}
+}
+
+// ScannedAtCleared returns if the "scanned_at" field was cleared in this mutation.
+func (m *FileMutation) ScannedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldScannedAt]
+	return ok
+}
+
+// ResetScannedAt resets all changes to the "scanned_at" field.
+func (m *FileMutation) ResetScannedAt() {
+	m.scanned_at = nil
+	delete(m.clearedFields, file.FieldScannedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[file.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, file.FieldDeletedAt)
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (m *FileMutation) ClearBlob() {
+	m.clearedblob = true
+}
+
+// BlobCleared reports if the "blob" edge to the Blob entity was cleared.
+func (m *FileMutation) BlobCleared() bool {
+	return m.BlobIDCleared() || m.clearedblob
+}
+
+// BlobIDs returns the "blob" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlobID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) BlobIDs() (ids []int) {
+	if id := m.blob; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBlob resets all changes to the "blob" edge.
+func (m *FileMutation) ResetBlob() {
+	m.blob = nil
+	m.clearedblob = false
+}
+
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.uid != nil {
+		fields = append(fields, file.FieldUID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, file.FieldUserID)
+	}
+	if m.filename != nil {
+		fields = append(fields, file.FieldFilename)
+	}
+	if m.object_path != nil {
+		fields = append(fields, file.FieldObjectPath)
+	}
+	if m.logical_path != nil {
+		fields = append(fields, file.FieldLogicalPath)
+	}
+	if m.version != nil {
+		fields = append(fields, file.FieldVersion)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, file.FieldMimeType)
+	}
+	if m.detected_mime_type != nil {
+		fields = append(fields, file.FieldDetectedMimeType)
+	}
+	if m.etag != nil {
+		fields = append(fields, file.FieldEtag)
+	}
+	if m.last_modified != nil {
+		fields = append(fields, file.FieldLastModified)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, file.FieldSha256)
+	}
+	if m.md5 != nil {
+		fields = append(fields, file.FieldMd5)
+	}
+	if m.blob != nil {
+		fields = append(fields, file.FieldBlobID)
+	}
+	if m.status != nil {
+		fields = append(fields, file.FieldStatus)
+	}
+	if m.visibility != nil {
+		fields = append(fields, file.FieldVisibility)
+	}
+	if m.scan_status != nil {
+		fields = append(fields, file.FieldScanStatus)
+	}
+	if m.scan_result != nil {
+		fields = append(fields, file.FieldScanResult)
+	}
+	if m.scanned_at != nil {
+		fields = append(fields, file.FieldScannedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, file.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, file.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldUID:
+		return m.UID()
+	case file.FieldUserID:
+		return m.UserID()
+	case file.FieldFilename:
+		return m.Filename()
+	case file.FieldObjectPath:
+		return m.ObjectPath()
+	case file.FieldLogicalPath:
+		return m.LogicalPath()
+	case file.FieldVersion:
+		return m.Version()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldMimeType:
+		return m.MimeType()
+	case file.FieldDetectedMimeType:
+		return m.DetectedMimeType()
+	case file.FieldEtag:
+		return m.Etag()
+	case file.FieldLastModified:
+		return m.LastModified()
+	case file.FieldSha256:
+		return m.Sha256()
+	case file.FieldMd5:
+		return m.Md5()
+	case file.FieldBlobID:
+		return m.BlobID()
+	case file.FieldStatus:
+		return m.Status()
+	case file.FieldVisibility:
+		return m.Visibility()
+	case file.FieldScanStatus:
+		return m.ScanStatus()
+	case file.FieldScanResult:
+		return m.ScanResult()
+	case file.FieldScannedAt:
+		return m.ScannedAt()
+	case file.FieldCreatedAt:
+		return m.CreatedAt()
+	case file.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case file.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldUID:
+		return m.OldUID(ctx)
+	case file.FieldUserID:
+		return m.OldUserID(ctx)
+	case file.FieldFilename:
+		return m.OldFilename(ctx)
+	case file.FieldObjectPath:
+		return m.OldObjectPath(ctx)
+	case file.FieldLogicalPath:
+		return m.OldLogicalPath(ctx)
+	case file.FieldVersion:
+		return m.OldVersion(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case file.FieldDetectedMimeType:
+		return m.OldDetectedMimeType(ctx)
+	case file.FieldEtag:
+		return m.OldEtag(ctx)
+	case file.FieldLastModified:
+		return m.OldLastModified(ctx)
+	case file.FieldSha256:
+		return m.OldSha256(ctx)
+	case file.FieldMd5:
+		return m.OldMd5(ctx)
+	case file.FieldBlobID:
+		return m.OldBlobID(ctx)
+	case file.FieldStatus:
+		return m.OldStatus(ctx)
+	case file.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case file.FieldScanStatus:
+		return m.OldScanStatus(ctx)
+	case file.FieldScanResult:
+		return m.OldScanResult(ctx)
+	case file.FieldScannedAt:
+		return m.OldScannedAt(ctx)
+	case file.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case file.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case file.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown File field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case file.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case file.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case file.FieldObjectPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectPath(v)
+		return nil
+	case file.FieldLogicalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogicalPath(v)
+		return nil
+	case file.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case file.FieldDetectedMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedMimeType(v)
+		return nil
+	case file.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case file.FieldLastModified:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastModified(v)
+		return nil
+	case file.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case file.FieldMd5:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMd5(v)
+		return nil
+	case file.FieldBlobID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlobID(v)
+		return nil
+	case file.FieldStatus:
+		v, ok := value.(file.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case file.FieldVisibility:
+		v, ok := value.(file.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case file.FieldScanStatus:
+		v, ok := value.(file.ScanStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanStatus(v)
+		return nil
+	case file.FieldScanResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanResult(v)
+		return nil
+	case file.FieldScannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannedAt(v)
+		return nil
+	case file.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case file.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case file.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, file.FieldUserID)
+	}
+	if m.addversion != nil {
+		fields = append(fields, file.FieldVersion)
+	}
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldUserID:
+		return m.AddedUserID()
+	case file.FieldVersion:
+		return m.AddedVersion()
+	case file.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case file.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldLogicalPath) {
+		fields = append(fields, file.FieldLogicalPath)
+	}
+	if m.FieldCleared(file.FieldDetectedMimeType) {
+		fields = append(fields, file.FieldDetectedMimeType)
+	}
+	if m.FieldCleared(file.FieldEtag) {
+		fields = append(fields, file.FieldEtag)
+	}
+	if m.FieldCleared(file.FieldLastModified) {
+		fields = append(fields, file.FieldLastModified)
+	}
+	if m.FieldCleared(file.FieldSha256) {
+		fields = append(fields, file.FieldSha256)
+	}
+	if m.FieldCleared(file.FieldMd5) {
+		fields = append(fields, file.FieldMd5)
+	}
+	if m.FieldCleared(file.FieldBlobID) {
+		fields = append(fields, file.FieldBlobID)
+	}
+	if m.FieldCleared(file.FieldScanResult) {
+		fields = append(fields, file.FieldScanResult)
+	}
+	if m.FieldCleared(file.FieldScannedAt) {
+		fields = append(fields, file.FieldScannedAt)
+	}
+	if m.FieldCleared(file.FieldDeletedAt) {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldLogicalPath:
+		m.ClearLogicalPath()
+		return nil
+	case file.FieldDetectedMimeType:
+		m.ClearDetectedMimeType()
+		return nil
+	case file.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case file.FieldLastModified:
+		m.ClearLastModified()
+		return nil
+	case file.FieldSha256:
+		m.ClearSha256()
+		return nil
+	case file.FieldMd5:
+		m.ClearMd5()
+		return nil
+	case file.FieldBlobID:
+		m.ClearBlobID()
+		return nil
+	case file.FieldScanResult:
+		m.ClearScanResult()
+		return nil
+	case file.FieldScannedAt:
+		m.ClearScannedAt()
+		return nil
+	case file.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldUID:
+		m.ResetUID()
+		return nil
+	case file.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case file.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case file.FieldObjectPath:
+		m.ResetObjectPath()
+		return nil
+	case file.FieldLogicalPath:
+		m.ResetLogicalPath()
+		return nil
+	case file.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case file.FieldDetectedMimeType:
+		m.ResetDetectedMimeType()
+		return nil
+	case file.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case file.FieldLastModified:
+		m.ResetLastModified()
+		return nil
+	case file.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case file.FieldMd5:
+		m.ResetMd5()
+		return nil
+	case file.FieldBlobID:
+		m.ResetBlobID()
+		return nil
+	case file.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case file.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case file.FieldScanStatus:
+		m.ResetScanStatus()
+		return nil
+	case file.FieldScanResult:
+		m.ResetScanResult()
+		return nil
+	case file.FieldScannedAt:
+		m.ResetScannedAt()
+		return nil
+	case file.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case file.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case file.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.blob != nil {
+		edges = append(edges, file.EdgeBlob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeBlob:
+		if id := m.blob; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedblob {
+		edges = append(edges, file.EdgeBlob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeBlob:
+		return m.clearedblob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	case file.EdgeBlob:
+		m.ClearBlob()
+		return nil
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeBlob:
+		m.ResetBlob()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// MultipartMutation represents an operation that mutates the Multipart nodes in the graph.
+type MultipartMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	uid                *uuid.UUID
+	user_id            *int
+	adduser_id         *int
+	filename           *string
+	object_path        *string
+	mime_type          *string
+	detected_mime_type *string
+	upload_id          *string
+	upload_length      *int
+	addupload_length   *int
+	visibility         *multipart.Visibility
+	status             *multipart.Status
+	file_uid           *uuid.UUID
+	lock_uid           *uuid.UUID
+	locked_until       *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Multipart, error)
+	predicates         []predicate.Multipart
+}
+
+var _ ent.Mutation = (*MultipartMutation)(nil)
+
+// multipartOption allows management of the mutation configuration using functional options.
+type multipartOption func(*MultipartMutation)
+
+// newMultipartMutation creates new mutation for the Multipart entity.
+func newMultipartMutation(c config, op Op, opts ...multipartOption) *MultipartMutation {
+	m := &MultipartMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMultipart,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMultipartID sets the ID field of the mutation.
+func withMultipartID(id int) multipartOption {
+	return func(m *MultipartMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Multipart
+		)
+		m.oldValue = func(ctx context.Context) (*Multipart, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Multipart.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMultipart sets the old Multipart of the mutation.
+func withMultipart(node *Multipart) multipartOption {
+	return func(m *MultipartMutation) {
+		m.oldValue = func(context.Context) (*Multipart, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MultipartMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MultipartMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MultipartMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MultipartMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Multipart.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUID sets the "uid" field.
+func (m *MultipartMutation) SetUID(u uuid.UUID) {
+	m.uid = &u
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *MultipartMutation) UID() (r uuid.UUID, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *MultipartMutation) ResetUID() {
+	m.uid = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MultipartMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MultipartMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *MultipartMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *MultipartMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MultipartMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *MultipartMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *MultipartMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *MultipartMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetObjectPath sets the "object_path" field.
+func (m *MultipartMutation) SetObjectPath(s string) {
+	m.object_path = &s
+}
+
+// ObjectPath returns the value of the "object_path" field in the mutation.
+func (m *MultipartMutation) ObjectPath() (r string, exists bool) {
+	v := m.object_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectPath returns the old "object_path" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldObjectPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectPath: %w", err)
+	}
+	return oldValue.ObjectPath, nil
+}
+
+// ResetObjectPath resets all changes to the "object_path" field.
+func (m *MultipartMutation) ResetObjectPath() {
+	m.object_path = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *MultipartMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *MultipartMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *MultipartMutation) ResetMimeType() {
+	m.mime_type = nil
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (m *MultipartMutation) SetDetectedMimeType(s string) {
+	m.detected_mime_type = &s
+}
+
+// DetectedMimeType returns the value of the "detected_mime_type" field in the mutation.
+func (m *MultipartMutation) DetectedMimeType() (r string, exists bool) {
+	v := m.detected_mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedMimeType returns the old "detected_mime_type" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldDetectedMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedMimeType: %w", err)
+	}
+	return oldValue.DetectedMimeType, nil
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (m *MultipartMutation) ClearDetectedMimeType() {
+	m.detected_mime_type = nil
+	m.clearedFields[multipart.FieldDetectedMimeType] = struct{}{}
+}
+
+// DetectedMimeTypeCleared returns if the "detected_mime_type" field was cleared in this mutation.
+func (m *MultipartMutation) DetectedMimeTypeCleared() bool {
+	_, ok := m.clearedFields[multipart.FieldDetectedMimeType]
+	return ok
+}
+
+// ResetDetectedMimeType resets all changes to the "detected_mime_type" field.
+func (m *MultipartMutation) ResetDetectedMimeType() {
+	m.detected_mime_type = nil
+	delete(m.clearedFields, multipart.FieldDetectedMimeType)
+}
+
+// SetUploadID sets the "upload_id" field.
+func (m *MultipartMutation) SetUploadID(s string) {
+	m.upload_id = &s
+}
+
+// UploadID returns the value of the "upload_id" field in the mutation.
+func (m *MultipartMutation) UploadID() (r string, exists bool) {
+	v := m.upload_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadID returns the old "upload_id" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldUploadID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadID: %w", err)
+	}
+	return oldValue.UploadID, nil
+}
+
+// ResetUploadID resets all changes to the "upload_id" field.
+func (m *MultipartMutation) ResetUploadID() {
+	m.upload_id = nil
+}
+
+// SetUploadLength sets the "upload_length" field.
+func (m *MultipartMutation) SetUploadLength(i int) {
+	m.upload_length = &i
+	m.addupload_length = nil
+}
+
+// UploadLength returns the value of the "upload_length" field in the mutation.
+func (m *MultipartMutation) UploadLength() (r int, exists bool) {
+	v := m.upload_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadLength returns the old "upload_length" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldUploadLength(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadLength: %w", err)
+	}
+	return oldValue.UploadLength, nil
+}
+
+// AddUploadLength adds i to the "upload_length" field.
+func (m *MultipartMutation) AddUploadLength(i int) {
+	if m.addupload_length != nil {
+		*m.addupload_length += i
+	} else {
+		m.addupload_length = &i
+	}
+}
+
+// AddedUploadLength returns the value that was added to the "upload_length" field in this mutation.
+func (m *MultipartMutation) AddedUploadLength() (r int, exists bool) {
+	v := m.addupload_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUploadLength clears the value of the "upload_length" field.
+func (m *MultipartMutation) ClearUploadLength() {
+	m.upload_length = nil
+	m.addupload_length = nil
+	m.clearedFields[multipart.FieldUploadLength] = struct{}{}
+}
+
+// UploadLengthCleared returns if the "upload_length" field was cleared in this mutation.
+func (m *MultipartMutation) UploadLengthCleared() bool {
+	_, ok := m.clearedFields[multipart.FieldUploadLength]
+	return ok
+}
+
+// ResetUploadLength resets all changes to the "upload_length" field.
+func (m *MultipartMutation) ResetUploadLength() {
+	m.upload_length = nil
+	m.addupload_length = nil
+	delete(m.clearedFields, multipart.FieldUploadLength)
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *MultipartMutation) SetVisibility(value multipart.Visibility) {
+	m.visibility = &value
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *MultipartMutation) Visibility() (r multipart.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldVisibility(ctx context.Context) (v multipart.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *MultipartMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *MultipartMutation) SetStatus(value multipart.Status) {
+	m.status = &value
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MultipartMutation) Status() (r multipart.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldStatus(ctx context.Context) (v multipart.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MultipartMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFileUID sets the "file_uid" field.
+func (m *MultipartMutation) SetFileUID(u uuid.UUID) {
+	m.file_uid = &u
+}
+
+// FileUID returns the value of the "file_uid" field in the mutation.
+func (m *MultipartMutation) FileUID() (r uuid.UUID, exists bool) {
+	v := m.file_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileUID returns the old "file_uid" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldFileUID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileUID: %w", err)
+	}
+	return oldValue.FileUID, nil
+}
+
+// ClearFileUID clears the value of the "file_uid" field.
+func (m *MultipartMutation) ClearFileUID() {
+	m.file_uid = nil
+	m.clearedFields[multipart.FieldFileUID] = struct{}{}
+}
+
+// FileUIDCleared returns if the "file_uid" field was cleared in this mutation.
+func (m *MultipartMutation) FileUIDCleared() bool {
+	_, ok := m.clearedFields[multipart.FieldFileUID]
+	return ok
+}
+
+// ResetFileUID resets all changes to the "file_uid" field.
+func (m *MultipartMutation) ResetFileUID() {
+	m.file_uid = nil
+	delete(m.clearedFields, multipart.FieldFileUID)
+}
+
+// SetLockUID sets the "lock_uid" field.
+func (m *MultipartMutation) SetLockUID(u uuid.UUID) {
+	m.lock_uid = &u
+}
+
+// LockUID returns the value of the "lock_uid" field in the mutation.
+func (m *MultipartMutation) LockUID() (r uuid.UUID, exists bool) {
+	v := m.lock_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockUID returns the old "lock_uid" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldLockUID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockUID: %w", err)
+	}
+	return oldValue.LockUID, nil
+}
+
+// ClearLockUID clears the value of the "lock_uid" field.
+func (m *MultipartMutation) ClearLockUID() {
+	m.lock_uid = nil
+	m.clearedFields[multipart.FieldLockUID] = struct{}{}
+}
+
+// LockUIDCleared returns if the "lock_uid" field was cleared in this mutation.
+func (m *MultipartMutation) LockUIDCleared() bool {
+	_, ok := m.clearedFields[multipart.FieldLockUID]
+	return ok
+}
+
+// ResetLockUID resets all changes to the "lock_uid" field.
+func (m *MultipartMutation) ResetLockUID() {
+	m.lock_uid = nil
+	delete(m.clearedFields, multipart.FieldLockUID)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *MultipartMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *MultipartMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *MultipartMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[multipart.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *MultipartMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[multipart.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *MultipartMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, multipart.FieldLockedUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MultipartMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MultipartMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MultipartMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MultipartMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MultipartMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Multipart entity.
+// If the Multipart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MultipartMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the MultipartMutation builder.
+func (m *MultipartMutation) Where(ps ...predicate.Multipart) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MultipartMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MultipartMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Multipart, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MultipartMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MultipartMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Multipart).
+func (m *MultipartMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MultipartMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.uid != nil {
+		fields = append(fields, multipart.FieldUID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, multipart.FieldUserID)
+	}
+	if m.filename != nil {
+		fields = append(fields, multipart.FieldFilename)
+	}
+	if m.object_path != nil {
+		fields = append(fields, multipart.FieldObjectPath)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, multipart.FieldMimeType)
+	}
+	if m.detected_mime_type != nil {
+		fields = append(fields, multipart.FieldDetectedMimeType)
+	}
+	if m.upload_id != nil {
+		fields = append(fields, multipart.FieldUploadID)
+	}
+	if m.upload_length != nil {
+		fields = append(fields, multipart.FieldUploadLength)
+	}
+	if m.visibility != nil {
+		fields = append(fields, multipart.FieldVisibility)
+	}
+	if m.status != nil {
+		fields = append(fields, multipart.FieldStatus)
+	}
+	if m.file_uid != nil {
+		fields = append(fields, multipart.FieldFileUID)
+	}
+	if m.lock_uid != nil {
+		fields = append(fields, multipart.FieldLockUID)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, multipart.FieldLockedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, multipart.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, multipart.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MultipartMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case multipart.FieldUID:
+		return m.UID()
+	case multipart.FieldUserID:
+		return m.UserID()
+	case multipart.FieldFilename:
+		return m.Filename()
+	case multipart.FieldObjectPath:
+		return m.ObjectPath()
+	case multipart.FieldMimeType:
+		return m.MimeType()
+	case multipart.FieldDetectedMimeType:
+		return m.DetectedMimeType()
+	case multipart.FieldUploadID:
+		return m.UploadID()
+	case multipart.FieldUploadLength:
+		return m.UploadLength()
+	case multipart.FieldVisibility:
+		return m.Visibility()
+	case multipart.FieldStatus:
+		return m.Status()
+	case multipart.FieldFileUID:
+		return m.FileUID()
+	case multipart.FieldLockUID:
+		return m.LockUID()
+	case multipart.FieldLockedUntil:
+		return m.LockedUntil()
+	case multipart.FieldCreatedAt:
+		return m.CreatedAt()
+	case multipart.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MultipartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case multipart.FieldUID:
+		return m.OldUID(ctx)
+	case multipart.FieldUserID:
+		return m.OldUserID(ctx)
+	case multipart.FieldFilename:
+		return m.OldFilename(ctx)
+	case multipart.FieldObjectPath:
+		return m.OldObjectPath(ctx)
+	case multipart.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case multipart.FieldDetectedMimeType:
+		return m.OldDetectedMimeType(ctx)
+	case multipart.FieldUploadID:
+		return m.OldUploadID(ctx)
+	case multipart.FieldUploadLength:
+		return m.OldUploadLength(ctx)
+	case multipart.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case multipart.FieldStatus:
+		return m.OldStatus(ctx)
+	case multipart.FieldFileUID:
+		return m.OldFileUID(ctx)
+	case multipart.FieldLockUID:
+		return m.OldLockUID(ctx)
+	case multipart.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case multipart.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case multipart.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Multipart field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MultipartMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case multipart.FieldUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case multipart.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case multipart.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case multipart.FieldObjectPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectPath(v)
+		return nil
+	case multipart.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case multipart.FieldDetectedMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedMimeType(v)
+		return nil
+	case multipart.FieldUploadID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadID(v)
+		return nil
+	case multipart.FieldUploadLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadLength(v)
+		return nil
+	case multipart.FieldVisibility:
+		v, ok := value.(multipart.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case multipart.FieldStatus:
+		v, ok := value.(multipart.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case multipart.FieldFileUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileUID(v)
+		return nil
+	case multipart.FieldLockUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockUID(v)
+		return nil
+	case multipart.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case multipart.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case multipart.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Multipart field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MultipartMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, multipart.FieldUserID)
+	}
+	if m.addupload_length != nil {
+		fields = append(fields, multipart.FieldUploadLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MultipartMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case multipart.FieldUserID:
+		return m.AddedUserID()
+	case multipart.FieldUploadLength:
+		return m.AddedUploadLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MultipartMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case multipart.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case multipart.FieldUploadLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploadLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Multipart numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MultipartMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(multipart.FieldDetectedMimeType) {
+		fields = append(fields, multipart.FieldDetectedMimeType)
+	}
+	if m.FieldCleared(multipart.FieldUploadLength) {
+		fields = append(fields, multipart.FieldUploadLength)
+	}
+	if m.FieldCleared(multipart.FieldFileUID) {
+		fields = append(fields, multipart.FieldFileUID)
+	}
+	if m.FieldCleared(multipart.FieldLockUID) {
+		fields = append(fields, multipart.FieldLockUID)
+	}
+	if m.FieldCleared(multipart.FieldLockedUntil) {
+		fields = append(fields, multipart.FieldLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MultipartMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MultipartMutation) ClearField(name string) error {
+	switch name {
+	case multipart.FieldDetectedMimeType:
+		m.ClearDetectedMimeType()
+		return nil
+	case multipart.FieldUploadLength:
+		m.ClearUploadLength()
+		return nil
+	case multipart.FieldFileUID:
+		m.ClearFileUID()
+		return nil
+	case multipart.FieldLockUID:
+		m.ClearLockUID()
+		return nil
+	case multipart.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown Multipart nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MultipartMutation) ResetField(name string) error {
+	switch name {
+	case multipart.FieldUID:
+		m.ResetUID()
+		return nil
+	case multipart.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case multipart.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case multipart.FieldObjectPath:
+		m.ResetObjectPath()
+		return nil
+	case multipart.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case multipart.FieldDetectedMimeType:
+		m.ResetDetectedMimeType()
+		return nil
+	case multipart.FieldUploadID:
+		m.ResetUploadID()
+		return nil
+	case multipart.FieldUploadLength:
+		m.ResetUploadLength()
+		return nil
+	case multipart.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case multipart.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case multipart.FieldFileUID:
+		m.ResetFileUID()
+		return nil
+	case multipart.FieldLockUID:
+		m.ResetLockUID()
+		return nil
+	case multipart.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case multipart.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case multipart.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Multipart field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MultipartMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MultipartMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MultipartMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MultipartMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MultipartMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MultipartMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MultipartMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Multipart unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MultipartMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Multipart edge %s", name)
+}
+
+// MultipartPartMutation represents an operation that mutates the MultipartPart nodes in the graph.
+type MultipartPartMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	multipart_id    *int
+	addmultipart_id *int
+	part_number     *int
+	addpart_number  *int
+	etag            *string
+	size            *int
+	addsize         *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*MultipartPart, error)
+	predicates      []predicate.MultipartPart
+}
+
+var _ ent.Mutation = (*MultipartPartMutation)(nil)
+
+// multipartpartOption allows management of the mutation configuration using functional options.
+type multipartpartOption func(*MultipartPartMutation)
+
+// newMultipartPartMutation creates new mutation for the MultipartPart entity.
+func newMultipartPartMutation(c config, op Op, opts ...multipartpartOption) *MultipartPartMutation {
+	m := &MultipartPartMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMultipartPart,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMultipartPartID sets the ID field of the mutation.
+func withMultipartPartID(id int) multipartpartOption {
+	return func(m *MultipartPartMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MultipartPart
+		)
+		m.oldValue = func(ctx context.Context) (*MultipartPart, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MultipartPart.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMultipartPart sets the old MultipartPart of the mutation.
+func withMultipartPart(node *MultipartPart) multipartpartOption {
+	return func(m *MultipartPartMutation) {
+		m.oldValue = func(context.Context) (*MultipartPart, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MultipartPartMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MultipartPartMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MultipartPartMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MultipartPartMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MultipartPart.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (m *MultipartPartMutation) SetMultipartID(i int) {
+	m.multipart_id = &i
+	m.addmultipart_id = nil
+}
+
+// MultipartID returns the value of the "multipart_id" field in the mutation.
+func (m *MultipartPartMutation) MultipartID() (r int, exists bool) {
+	v := m.multipart_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultipartID returns the old "multipart_id" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldMultipartID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultipartID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultipartID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultipartID: %w", err)
+	}
+	return oldValue.MultipartID, nil
+}
+
+// AddMultipartID adds i to the "multipart_id" field.
+func (m *MultipartPartMutation) AddMultipartID(i int) {
+	if m.addmultipart_id != nil {
+		*m.addmultipart_id += i
+	} else {
+		m.addmultipart_id = &i
+	}
+}
+
+// AddedMultipartID returns the value that was added to the "multipart_id" field in this mutation.
+func (m *MultipartPartMutation) AddedMultipartID() (r int, exists bool) {
+	v := m.addmultipart_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMultipartID resets all changes to the "multipart_id" field.
+func (m *MultipartPartMutation) ResetMultipartID() {
+	m.multipart_id = nil
+	m.addmultipart_id = nil
+}
+
+// SetPartNumber sets the "part_number" field.
+func (m *MultipartPartMutation) SetPartNumber(i int) {
+	m.part_number = &i
+	m.addpart_number = nil
+}
+
+// PartNumber returns the value of the "part_number" field in the mutation.
+func (m *MultipartPartMutation) PartNumber() (r int, exists bool) {
+	v := m.part_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartNumber returns the old "part_number" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldPartNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartNumber: %w", err)
+	}
+	return oldValue.PartNumber, nil
+}
+
+// AddPartNumber adds i to the "part_number" field.
+func (m *MultipartPartMutation) AddPartNumber(i int) {
+	if m.addpart_number != nil {
+		*m.addpart_number += i
+	} else {
+		m.addpart_number = &i
+	}
+}
+
+// AddedPartNumber returns the value that was added to the "part_number" field in this mutation.
+func (m *MultipartPartMutation) AddedPartNumber() (r int, exists bool) {
+	v := m.addpart_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPartNumber resets all changes to the "part_number" field.
+func (m *MultipartPartMutation) ResetPartNumber() {
+	m.part_number = nil
+	m.addpart_number = nil
+}
+
+// SetEtag sets the "etag" field.
+func (m *MultipartPartMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *MultipartPartMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *MultipartPartMutation) ResetEtag() {
+	m.etag = nil
+}
+
+// SetSize sets the "size" field.
+func (m *MultipartPartMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *MultipartPartMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *MultipartPartMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *MultipartPartMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *MultipartPartMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MultipartPartMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MultipartPartMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MultipartPartMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MultipartPartMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MultipartPartMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MultipartPart entity.
+// If the MultipartPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MultipartPartMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MultipartPartMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the MultipartPartMutation builder.
+func (m *MultipartPartMutation) Where(ps ...predicate.MultipartPart) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MultipartPartMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MultipartPartMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MultipartPart, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MultipartPartMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MultipartPartMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MultipartPart).
+func (m *MultipartPartMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MultipartPartMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.multipart_id != nil {
+		fields = append(fields, multipartpart.FieldMultipartID)
+	}
+	if m.part_number != nil {
+		fields = append(fields, multipartpart.FieldPartNumber)
+	}
+	if m.etag != nil {
+		fields = append(fields, multipartpart.FieldEtag)
+	}
+	if m.size != nil {
+		fields = append(fields, multipartpart.FieldSize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, multipartpart.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, multipartpart.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MultipartPartMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		return m.MultipartID()
+	case multipartpart.FieldPartNumber:
+		return m.PartNumber()
+	case multipartpart.FieldEtag:
+		return m.Etag()
+	case multipartpart.FieldSize:
+		return m.Size()
+	case multipartpart.FieldCreatedAt:
+		return m.CreatedAt()
+	case multipartpart.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MultipartPartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		return m.OldMultipartID(ctx)
+	case multipartpart.FieldPartNumber:
+		return m.OldPartNumber(ctx)
+	case multipartpart.FieldEtag:
+		return m.OldEtag(ctx)
+	case multipartpart.FieldSize:
+		return m.OldSize(ctx)
+	case multipartpart.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case multipartpart.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MultipartPart field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MultipartPartMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultipartID(v)
+		return nil
+	case multipartpart.FieldPartNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartNumber(v)
+		return nil
+	case multipartpart.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case multipartpart.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case multipartpart.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case multipartpart.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MultipartPart field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MultipartPartMutation) AddedFields() []string {
+	var fields []string
+	if m.addmultipart_id != nil {
+		fields = append(fields, multipartpart.FieldMultipartID)
+	}
+	if m.addpart_number != nil {
+		fields = append(fields, multipartpart.FieldPartNumber)
+	}
+	if m.addsize != nil {
+		fields = append(fields, multipartpart.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MultipartPartMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		return m.AddedMultipartID()
+	case multipartpart.FieldPartNumber:
+		return m.AddedPartNumber()
+	case multipartpart.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MultipartPartMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMultipartID(v)
+		return nil
+	case multipartpart.FieldPartNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPartNumber(v)
+		return nil
+	case multipartpart.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MultipartPart numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MultipartPartMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MultipartPartMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MultipartPartMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MultipartPart nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MultipartPartMutation) ResetField(name string) error {
+	switch name {
+	case multipartpart.FieldMultipartID:
+		m.ResetMultipartID()
+		return nil
+	case multipartpart.FieldPartNumber:
+		m.ResetPartNumber()
+		return nil
+	case multipartpart.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case multipartpart.FieldSize:
+		m.ResetSize()
+		return nil
+	case multipartpart.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case multipartpart.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MultipartPart field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MultipartPartMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MultipartPartMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MultipartPartMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MultipartPartMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MultipartPartMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MultipartPartMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MultipartPartMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MultipartPart unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MultipartPartMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MultipartPart edge %s", name)
+}
+
+// ShareLinkMutation represents an operation that mutates the ShareLink nodes in the graph.
+type ShareLinkMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	uid              *uuid.UUID
+	token            *string
+	user_id          *int
+	adduser_id       *int
+	password_hash    *string
+	expires_at       *time.Time
+	max_downloads    *int
+	addmax_downloads *int
+	downloads        *int
+	adddownloads     *int
+	revoked_at       *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	file             *int
+	clearedfile      bool
+	done             bool
+	oldValue         func(context.Context) (*ShareLink, error)
+	predicates       []predicate.ShareLink
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
+var _ ent.Mutation = (*ShareLinkMutation)(nil)
 
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
+// sharelinkOption allows management of the mutation configuration using functional options.
+type sharelinkOption func(*ShareLinkMutation)
 
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
+// newShareLinkMutation creates new mutation for the ShareLink entity.
+func newShareLinkMutation(c config, op Op, opts ...sharelinkOption) *ShareLinkMutation {
+	m := &ShareLinkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeShareLink,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +4579,20 @@ func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
 	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id int) fileOption {
-	return func(m *FileMutation) {
+// withShareLinkID sets the ID field of the mutation.
+func withShareLinkID(id int) sharelinkOption {
+	return func(m *ShareLinkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *File
+			value *ShareLink
 		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
+		m.oldValue = func(ctx context.Context) (*ShareLink, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().File.Get(ctx, id)
+					value, err = m.Client().ShareLink.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +4601,10 @@ func withFileID(id int) fileOption {
 	}
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
+// withShareLink sets the old ShareLink of the mutation.
+func withShareLink(node *ShareLink) sharelinkOption {
+	return func(m *ShareLinkMutation) {
+		m.oldValue = func(context.Context) (*ShareLink, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +4613,7 @@ func withFile(node *File) fileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m ShareLinkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +4621,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m ShareLinkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,7 +4632,7 @@ func (m FileMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id int, exists bool) {
+func (m *ShareLinkMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -134,7 +4643,7 @@ func (m *FileMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ShareLinkMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -143,19 +4652,19 @@ func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ShareLink.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUID sets the "uid" field.
-func (m *FileMutation) SetUID(u uuid.UUID) {
+func (m *ShareLinkMutation) SetUID(u uuid.UUID) {
 	m.uid = &u
 }
 
 // UID returns the value of the "uid" field in the mutation.
-func (m *FileMutation) UID() (r uuid.UUID, exists bool) {
+func (m *ShareLinkMutation) UID() (r uuid.UUID, exists bool) {
 	v := m.uid
 	if v == nil {
 		return
@@ -163,10 +4672,10 @@ func (m *FileMutation) UID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUID returns the old "uid" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ShareLinkMutation) OldUID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUID is only allowed on UpdateOne operations")
 	}
@@ -181,18 +4690,90 @@ func (m *FileMutation) OldUID(ctx context.Context) (v uuid.UUID, err error) {
 }
 
 // ResetUID resets all changes to the "uid" field.
-func (m *FileMutation) ResetUID() {
+func (m *ShareLinkMutation) ResetUID() {
 	m.uid = nil
 }
 
+// SetToken sets the "token" field.
+func (m *ShareLinkMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *ShareLinkMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *ShareLinkMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *ShareLinkMutation) SetFileID(i int) {
+	m.file = &i
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *ShareLinkMutation) FileID() (r int, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldFileID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *ShareLinkMutation) ResetFileID() {
+	m.file = nil
+}
+
 // SetUserID sets the "user_id" field.
-func (m *FileMutation) SetUserID(i int) {
+func (m *ShareLinkMutation) SetUserID(i int) {
 	m.user_id = &i
 	m.adduser_id = nil
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *FileMutation) UserID() (r int, exists bool) {
+func (m *ShareLinkMutation) UserID() (r int, exists bool) {
 	v := m.user_id
 	if v == nil {
 		return
@@ -200,10 +4781,10 @@ func (m *FileMutation) UserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *ShareLinkMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -218,7 +4799,7 @@ func (m *FileMutation) OldUserID(ctx context.Context) (v int, err error) {
 }
 
 // AddUserID adds i to the "user_id" field.
-func (m *FileMutation) AddUserID(i int) {
+func (m *ShareLinkMutation) AddUserID(i int) {
 	if m.adduser_id != nil {
 		*m.adduser_id += i
 	} else {
@@ -227,7 +4808,7 @@ func (m *FileMutation) AddUserID(i int) {
 }
 
 // AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *FileMutation) AddedUserID() (r int, exists bool) {
+func (m *ShareLinkMutation) AddedUserID() (r int, exists bool) {
 	v := m.adduser_id
 	if v == nil {
 		return
@@ -236,182 +4817,291 @@ func (m *FileMutation) AddedUserID() (r int, exists bool) {
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *FileMutation) ResetUserID() {
+func (m *ShareLinkMutation) ResetUserID() {
 	m.user_id = nil
 	m.adduser_id = nil
 }
 
-// SetFilename sets the "filename" field.
-func (m *FileMutation) SetFilename(s string) {
-	m.filename = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *ShareLinkMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *FileMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *ShareLinkMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *ShareLinkMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *FileMutation) ResetFilename() {
-	m.filename = nil
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *ShareLinkMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[sharelink.FieldPasswordHash] = struct{}{}
 }
 
-// SetObjectPath sets the "object_path" field.
-func (m *FileMutation) SetObjectPath(s string) {
-	m.object_path = &s
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *ShareLinkMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldPasswordHash]
+	return ok
 }
 
-// ObjectPath returns the value of the "object_path" field in the mutation.
-func (m *FileMutation) ObjectPath() (r string, exists bool) {
-	v := m.object_path
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *ShareLinkMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, sharelink.FieldPasswordHash)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ShareLinkMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ShareLinkMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObjectPath returns the old "object_path" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldObjectPath(ctx context.Context) (v string, err error) {
+func (m *ShareLinkMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObjectPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObjectPath requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObjectPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.ObjectPath, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetObjectPath resets all changes to the "object_path" field.
-func (m *FileMutation) ResetObjectPath() {
-	m.object_path = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ShareLinkMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[sharelink.FieldExpiresAt] = struct{}{}
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(i int) {
-	m.size = &i
-	m.addsize = nil
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ShareLinkMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldExpiresAt]
+	return ok
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r int, exists bool) {
-	v := m.size
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ShareLinkMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, sharelink.FieldExpiresAt)
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (m *ShareLinkMutation) SetMaxDownloads(i int) {
+	m.max_downloads = &i
+	m.addmax_downloads = nil
+}
+
+// MaxDownloads returns the value of the "max_downloads" field in the mutation.
+func (m *ShareLinkMutation) MaxDownloads() (r int, exists bool) {
+	v := m.max_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxDownloads returns the old "max_downloads" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v int, err error) {
+func (m *ShareLinkMutation) OldMaxDownloads(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldMaxDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxDownloads: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.MaxDownloads, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *FileMutation) AddSize(i int) {
-	if m.addsize != nil {
-		*m.addsize += i
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (m *ShareLinkMutation) AddMaxDownloads(i int) {
+	if m.addmax_downloads != nil {
+		*m.addmax_downloads += i
+	} else {
+		m.addmax_downloads = &i
+	}
+}
+
+// AddedMaxDownloads returns the value that was added to the "max_downloads" field in this mutation.
+func (m *ShareLinkMutation) AddedMaxDownloads() (r int, exists bool) {
+	v := m.addmax_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (m *ShareLinkMutation) ClearMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	m.clearedFields[sharelink.FieldMaxDownloads] = struct{}{}
+}
+
+// MaxDownloadsCleared returns if the "max_downloads" field was cleared in this mutation.
+func (m *ShareLinkMutation) MaxDownloadsCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldMaxDownloads]
+	return ok
+}
+
+// ResetMaxDownloads resets all changes to the "max_downloads" field.
+func (m *ShareLinkMutation) ResetMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	delete(m.clearedFields, sharelink.FieldMaxDownloads)
+}
+
+// SetDownloads sets the "downloads" field.
+func (m *ShareLinkMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *ShareLinkMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloads returns the old "downloads" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldDownloads(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+	}
+	return oldValue.Downloads, nil
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (m *ShareLinkMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
 	} else {
-		m.addsize = &i
+		m.adddownloads = &i
 	}
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int, exists bool) {
-	v := m.addsize
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *ShareLinkMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *ShareLinkMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *FileMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ShareLinkMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *FileMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ShareLinkMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMimeType returns the old "mime_type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
+func (m *ShareLinkMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.MimeType, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *FileMutation) ResetMimeType() {
-	m.mime_type = nil
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ShareLinkMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[sharelink.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ShareLinkMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ShareLinkMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, sharelink.FieldRevokedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
+func (m *ShareLinkMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ShareLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -419,10 +5109,10 @@ func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -437,17 +5127,17 @@ func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
+func (m *ShareLinkMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FileMutation) SetUpdatedAt(t time.Time) {
+func (m *ShareLinkMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ShareLinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -455,10 +5145,10 @@ func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -473,68 +5163,45 @@ func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileMutation) ResetUpdatedAt() {
+func (m *ShareLinkMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *FileMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ClearFile clears the "file" edge to the File entity.
+func (m *ShareLinkMutation) ClearFile() {
+	m.clearedfile = true
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *ShareLinkMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ShareLinkMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *FileMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[file.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *FileMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[file.FieldDeletedAt]
-	return ok
+	return
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *FileMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, file.FieldDeletedAt)
+// ResetFile resets all changes to the "file" edge.
+func (m *ShareLinkMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the ShareLinkMutation builder.
+func (m *ShareLinkMutation) Where(ps ...predicate.ShareLink) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ShareLinkMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *ShareLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareLink, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -542,51 +5209,57 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *ShareLinkMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *ShareLinkMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (ShareLink).
+func (m *ShareLinkMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *ShareLinkMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.uid != nil {
-		fields = append(fields, file.FieldUID)
+		fields = append(fields, sharelink.FieldUID)
+	}
+	if m.token != nil {
+		fields = append(fields, sharelink.FieldToken)
+	}
+	if m.file != nil {
+		fields = append(fields, sharelink.FieldFileID)
 	}
 	if m.user_id != nil {
-		fields = append(fields, file.FieldUserID)
+		fields = append(fields, sharelink.FieldUserID)
 	}
-	if m.filename != nil {
-		fields = append(fields, file.FieldFilename)
+	if m.password_hash != nil {
+		fields = append(fields, sharelink.FieldPasswordHash)
 	}
-	if m.object_path != nil {
-		fields = append(fields, file.FieldObjectPath)
+	if m.expires_at != nil {
+		fields = append(fields, sharelink.FieldExpiresAt)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.max_downloads != nil {
+		fields = append(fields, sharelink.FieldMaxDownloads)
 	}
-	if m.mime_type != nil {
-		fields = append(fields, file.FieldMimeType)
+	if m.downloads != nil {
+		fields = append(fields, sharelink.FieldDownloads)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, sharelink.FieldRevokedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
+		fields = append(fields, sharelink.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, file.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, file.FieldDeletedAt)
+		fields = append(fields, sharelink.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -594,26 +5267,30 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *ShareLinkMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldUID:
+	case sharelink.FieldUID:
 		return m.UID()
-	case file.FieldUserID:
+	case sharelink.FieldToken:
+		return m.Token()
+	case sharelink.FieldFileID:
+		return m.FileID()
+	case sharelink.FieldUserID:
 		return m.UserID()
-	case file.FieldFilename:
-		return m.Filename()
-	case file.FieldObjectPath:
-		return m.ObjectPath()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldMimeType:
-		return m.MimeType()
-	case file.FieldCreatedAt:
+	case sharelink.FieldPasswordHash:
+		return m.PasswordHash()
+	case sharelink.FieldExpiresAt:
+		return m.ExpiresAt()
+	case sharelink.FieldMaxDownloads:
+		return m.MaxDownloads()
+	case sharelink.FieldDownloads:
+		return m.Downloads()
+	case sharelink.FieldRevokedAt:
+		return m.RevokedAt()
+	case sharelink.FieldCreatedAt:
 		return m.CreatedAt()
-	case file.FieldUpdatedAt:
+	case sharelink.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case file.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -621,111 +5298,132 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ShareLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldUID:
+	case sharelink.FieldUID:
 		return m.OldUID(ctx)
-	case file.FieldUserID:
+	case sharelink.FieldToken:
+		return m.OldToken(ctx)
+	case sharelink.FieldFileID:
+		return m.OldFileID(ctx)
+	case sharelink.FieldUserID:
 		return m.OldUserID(ctx)
-	case file.FieldFilename:
-		return m.OldFilename(ctx)
-	case file.FieldObjectPath:
-		return m.OldObjectPath(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case file.FieldCreatedAt:
+	case sharelink.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case sharelink.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case sharelink.FieldMaxDownloads:
+		return m.OldMaxDownloads(ctx)
+	case sharelink.FieldDownloads:
+		return m.OldDownloads(ctx)
+	case sharelink.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case sharelink.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case file.FieldUpdatedAt:
+	case sharelink.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case file.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown ShareLink field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *ShareLinkMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldUID:
+	case sharelink.FieldUID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUID(v)
 		return nil
-	case file.FieldUserID:
+	case sharelink.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case sharelink.FieldFileID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case sharelink.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case file.FieldFilename:
+	case sharelink.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetPasswordHash(v)
 		return nil
-	case file.FieldObjectPath:
-		v, ok := value.(string)
+	case sharelink.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetObjectPath(v)
+		m.SetExpiresAt(v)
 		return nil
-	case file.FieldSize:
+	case sharelink.FieldMaxDownloads:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetMaxDownloads(v)
 		return nil
-	case file.FieldMimeType:
-		v, ok := value.(string)
+	case sharelink.FieldDownloads:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMimeType(v)
+		m.SetDownloads(v)
 		return nil
-	case file.FieldCreatedAt:
+	case sharelink.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRevokedAt(v)
 		return nil
-	case file.FieldUpdatedAt:
+	case sharelink.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case file.FieldDeletedAt:
+	case sharelink.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown ShareLink field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *ShareLinkMutation) AddedFields() []string {
 	var fields []string
 	if m.adduser_id != nil {
-		fields = append(fields, file.FieldUserID)
+		fields = append(fields, sharelink.FieldUserID)
 	}
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
+	if m.addmax_downloads != nil {
+		fields = append(fields, sharelink.FieldMaxDownloads)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, sharelink.FieldDownloads)
 	}
 	return fields
 }
@@ -733,12 +5431,14 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ShareLinkMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldUserID:
+	case sharelink.FieldUserID:
 		return m.AddedUserID()
-	case file.FieldSize:
-		return m.AddedSize()
+	case sharelink.FieldMaxDownloads:
+		return m.AddedMaxDownloads()
+	case sharelink.FieldDownloads:
+		return m.AddedDownloads()
 	}
 	return nil, false
 }
@@ -746,133 +5446,190 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *ShareLinkMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldUserID:
+	case sharelink.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUserID(v)
 		return nil
-	case file.FieldSize:
+	case sharelink.FieldMaxDownloads:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddMaxDownloads(v)
+		return nil
+	case sharelink.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown ShareLink numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *ShareLinkMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldDeletedAt) {
-		fields = append(fields, file.FieldDeletedAt)
+	if m.FieldCleared(sharelink.FieldPasswordHash) {
+		fields = append(fields, sharelink.FieldPasswordHash)
+	}
+	if m.FieldCleared(sharelink.FieldExpiresAt) {
+		fields = append(fields, sharelink.FieldExpiresAt)
+	}
+	if m.FieldCleared(sharelink.FieldMaxDownloads) {
+		fields = append(fields, sharelink.FieldMaxDownloads)
+	}
+	if m.FieldCleared(sharelink.FieldRevokedAt) {
+		fields = append(fields, sharelink.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *ShareLinkMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *ShareLinkMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case sharelink.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case sharelink.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case sharelink.FieldMaxDownloads:
+		m.ClearMaxDownloads()
+		return nil
+	case sharelink.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown ShareLink nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *ShareLinkMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldUID:
+	case sharelink.FieldUID:
 		m.ResetUID()
 		return nil
-	case file.FieldUserID:
+	case sharelink.FieldToken:
+		m.ResetToken()
+		return nil
+	case sharelink.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case sharelink.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case file.FieldFilename:
-		m.ResetFilename()
+	case sharelink.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case file.FieldObjectPath:
-		m.ResetObjectPath()
+	case sharelink.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case sharelink.FieldMaxDownloads:
+		m.ResetMaxDownloads()
 		return nil
-	case file.FieldMimeType:
-		m.ResetMimeType()
+	case sharelink.FieldDownloads:
+		m.ResetDownloads()
 		return nil
-	case file.FieldCreatedAt:
+	case sharelink.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case sharelink.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case file.FieldUpdatedAt:
+	case sharelink.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case file.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown ShareLink field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, sharelink.EdgeFile)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *ShareLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sharelink.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *ShareLinkMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, sharelink.EdgeFile)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *ShareLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sharelink.EdgeFile:
+		return m.clearedfile
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown File unique edge %s", name)
+func (m *ShareLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case sharelink.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown File edge %s", name)
+func (m *ShareLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case sharelink.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink edge %s", name)
 }
@@ -6,5 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
+
+// Multipart is the predicate function for multipart builders.
+type Multipart func(*sql.Selector)
+
+// MultipartPart is the predicate function for multipartpart builders.
+type MultipartPart func(*sql.Selector)
+
+// ShareLink is the predicate function for sharelink builders.
+type ShareLink func(*sql.Selector)
@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"storage/ent/blob"
 	"storage/ent/file"
+	"storage/ent/multipart"
+	"storage/ent/multipartpart"
 	"storage/ent/schema"
+	"storage/ent/sharelink"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,20 +18,124 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescEtag is the schema descriptor for etag field.
+	blobDescEtag := blobFields[3].Descriptor()
+	// blob.DefaultEtag holds the default value on creation for the etag field.
+	blob.DefaultEtag = blobDescEtag.Default.(string)
+	// blobDescRefCount is the schema descriptor for ref_count field.
+	blobDescRefCount := blobFields[4].Descriptor()
+	// blob.DefaultRefCount holds the default value on creation for the ref_count field.
+	blob.DefaultRefCount = blobDescRefCount.Default.(int)
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[5].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	// blobDescUpdatedAt is the schema descriptor for updated_at field.
+	blobDescUpdatedAt := blobFields[6].Descriptor()
+	// blob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blob.DefaultUpdatedAt = blobDescUpdatedAt.Default.(func() time.Time)
+	// blob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blob.UpdateDefaultUpdatedAt = blobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	fileFields := schema.File{}.Fields()
 	_ = fileFields
 	// fileDescUID is the schema descriptor for uid field.
 	fileDescUID := fileFields[0].Descriptor()
 	// file.DefaultUID holds the default value on creation for the uid field.
 	file.DefaultUID = fileDescUID.Default.(func() uuid.UUID)
+	// fileDescLogicalPath is the schema descriptor for logical_path field.
+	fileDescLogicalPath := fileFields[4].Descriptor()
+	// file.DefaultLogicalPath holds the default value on creation for the logical_path field.
+	file.DefaultLogicalPath = fileDescLogicalPath.Default.(string)
+	// fileDescVersion is the schema descriptor for version field.
+	fileDescVersion := fileFields[5].Descriptor()
+	// file.DefaultVersion holds the default value on creation for the version field.
+	file.DefaultVersion = fileDescVersion.Default.(int)
+	// fileDescDetectedMimeType is the schema descriptor for detected_mime_type field.
+	fileDescDetectedMimeType := fileFields[8].Descriptor()
+	// file.DefaultDetectedMimeType holds the default value on creation for the detected_mime_type field.
+	file.DefaultDetectedMimeType = fileDescDetectedMimeType.Default.(string)
+	// fileDescEtag is the schema descriptor for etag field.
+	fileDescEtag := fileFields[9].Descriptor()
+	// file.DefaultEtag holds the default value on creation for the etag field.
+	file.DefaultEtag = fileDescEtag.Default.(string)
+	// fileDescSha256 is the schema descriptor for sha256 field.
+	fileDescSha256 := fileFields[11].Descriptor()
+	// file.DefaultSha256 holds the default value on creation for the sha256 field.
+	file.DefaultSha256 = fileDescSha256.Default.(string)
+	// fileDescMd5 is the schema descriptor for md5 field.
+	fileDescMd5 := fileFields[12].Descriptor()
+	// file.DefaultMd5 holds the default value on creation for the md5 field.
+	file.DefaultMd5 = fileDescMd5.Default.(string)
+	// fileDescScanResult is the schema descriptor for scan_result field.
+	fileDescScanResult := fileFields[17].Descriptor()
+	// file.DefaultScanResult holds the default value on creation for the scan_result field.
+	file.DefaultScanResult = fileDescScanResult.Default.(string)
 	// fileDescCreatedAt is the schema descriptor for created_at field.
-	fileDescCreatedAt := fileFields[6].Descriptor()
+	fileDescCreatedAt := fileFields[19].Descriptor()
 	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
 	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
 	// fileDescUpdatedAt is the schema descriptor for updated_at field.
-	fileDescUpdatedAt := fileFields[7].Descriptor()
+	fileDescUpdatedAt := fileFields[20].Descriptor()
 	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
 	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	multipartFields := schema.Multipart{}.Fields()
+	_ = multipartFields
+	// multipartDescUID is the schema descriptor for uid field.
+	multipartDescUID := multipartFields[0].Descriptor()
+	// multipart.DefaultUID holds the default value on creation for the uid field.
+	multipart.DefaultUID = multipartDescUID.Default.(func() uuid.UUID)
+	// multipartDescDetectedMimeType is the schema descriptor for detected_mime_type field.
+	multipartDescDetectedMimeType := multipartFields[5].Descriptor()
+	// multipart.DefaultDetectedMimeType holds the default value on creation for the detected_mime_type field.
+	multipart.DefaultDetectedMimeType = multipartDescDetectedMimeType.Default.(string)
+	// multipartDescCreatedAt is the schema descriptor for created_at field.
+	multipartDescCreatedAt := multipartFields[13].Descriptor()
+	// multipart.DefaultCreatedAt holds the default value on creation for the created_at field.
+	multipart.DefaultCreatedAt = multipartDescCreatedAt.Default.(func() time.Time)
+	// multipartDescUpdatedAt is the schema descriptor for updated_at field.
+	multipartDescUpdatedAt := multipartFields[14].Descriptor()
+	// multipart.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	multipart.DefaultUpdatedAt = multipartDescUpdatedAt.Default.(func() time.Time)
+	// multipart.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	multipart.UpdateDefaultUpdatedAt = multipartDescUpdatedAt.UpdateDefault.(func() time.Time)
+	multipartpartFields := schema.MultipartPart{}.Fields()
+	_ = multipartpartFields
+	// multipartpartDescCreatedAt is the schema descriptor for created_at field.
+	multipartpartDescCreatedAt := multipartpartFields[4].Descriptor()
+	// multipartpart.DefaultCreatedAt holds the default value on creation for the created_at field.
+	multipartpart.DefaultCreatedAt = multipartpartDescCreatedAt.Default.(func() time.Time)
+	// multipartpartDescUpdatedAt is the schema descriptor for updated_at field.
+	multipartpartDescUpdatedAt := multipartpartFields[5].Descriptor()
+	// multipartpart.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	multipartpart.DefaultUpdatedAt = multipartpartDescUpdatedAt.Default.(func() time.Time)
+	// multipartpart.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	multipartpart.UpdateDefaultUpdatedAt = multipartpartDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sharelinkFields := schema.ShareLink{}.Fields()
+	_ = sharelinkFields
+	// sharelinkDescUID is the schema descriptor for uid field.
+	sharelinkDescUID := sharelinkFields[0].Descriptor()
+	// sharelink.DefaultUID holds the default value on creation for the uid field.
+	sharelink.DefaultUID = sharelinkDescUID.Default.(func() uuid.UUID)
+	// sharelinkDescPasswordHash is the schema descriptor for password_hash field.
+	sharelinkDescPasswordHash := sharelinkFields[4].Descriptor()
+	// sharelink.DefaultPasswordHash holds the default value on creation for the password_hash field.
+	sharelink.DefaultPasswordHash = sharelinkDescPasswordHash.Default.(string)
+	// sharelinkDescDownloads is the schema descriptor for downloads field.
+	sharelinkDescDownloads := sharelinkFields[7].Descriptor()
+	// sharelink.DefaultDownloads holds the default value on creation for the downloads field.
+	sharelink.DefaultDownloads = sharelinkDescDownloads.Default.(int)
+	// sharelinkDescCreatedAt is the schema descriptor for created_at field.
+	sharelinkDescCreatedAt := sharelinkFields[9].Descriptor()
+	// sharelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharelink.DefaultCreatedAt = sharelinkDescCreatedAt.Default.(func() time.Time)
+	// sharelinkDescUpdatedAt is the schema descriptor for updated_at field.
+	sharelinkDescUpdatedAt := sharelinkFields[10].Descriptor()
+	// sharelink.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sharelink.DefaultUpdatedAt = sharelinkDescUpdatedAt.Default.(func() time.Time)
+	// sharelink.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sharelink.UpdateDefaultUpdatedAt = sharelinkDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
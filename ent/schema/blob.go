@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Blob holds the schema definition for the Blob entity.
+type Blob struct {
+	ent.Schema
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String(`sha256`).
+			Comment(`hex encoded sha-256 checksum of content, object path is derived from it`),
+
+		field.String(`object_path`).
+			Comment(`path to content object in s3 storage`),
+
+		field.Int(`size`).
+			Comment(`size of content in bytes`),
+
+		field.String(`etag`).
+			Optional().
+			Default(``).
+			Comment(`etag of content object in s3 storage`),
+
+		field.Int(`ref_count`).
+			Default(0).
+			Comment(`count of files referencing the blob, object is removed with the last reference`),
+
+		field.Time(`created_at`).
+			Default(time.Now).
+			Immutable().
+			Comment(`creation time of blob`),
+
+		field.Time(`updated_at`).
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: `CURRENT_TIMESTAMP`,
+				},
+			).
+			Comment(`last update time of blob`),
+	}
+}
+
+// Edges of the Blob.
+func (Blob) Edges() []ent.Edge {
+	return nil
+}
+
+func (Blob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(`sha256`).Unique(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -31,11 +32,75 @@ func (File) Fields() []ent.Field {
 		field.String(`object_path`).
 			Comment(`path to file object in s3 storage`),
 
+		field.String(`logical_path`).
+			Optional().
+			Default(``).
+			Comment(`path of file shared by all its versions, object path of the first version`),
+
+		field.Int(`version`).
+			Default(1).
+			Comment(`number of file version, the latest active version is served by default`),
+
 		field.Int(`size`).
 			Comment(`size of file in bytes`),
 
 		field.String(`mime_type`).
-			Comment(`file mime type`),
+			Comment(`file mime type declared by extension of filename, files are served with it`),
+
+		field.String(`detected_mime_type`).
+			Optional().
+			Default(``).
+			Comment(`file mime type detected by first bytes of content, empty for empty files and older uploads`),
+
+		field.String(`etag`).
+			Optional().
+			Default(``).
+			Comment(`etag of file object in s3 storage`),
+
+		field.Time(`last_modified`).
+			Optional().
+			Nillable().
+			Comment(`last modification time of file object in s3 storage`),
+
+		field.String(`sha256`).
+			Optional().
+			Default(``).
+			Comment(`hex encoded sha-256 checksum of file content`),
+
+		field.String(`md5`).
+			Optional().
+			Default(``).
+			Comment(`hex encoded md5 checksum of file content`),
+
+		field.Int(`blob_id`).
+			Optional().
+			Nillable().
+			Comment(`identifier of content blob shared by files with the same content, empty for own objects`),
+
+		field.Enum(`status`).
+			Values(`pending`, `active`, `failed`, `deleted`, `purged`).
+			Default(`active`).
+			Comment(`status of file, transitions between statuses are checked by repository`),
+
+		field.Enum(`visibility`).
+			Values(`public`, `authenticated`, `owner`, `shared`).
+			Default(`public`).
+			Comment(`who may download file: anyone, authenticated users, owner or owner and holders of share links`),
+
+		field.Enum(`scan_status`).
+			Values(`skipped`, `quarantined`, `clean`, `infected`).
+			Default(`skipped`).
+			Comment(`antivirus scan status, quarantined and infected files are not downloadable, skipped ones are not scanned`),
+
+		field.String(`scan_result`).
+			Optional().
+			Default(``).
+			Comment(`signature of malware found by the last scan or error of the last failed scan`),
+
+		field.Time(`scanned_at`).
+			Optional().
+			Nillable().
+			Comment(`time of the last antivirus scan`),
 
 		field.Time(`created_at`).
 			Default(time.Now).
@@ -62,7 +127,13 @@ func (File) Fields() []ent.Field {
 
 // Edges of the File.
 func (File) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To(`blob`, Blob.Type).
+			Field(`blob_id`).
+			Unique().
+			Annotations(entsql.Annotation{OnDelete: entsql.SetNull}).
+			Comment(`content blob of deduplicated file`),
+	}
 }
 
 func (File) Indexes() []ent.Index {
@@ -70,7 +141,11 @@ func (File) Indexes() []ent.Index {
 		index.Fields(`uid`).Unique(),
 		index.Fields(`user_id`),
 		index.Fields(`deleted_at`),
+		index.Fields(`status`),
 		index.Fields(`filename`),
-		index.Fields(`object_path`).Unique(),
+		index.Fields(`object_path`), // failed and deleted files keep their paths, active ones are checked by usecase
+		index.Fields(`blob_id`),
+		index.Fields(`logical_path`, `version`),
+		index.Fields(`scan_status`),
 	}
 }
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Multipart holds the schema definition for the Multipart entity.
+type Multipart struct {
+	ent.Schema
+}
+
+// Fields of the Multipart.
+func (Multipart) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID(`uid`, uuid.UUID{}).
+			Default(uuid.New).
+			Comment(`unique multipart upload identifier`),
+
+		field.Int(`user_id`).
+			Comment(`user identification number`),
+
+		field.String(`filename`).
+			Comment(`filename of uploading file`),
+
+		field.String(`object_path`).
+			Comment(`path to file object in s3 storage`),
+
+		field.String(`mime_type`).
+			Comment(`file mime type declared by extension of filename`),
+
+		field.String(`detected_mime_type`).
+			Optional().
+			Default(``).
+			Comment(`file mime type detected by first bytes of the first part`),
+
+		field.String(`upload_id`).
+			Comment(`multipart upload identifier in s3 storage`),
+
+		field.Int(`upload_length`).
+			Optional().
+			Nillable().
+			Comment(`declared total size of file in bytes, known only for tus uploads`),
+
+		field.Enum(`visibility`).
+			Values(`public`, `authenticated`, `owner`, `shared`).
+			Default(`public`).
+			Comment(`visibility of file created after completion`),
+
+		field.Enum(`status`).
+			Values(`active`, `completed`, `aborted`).
+			Default(`active`).
+			Comment(`status of multipart upload`),
+
+		field.UUID(`file_uid`, uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment(`uid of file created after completion`),
+
+		field.UUID(`lock_uid`, uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment(`identifier of request which appends chunk to tus upload at the moment`),
+
+		field.Time(`locked_until`).
+			Optional().
+			Nillable().
+			Comment(`time until which tus upload stays locked by request unless lock is renewed`),
+
+		field.Time(`created_at`).
+			Default(time.Now).
+			Immutable().
+			Comment(`creation time of multipart upload`),
+
+		field.Time(`updated_at`).
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: `CURRENT_TIMESTAMP`,
+				},
+			).
+			Comment(`last update time of multipart upload`),
+	}
+}
+
+// Edges of the Multipart.
+func (Multipart) Edges() []ent.Edge {
+	return nil
+}
+
+func (Multipart) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(`uid`).Unique(),
+		index.Fields(`user_id`),
+		index.Fields(`status`),
+		index.Fields(`object_path`),
+	}
+}
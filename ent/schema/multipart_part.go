@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MultipartPart holds the schema definition for the MultipartPart entity.
+type MultipartPart struct {
+	ent.Schema
+}
+
+// Fields of the MultipartPart.
+func (MultipartPart) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int(`multipart_id`).
+			Comment(`identifier of multipart upload`),
+
+		field.Int(`part_number`).
+			Comment(`number of part from 1 to 10000`),
+
+		field.String(`etag`).
+			Comment(`entity tag of part returned by s3 storage`),
+
+		field.Int(`size`).
+			Comment(`size of part in bytes`),
+
+		field.Time(`created_at`).
+			Default(time.Now).
+			Immutable().
+			Comment(`creation time of part`),
+
+		field.Time(`updated_at`).
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: `CURRENT_TIMESTAMP`,
+				},
+			).
+			Comment(`last update time of part`),
+	}
+}
+
+// Edges of the MultipartPart.
+func (MultipartPart) Edges() []ent.Edge {
+	return nil
+}
+
+func (MultipartPart) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(`multipart_id`, `part_number`).Unique(),
+	}
+}
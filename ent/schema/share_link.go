@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShareLink holds the schema definition for the ShareLink entity.
+type ShareLink struct {
+	ent.Schema
+}
+
+// Fields of the ShareLink.
+func (ShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID(`uid`, uuid.UUID{}).
+			Default(uuid.New).
+			Comment(`unique share link identifier`),
+
+		field.String(`token`).
+			Sensitive().
+			Comment(`secret token of public download url`),
+
+		field.Int(`file_id`).
+			Comment(`identifier of shared version of file`),
+
+		field.Int(`user_id`).
+			Comment(`identification number of user who created the link`),
+
+		field.String(`password_hash`).
+			Optional().
+			Default(``).
+			Sensitive().
+			Comment(`bcrypt hash of password required for download, empty for links without password`),
+
+		field.Time(`expires_at`).
+			Optional().
+			Nillable().
+			Comment(`time after which link is not available, empty for links without expiry`),
+
+		field.Int(`max_downloads`).
+			Optional().
+			Nillable().
+			Comment(`count of downloads after which link is not available, empty for unlimited links`),
+
+		field.Int(`downloads`).
+			Default(0).
+			Comment(`count of downloads by link`),
+
+		field.Time(`revoked_at`).
+			Optional().
+			Nillable().
+			Comment(`time of link revocation`),
+
+		field.Time(`created_at`).
+			Default(time.Now).
+			Immutable().
+			Comment(`creation time of link`),
+
+		field.Time(`updated_at`).
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(
+				&entsql.Annotation{
+					Default: `CURRENT_TIMESTAMP`,
+				},
+			).
+			Comment(`last update time of link`),
+	}
+}
+
+// Edges of the ShareLink.
+func (ShareLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To(`file`, File.Type).
+			Field(`file_id`).
+			Unique().
+			Required().
+			Comment(`shared version of file`),
+	}
+}
+
+func (ShareLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(`uid`).Unique(),
+		index.Fields(`token`).Unique(),
+		index.Fields(`file_id`),
+	}
+}
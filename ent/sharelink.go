@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"storage/ent/file"
+	"storage/ent/sharelink"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ShareLink is the model entity for the ShareLink schema.
+type ShareLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// unique share link identifier
+	UID uuid.UUID `json:"uid,omitempty"`
+	// secret token of public download url
+	Token string `json:"-"`
+	// identifier of shared version of file
+	FileID int `json:"file_id,omitempty"`
+	// identification number of user who created the link
+	UserID int `json:"user_id,omitempty"`
+	// bcrypt hash of password required for download, empty for links without password
+	PasswordHash string `json:"-"`
+	// time after which link is not available, empty for links without expiry
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// count of downloads after which link is not available, empty for unlimited links
+	MaxDownloads *int `json:"max_downloads,omitempty"`
+	// count of downloads by link
+	Downloads int `json:"downloads,omitempty"`
+	// time of link revocation
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// creation time of link
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// last update time of link
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareLinkQuery when eager-loading is set.
+	Edges ShareLinkEdges `json:"edges"`
+}
+
+// ShareLinkEdges holds the relations/edges for other nodes in the graph.
+type ShareLinkEdges struct {
+	// shared version of file
+	File *File `json:"file,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldID, sharelink.FieldFileID, sharelink.FieldUserID, sharelink.FieldMaxDownloads, sharelink.FieldDownloads:
+			values[i] = new(sql.NullInt64)
+		case sharelink.FieldToken, sharelink.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case sharelink.FieldExpiresAt, sharelink.FieldRevokedAt, sharelink.FieldCreatedAt, sharelink.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case sharelink.FieldUID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ShareLink", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareLink fields.
+func (sl *ShareLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sl.ID = int(value.Int64)
+		case sharelink.FieldUID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value != nil {
+				sl.UID = *value
+			}
+		case sharelink.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				sl.Token = value.String
+			}
+		case sharelink.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				sl.FileID = int(value.Int64)
+			}
+		case sharelink.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sl.UserID = int(value.Int64)
+			}
+		case sharelink.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				sl.PasswordHash = value.String
+			}
+		case sharelink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sl.ExpiresAt = new(time.Time)
+				*sl.ExpiresAt = value.Time
+			}
+		case sharelink.FieldMaxDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_downloads", values[i])
+			} else if value.Valid {
+				sl.MaxDownloads = new(int)
+				*sl.MaxDownloads = int(value.Int64)
+			}
+		case sharelink.FieldDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloads", values[i])
+			} else if value.Valid {
+				sl.Downloads = int(value.Int64)
+			}
+		case sharelink.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				sl.RevokedAt = new(time.Time)
+				*sl.RevokedAt = value.Time
+			}
+		case sharelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sl.CreatedAt = value.Time
+			}
+		case sharelink.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the "file" edge of the ShareLink entity.
+func (sl *ShareLink) QueryFile() *FileQuery {
+	return NewShareLinkClient(sl.config).QueryFile(sl)
+}
+
+// Update returns a builder for updating this ShareLink.
+// Note that you need to call ShareLink.Unwrap() before calling this method if this ShareLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sl *ShareLink) Update() *ShareLinkUpdateOne {
+	return NewShareLinkClient(sl.config).UpdateOne(sl)
+}
+
+// Unwrap unwraps the ShareLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sl *ShareLink) Unwrap() *ShareLink {
+	_tx, ok := sl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareLink is not a transactional entity")
+	}
+	sl.config.driver = _tx.drv
+	return sl
+}
+
+// String implements the fmt.Stringer.
+func (sl *ShareLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sl.ID))
+	builder.WriteString("uid=")
+	builder.WriteString(fmt.Sprintf("%v", sl.UID))
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", sl.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sl.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := sl.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sl.MaxDownloads; v != nil {
+		builder.WriteString("max_downloads=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("downloads=")
+	builder.WriteString(fmt.Sprintf("%v", sl.Downloads))
+	builder.WriteString(", ")
+	if v := sl.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareLinks is a parsable slice of ShareLink.
+type ShareLinks []*ShareLink
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/file"
+	"storage/ent/sharelink"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShareLinkCreate is the builder for creating a ShareLink entity.
+type ShareLinkCreate struct {
+	config
+	mutation *ShareLinkMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (slc *ShareLinkCreate) SetUID(u uuid.UUID) *ShareLinkCreate {
+	slc.mutation.SetUID(u)
+	return slc
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableUID(u *uuid.UUID) *ShareLinkCreate {
+	if u != nil {
+		slc.SetUID(*u)
+	}
+	return slc
+}
+
+// SetToken sets the "token" field.
+func (slc *ShareLinkCreate) SetToken(s string) *ShareLinkCreate {
+	slc.mutation.SetToken(s)
+	return slc
+}
+
+// SetFileID sets the "file_id" field.
+func (slc *ShareLinkCreate) SetFileID(i int) *ShareLinkCreate {
+	slc.mutation.SetFileID(i)
+	return slc
+}
+
+// SetUserID sets the "user_id" field.
+func (slc *ShareLinkCreate) SetUserID(i int) *ShareLinkCreate {
+	slc.mutation.SetUserID(i)
+	return slc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (slc *ShareLinkCreate) SetPasswordHash(s string) *ShareLinkCreate {
+	slc.mutation.SetPasswordHash(s)
+	return slc
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillablePasswordHash(s *string) *ShareLinkCreate {
+	if s != nil {
+		slc.SetPasswordHash(*s)
+	}
+	return slc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (slc *ShareLinkCreate) SetExpiresAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetExpiresAt(t)
+	return slc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableExpiresAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetExpiresAt(*t)
+	}
+	return slc
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (slc *ShareLinkCreate) SetMaxDownloads(i int) *ShareLinkCreate {
+	slc.mutation.SetMaxDownloads(i)
+	return slc
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableMaxDownloads(i *int) *ShareLinkCreate {
+	if i != nil {
+		slc.SetMaxDownloads(*i)
+	}
+	return slc
+}
+
+// SetDownloads sets the "downloads" field.
+func (slc *ShareLinkCreate) SetDownloads(i int) *ShareLinkCreate {
+	slc.mutation.SetDownloads(i)
+	return slc
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableDownloads(i *int) *ShareLinkCreate {
+	if i != nil {
+		slc.SetDownloads(*i)
+	}
+	return slc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (slc *ShareLinkCreate) SetRevokedAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetRevokedAt(t)
+	return slc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableRevokedAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetRevokedAt(*t)
+	}
+	return slc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (slc *ShareLinkCreate) SetCreatedAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetCreatedAt(t)
+	return slc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableCreatedAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetCreatedAt(*t)
+	}
+	return slc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (slc *ShareLinkCreate) SetUpdatedAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetUpdatedAt(t)
+	return slc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableUpdatedAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetUpdatedAt(*t)
+	}
+	return slc
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (slc *ShareLinkCreate) SetFile(f *File) *ShareLinkCreate {
+	return slc.SetFileID(f.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (slc *ShareLinkCreate) Mutation() *ShareLinkMutation {
+	return slc.mutation
+}
+
+// Save creates the ShareLink in the database.
+func (slc *ShareLinkCreate) Save(ctx context.Context) (*ShareLink, error) {
+	slc.defaults()
+	return withHooks[*ShareLink, ShareLinkMutation](ctx, slc.sqlSave, slc.mutation, slc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (slc *ShareLinkCreate) SaveX(ctx context.Context) *ShareLink {
+	v, err := slc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slc *ShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := slc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slc *ShareLinkCreate) ExecX(ctx context.Context) {
+	if err := slc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (slc *ShareLinkCreate) defaults() {
+	if _, ok := slc.mutation.UID(); !ok {
+		v := sharelink.DefaultUID()
+		slc.mutation.SetUID(v)
+	}
+	if _, ok := slc.mutation.PasswordHash(); !ok {
+		v := sharelink.DefaultPasswordHash
+		slc.mutation.SetPasswordHash(v)
+	}
+	if _, ok := slc.mutation.Downloads(); !ok {
+		v := sharelink.DefaultDownloads
+		slc.mutation.SetDownloads(v)
+	}
+	if _, ok := slc.mutation.CreatedAt(); !ok {
+		v := sharelink.DefaultCreatedAt()
+		slc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := slc.mutation.UpdatedAt(); !ok {
+		v := sharelink.DefaultUpdatedAt()
+		slc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slc *ShareLinkCreate) check() error {
+	if _, ok := slc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "ShareLink.uid"`)}
+	}
+	if _, ok := slc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "ShareLink.token"`)}
+	}
+	if _, ok := slc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "ShareLink.file_id"`)}
+	}
+	if _, ok := slc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ShareLink.user_id"`)}
+	}
+	if _, ok := slc.mutation.Downloads(); !ok {
+		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "ShareLink.downloads"`)}
+	}
+	if _, ok := slc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareLink.created_at"`)}
+	}
+	if _, ok := slc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ShareLink.updated_at"`)}
+	}
+	if _, ok := slc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "ShareLink.file"`)}
+	}
+	return nil
+}
+
+func (slc *ShareLinkCreate) sqlSave(ctx context.Context) (*ShareLink, error) {
+	if err := slc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := slc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, slc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	slc.mutation.id = &_node.ID
+	slc.mutation.done = true
+	return _node, nil
+}
+
+func (slc *ShareLinkCreate) createSpec() (*ShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLink{config: slc.config}
+		_spec = sqlgraph.NewCreateSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	)
+	if value, ok := slc.mutation.UID(); ok {
+		_spec.SetField(sharelink.FieldUID, field.TypeUUID, value)
+		_node.UID = value
+	}
+	if value, ok := slc.mutation.Token(); ok {
+		_spec.SetField(sharelink.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := slc.mutation.UserID(); ok {
+		_spec.SetField(sharelink.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := slc.mutation.PasswordHash(); ok {
+		_spec.SetField(sharelink.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := slc.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := slc.mutation.MaxDownloads(); ok {
+		_spec.SetField(sharelink.FieldMaxDownloads, field.TypeInt, value)
+		_node.MaxDownloads = &value
+	}
+	if value, ok := slc.mutation.Downloads(); ok {
+		_spec.SetField(sharelink.FieldDownloads, field.TypeInt, value)
+		_node.Downloads = value
+	}
+	if value, ok := slc.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := slc.mutation.CreatedAt(); ok {
+		_spec.SetField(sharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := slc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sharelink.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := slc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.FileTable,
+			Columns: []string{sharelink.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkCreateBulk is the builder for creating many ShareLink entities in bulk.
+type ShareLinkCreateBulk struct {
+	config
+	builders []*ShareLinkCreate
+}
+
+// Save creates the ShareLink entities in the database.
+func (slcb *ShareLinkCreateBulk) Save(ctx context.Context) ([]*ShareLink, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(slcb.builders))
+	nodes := make([]*ShareLink, len(slcb.builders))
+	mutators := make([]Mutator, len(slcb.builders))
+	for i := range slcb.builders {
+		func(i int, root context.Context) {
+			builder := slcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, slcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, slcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, slcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slcb *ShareLinkCreateBulk) SaveX(ctx context.Context) []*ShareLink {
+	v, err := slcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slcb *ShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := slcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slcb *ShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := slcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
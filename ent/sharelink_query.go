@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"storage/ent/file"
+	"storage/ent/predicate"
+	"storage/ent/sharelink"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareLinkQuery is the builder for querying ShareLink entities.
+type ShareLinkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.ShareLink
+	withFile   *FileQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareLinkQuery builder.
+func (slq *ShareLinkQuery) Where(ps ...predicate.ShareLink) *ShareLinkQuery {
+	slq.predicates = append(slq.predicates, ps...)
+	return slq
+}
+
+// Limit the number of records to be returned by this query.
+func (slq *ShareLinkQuery) Limit(limit int) *ShareLinkQuery {
+	slq.ctx.Limit = &limit
+	return slq
+}
+
+// Offset to start from.
+func (slq *ShareLinkQuery) Offset(offset int) *ShareLinkQuery {
+	slq.ctx.Offset = &offset
+	return slq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (slq *ShareLinkQuery) Unique(unique bool) *ShareLinkQuery {
+	slq.ctx.Unique = &unique
+	return slq
+}
+
+// Order specifies how the records should be ordered.
+func (slq *ShareLinkQuery) Order(o ...OrderFunc) *ShareLinkQuery {
+	slq.order = append(slq.order, o...)
+	return slq
+}
+
+// QueryFile chains the current query on the "file" edge.
+func (slq *ShareLinkQuery) QueryFile() *FileQuery {
+	query := (&FileClient{config: slq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := slq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := slq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sharelink.FileTable, sharelink.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(slq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShareLink entity from the query.
+// Returns a *NotFoundError when no ShareLink was found.
+func (slq *ShareLinkQuery) First(ctx context.Context) (*ShareLink, error) {
+	nodes, err := slq.Limit(1).All(setContextOp(ctx, slq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharelink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (slq *ShareLinkQuery) FirstX(ctx context.Context) *ShareLink {
+	node, err := slq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareLink ID from the query.
+// Returns a *NotFoundError when no ShareLink ID was found.
+func (slq *ShareLinkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(1).IDs(setContextOp(ctx, slq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharelink.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (slq *ShareLinkQuery) FirstIDX(ctx context.Context) int {
+	id, err := slq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShareLink entity is found.
+// Returns a *NotFoundError when no ShareLink entities are found.
+func (slq *ShareLinkQuery) Only(ctx context.Context) (*ShareLink, error) {
+	nodes, err := slq.Limit(2).All(setContextOp(ctx, slq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharelink.Label}
+	default:
+		return nil, &NotSingularError{sharelink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (slq *ShareLinkQuery) OnlyX(ctx context.Context) *ShareLink {
+	node, err := slq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareLink ID in the query.
+// Returns a *NotSingularError when more than one ShareLink ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (slq *ShareLinkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(2).IDs(setContextOp(ctx, slq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharelink.Label}
+	default:
+		err = &NotSingularError{sharelink.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (slq *ShareLinkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := slq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareLinks.
+func (slq *ShareLinkQuery) All(ctx context.Context) ([]*ShareLink, error) {
+	ctx = setContextOp(ctx, slq.ctx, "All")
+	if err := slq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShareLink, *ShareLinkQuery]()
+	return withInterceptors[[]*ShareLink](ctx, slq, qr, slq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (slq *ShareLinkQuery) AllX(ctx context.Context) []*ShareLink {
+	nodes, err := slq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareLink IDs.
+func (slq *ShareLinkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if slq.ctx.Unique == nil && slq.path != nil {
+		slq.Unique(true)
+	}
+	ctx = setContextOp(ctx, slq.ctx, "IDs")
+	if err = slq.Select(sharelink.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (slq *ShareLinkQuery) IDsX(ctx context.Context) []int {
+	ids, err := slq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (slq *ShareLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, slq.ctx, "Count")
+	if err := slq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, slq, querierCount[*ShareLinkQuery](), slq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (slq *ShareLinkQuery) CountX(ctx context.Context) int {
+	count, err := slq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (slq *ShareLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, slq.ctx, "Exist")
+	switch _, err := slq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (slq *ShareLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := slq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (slq *ShareLinkQuery) Clone() *ShareLinkQuery {
+	if slq == nil {
+		return nil
+	}
+	return &ShareLinkQuery{
+		config:     slq.config,
+		ctx:        slq.ctx.Clone(),
+		order:      append([]OrderFunc{}, slq.order...),
+		inters:     append([]Interceptor{}, slq.inters...),
+		predicates: append([]predicate.ShareLink{}, slq.predicates...),
+		withFile:   slq.withFile.Clone(),
+		// clone intermediate query.
+		sql:  slq.sql.Clone(),
+		path: slq.path,
+	}
+}
+
+// WithFile tells the query-builder to eager-load the nodes that are connected to
+// the "file" edge. The optional arguments are used to configure the query builder of the edge.
+func (slq *ShareLinkQuery) WithFile(opts ...func(*FileQuery)) *ShareLinkQuery {
+	query := (&FileClient{config: slq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	slq.withFile = query
+	return slq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID uuid.UUID `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareLink.Query().
+//		GroupBy(sharelink.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (slq *ShareLinkQuery) GroupBy(field string, fields ...string) *ShareLinkGroupBy {
+	slq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShareLinkGroupBy{build: slq}
+	grbuild.flds = &slq.ctx.Fields
+	grbuild.label = sharelink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID uuid.UUID `json:"uid,omitempty"`
+//	}
+//
+//	client.ShareLink.Query().
+//		Select(sharelink.FieldUID).
+//		Scan(ctx, &v)
+func (slq *ShareLinkQuery) Select(fields ...string) *ShareLinkSelect {
+	slq.ctx.Fields = append(slq.ctx.Fields, fields...)
+	sbuild := &ShareLinkSelect{ShareLinkQuery: slq}
+	sbuild.label = sharelink.Label
+	sbuild.flds, sbuild.scan = &slq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShareLinkSelect configured with the given aggregations.
+func (slq *ShareLinkQuery) Aggregate(fns ...AggregateFunc) *ShareLinkSelect {
+	return slq.Select().Aggregate(fns...)
+}
+
+func (slq *ShareLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range slq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, slq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range slq.ctx.Fields {
+		if !sharelink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if slq.path != nil {
+		prev, err := slq.path(ctx)
+		if err != nil {
+			return err
+		}
+		slq.sql = prev
+	}
+	return nil
+}
+
+func (slq *ShareLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShareLink, error) {
+	var (
+		nodes       = []*ShareLink{}
+		_spec       = slq.querySpec()
+		loadedTypes = [1]bool{
+			slq.withFile != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShareLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShareLink{config: slq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, slq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := slq.withFile; query != nil {
+		if err := slq.loadFile(ctx, query, nodes, nil,
+			func(n *ShareLink, e *File) { n.Edges.File = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (slq *ShareLinkQuery) loadFile(ctx context.Context, query *FileQuery, nodes []*ShareLink, init func(*ShareLink), assign func(*ShareLink, *File)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareLink)
+	for i := range nodes {
+		fk := nodes[i].FileID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(file.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "file_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (slq *ShareLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := slq.querySpec()
+	_spec.Node.Columns = slq.ctx.Fields
+	if len(slq.ctx.Fields) > 0 {
+		_spec.Unique = slq.ctx.Unique != nil && *slq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, slq.driver, _spec)
+}
+
+func (slq *ShareLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	_spec.From = slq.sql
+	if unique := slq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if slq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := slq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelink.FieldID)
+		for i := range fields {
+			if fields[i] != sharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := slq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := slq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := slq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := slq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (slq *ShareLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(slq.driver.Dialect())
+	t1 := builder.Table(sharelink.Table)
+	columns := slq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sharelink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if slq.sql != nil {
+		selector = slq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if slq.ctx.Unique != nil && *slq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range slq.predicates {
+		p(selector)
+	}
+	for _, p := range slq.order {
+		p(selector)
+	}
+	if offset := slq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := slq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShareLinkGroupBy is the group-by builder for ShareLink entities.
+type ShareLinkGroupBy struct {
+	selector
+	build *ShareLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (slgb *ShareLinkGroupBy) Aggregate(fns ...AggregateFunc) *ShareLinkGroupBy {
+	slgb.fns = append(slgb.fns, fns...)
+	return slgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (slgb *ShareLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, slgb.build.ctx, "GroupBy")
+	if err := slgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareLinkQuery, *ShareLinkGroupBy](ctx, slgb.build, slgb, slgb.build.inters, v)
+}
+
+func (slgb *ShareLinkGroupBy) sqlScan(ctx context.Context, root *ShareLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(slgb.fns))
+	for _, fn := range slgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*slgb.flds)+len(slgb.fns))
+		for _, f := range *slgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*slgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := slgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShareLinkSelect is the builder for selecting fields of ShareLink entities.
+type ShareLinkSelect struct {
+	*ShareLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sls *ShareLinkSelect) Aggregate(fns ...AggregateFunc) *ShareLinkSelect {
+	sls.fns = append(sls.fns, fns...)
+	return sls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sls *ShareLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sls.ctx, "Select")
+	if err := sls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareLinkQuery, *ShareLinkSelect](ctx, sls.ShareLinkQuery, sls, sls.inters, v)
+}
+
+func (sls *ShareLinkSelect) sqlScan(ctx context.Context, root *ShareLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sls.fns))
+	for _, fn := range sls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
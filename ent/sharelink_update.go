@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"storage/ent/file"
+	"storage/ent/predicate"
+	"storage/ent/sharelink"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShareLinkUpdate is the builder for updating ShareLink entities.
+type ShareLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (slu *ShareLinkUpdate) Where(ps ...predicate.ShareLink) *ShareLinkUpdate {
+	slu.mutation.Where(ps...)
+	return slu
+}
+
+// SetUID sets the "uid" field.
+func (slu *ShareLinkUpdate) SetUID(u uuid.UUID) *ShareLinkUpdate {
+	slu.mutation.SetUID(u)
+	return slu
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableUID(u *uuid.UUID) *ShareLinkUpdate {
+	if u != nil {
+		slu.SetUID(*u)
+	}
+	return slu
+}
+
+// SetToken sets the "token" field.
+func (slu *ShareLinkUpdate) SetToken(s string) *ShareLinkUpdate {
+	slu.mutation.SetToken(s)
+	return slu
+}
+
+// SetFileID sets the "file_id" field.
+func (slu *ShareLinkUpdate) SetFileID(i int) *ShareLinkUpdate {
+	slu.mutation.SetFileID(i)
+	return slu
+}
+
+// SetUserID sets the "user_id" field.
+func (slu *ShareLinkUpdate) SetUserID(i int) *ShareLinkUpdate {
+	slu.mutation.ResetUserID()
+	slu.mutation.SetUserID(i)
+	return slu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (slu *ShareLinkUpdate) AddUserID(i int) *ShareLinkUpdate {
+	slu.mutation.AddUserID(i)
+	return slu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (slu *ShareLinkUpdate) SetPasswordHash(s string) *ShareLinkUpdate {
+	slu.mutation.SetPasswordHash(s)
+	return slu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillablePasswordHash(s *string) *ShareLinkUpdate {
+	if s != nil {
+		slu.SetPasswordHash(*s)
+	}
+	return slu
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (slu *ShareLinkUpdate) ClearPasswordHash() *ShareLinkUpdate {
+	slu.mutation.ClearPasswordHash()
+	return slu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (slu *ShareLinkUpdate) SetExpiresAt(t time.Time) *ShareLinkUpdate {
+	slu.mutation.SetExpiresAt(t)
+	return slu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableExpiresAt(t *time.Time) *ShareLinkUpdate {
+	if t != nil {
+		slu.SetExpiresAt(*t)
+	}
+	return slu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (slu *ShareLinkUpdate) ClearExpiresAt() *ShareLinkUpdate {
+	slu.mutation.ClearExpiresAt()
+	return slu
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (slu *ShareLinkUpdate) SetMaxDownloads(i int) *ShareLinkUpdate {
+	slu.mutation.ResetMaxDownloads()
+	slu.mutation.SetMaxDownloads(i)
+	return slu
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableMaxDownloads(i *int) *ShareLinkUpdate {
+	if i != nil {
+		slu.SetMaxDownloads(*i)
+	}
+	return slu
+}
+
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (slu *ShareLinkUpdate) AddMaxDownloads(i int) *ShareLinkUpdate {
+	slu.mutation.AddMaxDownloads(i)
+	return slu
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (slu *ShareLinkUpdate) ClearMaxDownloads() *ShareLinkUpdate {
+	slu.mutation.ClearMaxDownloads()
+	return slu
+}
+
+// SetDownloads sets the "downloads" field.
+func (slu *ShareLinkUpdate) SetDownloads(i int) *ShareLinkUpdate {
+	slu.mutation.ResetDownloads()
+	slu.mutation.SetDownloads(i)
+	return slu
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableDownloads(i *int) *ShareLinkUpdate {
+	if i != nil {
+		slu.SetDownloads(*i)
+	}
+	return slu
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (slu *ShareLinkUpdate) AddDownloads(i int) *ShareLinkUpdate {
+	slu.mutation.AddDownloads(i)
+	return slu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (slu *ShareLinkUpdate) SetRevokedAt(t time.Time) *ShareLinkUpdate {
+	slu.mutation.SetRevokedAt(t)
+	return slu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableRevokedAt(t *time.Time) *ShareLinkUpdate {
+	if t != nil {
+		slu.SetRevokedAt(*t)
+	}
+	return slu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (slu *ShareLinkUpdate) ClearRevokedAt() *ShareLinkUpdate {
+	slu.mutation.ClearRevokedAt()
+	return slu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (slu *ShareLinkUpdate) SetUpdatedAt(t time.Time) *ShareLinkUpdate {
+	slu.mutation.SetUpdatedAt(t)
+	return slu
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (slu *ShareLinkUpdate) SetFile(f *File) *ShareLinkUpdate {
+	return slu.SetFileID(f.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (slu *ShareLinkUpdate) Mutation() *ShareLinkMutation {
+	return slu.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (slu *ShareLinkUpdate) ClearFile() *ShareLinkUpdate {
+	slu.mutation.ClearFile()
+	return slu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (slu *ShareLinkUpdate) Save(ctx context.Context) (int, error) {
+	slu.defaults()
+	return withHooks[int, ShareLinkMutation](ctx, slu.sqlSave, slu.mutation, slu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slu *ShareLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := slu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (slu *ShareLinkUpdate) Exec(ctx context.Context) error {
+	_, err := slu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slu *ShareLinkUpdate) ExecX(ctx context.Context) {
+	if err := slu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (slu *ShareLinkUpdate) defaults() {
+	if _, ok := slu.mutation.UpdatedAt(); !ok {
+		v := sharelink.UpdateDefaultUpdatedAt()
+		slu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slu *ShareLinkUpdate) check() error {
+	if _, ok := slu.mutation.FileID(); slu.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.file"`)
+	}
+	return nil
+}
+
+func (slu *ShareLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := slu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	if ps := slu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := slu.mutation.UID(); ok {
+		_spec.SetField(sharelink.FieldUID, field.TypeUUID, value)
+	}
+	if value, ok := slu.mutation.Token(); ok {
+		_spec.SetField(sharelink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := slu.mutation.UserID(); ok {
+		_spec.SetField(sharelink.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := slu.mutation.AddedUserID(); ok {
+		_spec.AddField(sharelink.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := slu.mutation.PasswordHash(); ok {
+		_spec.SetField(sharelink.FieldPasswordHash, field.TypeString, value)
+	}
+	if slu.mutation.PasswordHashCleared() {
+		_spec.ClearField(sharelink.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := slu.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if slu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := slu.mutation.MaxDownloads(); ok {
+		_spec.SetField(sharelink.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := slu.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(sharelink.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if slu.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(sharelink.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := slu.mutation.Downloads(); ok {
+		_spec.SetField(sharelink.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := slu.mutation.AddedDownloads(); ok {
+		_spec.AddField(sharelink.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := slu.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if slu.mutation.RevokedAtCleared() {
+		_spec.ClearField(sharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := slu.mutation.UpdatedAt(); ok {
+		_spec.SetField(sharelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if slu.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.FileTable,
+			Columns: []string{sharelink.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.FileTable,
+			Columns: []string{sharelink.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, slu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	slu.mutation.done = true
+	return n, nil
+}
+
+// ShareLinkUpdateOne is the builder for updating a single ShareLink entity.
+type ShareLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// SetUID sets the "uid" field.
+func (sluo *ShareLinkUpdateOne) SetUID(u uuid.UUID) *ShareLinkUpdateOne {
+	sluo.mutation.SetUID(u)
+	return sluo
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableUID(u *uuid.UUID) *ShareLinkUpdateOne {
+	if u != nil {
+		sluo.SetUID(*u)
+	}
+	return sluo
+}
+
+// SetToken sets the "token" field.
+func (sluo *ShareLinkUpdateOne) SetToken(s string) *ShareLinkUpdateOne {
+	sluo.mutation.SetToken(s)
+	return sluo
+}
+
+// SetFileID sets the "file_id" field.
+func (sluo *ShareLinkUpdateOne) SetFileID(i int) *ShareLinkUpdateOne {
+	sluo.mutation.SetFileID(i)
+	return sluo
+}
+
+// SetUserID sets the "user_id" field.
+func (sluo *ShareLinkUpdateOne) SetUserID(i int) *ShareLinkUpdateOne {
+	sluo.mutation.ResetUserID()
+	sluo.mutation.SetUserID(i)
+	return sluo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (sluo *ShareLinkUpdateOne) AddUserID(i int) *ShareLinkUpdateOne {
+	sluo.mutation.AddUserID(i)
+	return sluo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (sluo *ShareLinkUpdateOne) SetPasswordHash(s string) *ShareLinkUpdateOne {
+	sluo.mutation.SetPasswordHash(s)
+	return sluo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillablePasswordHash(s *string) *ShareLinkUpdateOne {
+	if s != nil {
+		sluo.SetPasswordHash(*s)
+	}
+	return sluo
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (sluo *ShareLinkUpdateOne) ClearPasswordHash() *ShareLinkUpdateOne {
+	sluo.mutation.ClearPasswordHash()
+	return sluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sluo *ShareLinkUpdateOne) SetExpiresAt(t time.Time) *ShareLinkUpdateOne {
+	sluo.mutation.SetExpiresAt(t)
+	return sluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableExpiresAt(t *time.Time) *ShareLinkUpdateOne {
+	if t != nil {
+		sluo.SetExpiresAt(*t)
+	}
+	return sluo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sluo *ShareLinkUpdateOne) ClearExpiresAt() *ShareLinkUpdateOne {
+	sluo.mutation.ClearExpiresAt()
+	return sluo
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (sluo *ShareLinkUpdateOne) SetMaxDownloads(i int) *ShareLinkUpdateOne {
+	sluo.mutation.ResetMaxDownloads()
+	sluo.mutation.SetMaxDownloads(i)
+	return sluo
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableMaxDownloads(i *int) *ShareLinkUpdateOne {
+	if i != nil {
+		sluo.SetMaxDownloads(*i)
+	}
+	return sluo
+}
+
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (sluo *ShareLinkUpdateOne) AddMaxDownloads(i int) *ShareLinkUpdateOne {
+	sluo.mutation.AddMaxDownloads(i)
+	return sluo
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (sluo *ShareLinkUpdateOne) ClearMaxDownloads() *ShareLinkUpdateOne {
+	sluo.mutation.ClearMaxDownloads()
+	return sluo
+}
+
+// SetDownloads sets the "downloads" field.
+func (sluo *ShareLinkUpdateOne) SetDownloads(i int) *ShareLinkUpdateOne {
+	sluo.mutation.ResetDownloads()
+	sluo.mutation.SetDownloads(i)
+	return sluo
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableDownloads(i *int) *ShareLinkUpdateOne {
+	if i != nil {
+		sluo.SetDownloads(*i)
+	}
+	return sluo
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (sluo *ShareLinkUpdateOne) AddDownloads(i int) *ShareLinkUpdateOne {
+	sluo.mutation.AddDownloads(i)
+	return sluo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sluo *ShareLinkUpdateOne) SetRevokedAt(t time.Time) *ShareLinkUpdateOne {
+	sluo.mutation.SetRevokedAt(t)
+	return sluo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableRevokedAt(t *time.Time) *ShareLinkUpdateOne {
+	if t != nil {
+		sluo.SetRevokedAt(*t)
+	}
+	return sluo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sluo *ShareLinkUpdateOne) ClearRevokedAt() *ShareLinkUpdateOne {
+	sluo.mutation.ClearRevokedAt()
+	return sluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sluo *ShareLinkUpdateOne) SetUpdatedAt(t time.Time) *ShareLinkUpdateOne {
+	sluo.mutation.SetUpdatedAt(t)
+	return sluo
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (sluo *ShareLinkUpdateOne) SetFile(f *File) *ShareLinkUpdateOne {
+	return sluo.SetFileID(f.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (sluo *ShareLinkUpdateOne) Mutation() *ShareLinkMutation {
+	return sluo.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (sluo *ShareLinkUpdateOne) ClearFile() *ShareLinkUpdateOne {
+	sluo.mutation.ClearFile()
+	return sluo
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (sluo *ShareLinkUpdateOne) Where(ps ...predicate.ShareLink) *ShareLinkUpdateOne {
+	sluo.mutation.Where(ps...)
+	return sluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sluo *ShareLinkUpdateOne) Select(field string, fields ...string) *ShareLinkUpdateOne {
+	sluo.fields = append([]string{field}, fields...)
+	return sluo
+}
+
+// Save executes the query and returns the updated ShareLink entity.
+func (sluo *ShareLinkUpdateOne) Save(ctx context.Context) (*ShareLink, error) {
+	sluo.defaults()
+	return withHooks[*ShareLink, ShareLinkMutation](ctx, sluo.sqlSave, sluo.mutation, sluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sluo *ShareLinkUpdateOne) SaveX(ctx context.Context) *ShareLink {
+	node, err := sluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sluo *ShareLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := sluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sluo *ShareLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := sluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sluo *ShareLinkUpdateOne) defaults() {
+	if _, ok := sluo.mutation.UpdatedAt(); !ok {
+		v := sharelink.UpdateDefaultUpdatedAt()
+		sluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sluo *ShareLinkUpdateOne) check() error {
+	if _, ok := sluo.mutation.FileID(); sluo.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.file"`)
+	}
+	return nil
+}
+
+func (sluo *ShareLinkUpdateOne) sqlSave(ctx context.Context) (_node *ShareLink, err error) {
+	if err := sluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	id, ok := sluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelink.FieldID)
+		for _, f := range fields {
+			if !sharelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sluo.mutation.UID(); ok {
+		_spec.SetField(sharelink.FieldUID, field.TypeUUID, value)
+	}
+	if value, ok := sluo.mutation.Token(); ok {
+		_spec.SetField(sharelink.FieldToken, field.TypeString, value)
+	}
+	if value, ok := sluo.mutation.UserID(); ok {
+		_spec.SetField(sharelink.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := sluo.mutation.AddedUserID(); ok {
+		_spec.AddField(sharelink.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := sluo.mutation.PasswordHash(); ok {
+		_spec.SetField(sharelink.FieldPasswordHash, field.TypeString, value)
+	}
+	if sluo.mutation.PasswordHashCleared() {
+		_spec.ClearField(sharelink.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := sluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sluo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sharelink.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := sluo.mutation.MaxDownloads(); ok {
+		_spec.SetField(sharelink.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := sluo.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(sharelink.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if sluo.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(sharelink.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := sluo.mutation.Downloads(); ok {
+		_spec.SetField(sharelink.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := sluo.mutation.AddedDownloads(); ok {
+		_spec.AddField(sharelink.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := sluo.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sluo.mutation.RevokedAtCleared() {
+		_spec.ClearField(sharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := sluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sharelink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sluo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.FileTable,
+			Columns: []string{sharelink.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.FileTable,
+			Columns: []string{sharelink.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareLink{config: sluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sluo.mutation.done = true
+	return _node, nil
+}
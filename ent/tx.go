@@ -12,8 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// Multipart is the client for interacting with the Multipart builders.
+	Multipart *MultipartClient
+	// MultipartPart is the client for interacting with the MultipartPart builders.
+	MultipartPart *MultipartPartClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Blob = NewBlobClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.Multipart = NewMultipartClient(tx.config)
+	tx.MultipartPart = NewMultipartPartClient(tx.config)
+	tx.ShareLink = NewShareLinkClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: Blob.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
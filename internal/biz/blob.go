@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"storage/ent"
+	"storage/internal/conf"
+)
+
+const blobsPrefix = `blobs`
+
+// newBlobObjectPath makes path of content object of new blob. Slug keys are made of names of files, so blobs
+// of slug layout are kept by checksum, the first byte of checksum is a directory to keep listings of storage small,
+// other layouts make keys of blobs as keys of files. Every blob gets own object, so object of released blob which is
+// removed late never takes content of blob created again with the same checksum.
+func (s *StorageUsecase) newBlobObjectPath(sha256Hex, filename string) string {
+	layout := s.storage.GetKeys().GetLayout()
+	if layout == conf.Storage_Keys_slug {
+		return fmt.Sprintf(`%s/%s/%s-%s`, blobsPrefix, sha256Hex[:2], sha256Hex, uuid.NewString())
+	}
+	return makeLayoutKey(layout, filename, uuid.NewString(), time.Now())
+}
+
+// ObjectPathOf returns path of object with content of file, deduplicated files share object of their blob,
+// so their own object path may not refer to any object
+func ObjectPathOf(f *ent.File) string {
+	if f.Edges.Blob != nil {
+		return f.Edges.Blob.ObjectPath
+	}
+	return f.ObjectPath
+}
+
+// storeBlob makes uploaded object of file with known checksum content of blob, so the same content uploaded many
+// times is stored once. Object is copied to path of new blob unless it is uploaded to unique key which becomes
+// content of blob as it is, and blob is committed only after its object exists, so file never refers to blob
+// without content.
+func (s *StorageUsecase) storeBlob(
+	ctx context.Context,
+	f *ent.File,
+	uploadedPath string,
+	uniqueKey bool,
+	size int64,
+	etag string,
+) (*ent.Blob, error) {
+	blob, err := s.blobRepo.Reference(ctx, f.Sha256)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if blob == nil {
+		blobPath := uploadedPath
+		if !uniqueKey {
+			blobPath = s.newBlobObjectPath(f.Sha256, f.Filename)
+			copied, err := s.minioClient.CopyObject(ctx, uploadedPath, blobPath)
+			if err != nil {
+				return nil, err
+			}
+			etag = copied.ETag
+		}
+
+		blob, err = s.blobRepo.Acquire(ctx, &ent.Blob{
+			Sha256:     f.Sha256,
+			ObjectPath: blobPath,
+			Size:       int(size),
+			Etag:       etag,
+		})
+		if err != nil {
+			if blobPath != uploadedPath {
+				s.removeObjectQuietly(ctx, blobPath)
+			}
+			return nil, err
+		}
+		if blobPath != uploadedPath && blobPath != blob.ObjectPath {
+			// the same content is stored concurrently, its blob is referenced instead
+			s.removeObjectQuietly(ctx, blobPath)
+		}
+	}
+
+	if uploadedPath != blob.ObjectPath {
+		// content is safe in blob already, so leftover object must not fail the upload
+		s.removeObjectQuietly(ctx, uploadedPath)
+	}
+
+	return blob, nil
+}
+
+// releaseBlob removes reference to blob and removes its content object with the last reference,
+// blob created again with the same checksum has another object, so removal never takes its content
+func (s *StorageUsecase) releaseBlob(ctx context.Context, blob *ent.Blob) error {
+	deleted, err := s.blobRepo.Release(ctx, blob.ID)
+	if err != nil || !deleted {
+		return err
+	}
+	return s.minioClient.Remove(ctx, blob.ObjectPath)
+}
+
+// releaseBlobQuietly is used on failures when error of releasing can only be logged
+func (s *StorageUsecase) releaseBlobQuietly(ctx context.Context, blob *ent.Blob) {
+	if err := s.releaseBlob(ctx, blob); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to release blob [%s]: %v`, blob.Sha256, err)
+	}
+}
+
+// removeObjectQuietly removes object which is not needed anymore, leftover object is only logged
+// because reconciliation removes it later
+func (s *StorageUsecase) removeObjectQuietly(ctx context.Context, objectPath string) {
+	if err := s.minioClient.Remove(ctx, objectPath); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to remove object [%s]: %v`, objectPath, err)
+	}
+}
@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+
+	"storage/ent"
+	"storage/internal/clients/minio"
+)
+
+func TestReleaseBlob(t *testing.T) {
+	const content = `photo`
+	ctx := context.Background()
+	blob := &ent.Blob{ID: 1, ObjectPath: (&StorageUsecase{}).newBlobObjectPath(strings.Repeat(`ab`, 32), `photo.jpg`)}
+
+	testCases := []struct {
+		name           string
+		deleted        bool
+		expectedStored bool
+	}{
+		{
+			name:           "referenced_blob_keeps_object",
+			deleted:        false,
+			expectedStored: true,
+		},
+		{
+			name:           "last_reference_removes_object",
+			deleted:        true,
+			expectedStored: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			storage := minio.NewMemory()
+			_, err := storage.UploadFromReader(ctx, strings.NewReader(content), int64(len(content)), ``, blob.ObjectPath)
+			require.NoError(t, err)
+
+			blobRepo := &blobRepositoryMock{
+				ReleaseFunc: func(ctx context.Context, id int) (bool, error) {
+					require.Equal(t, blob.ID, id)
+					return testCase.deleted, nil
+				},
+			}
+			usecase := &StorageUsecase{
+				minioClient: storage,
+				blobRepo:    blobRepo,
+				logger:      log.NewHelper(log.DefaultLogger),
+			}
+
+			require.NoError(t, usecase.releaseBlob(ctx, blob))
+			require.Len(t, blobRepo.ReleaseCalls(), 1)
+			_, stored := storage.Content(blob.ObjectPath)
+			require.Equal(t, testCase.expectedStored, stored)
+		})
+	}
+}
+
+func TestStoreBlob(t *testing.T) {
+	const content = `photo`
+	ctx := context.Background()
+	sha256Hex := strings.Repeat(`ab`, 32)
+	keys := &StorageUsecase{}
+	concurrentPath := keys.newBlobObjectPath(sha256Hex, `photo.jpg`)
+	blobPath := keys.newBlobObjectPath(sha256Hex, `photo.jpg`)
+
+	testCases := []struct {
+		name string
+		// uploadedPath is a path of uploaded object, objects of blobs are uploaded right there
+		uploadedPath string
+		// uniqueKey tells that uploaded object becomes content of new blob without copy
+		uniqueKey bool
+		// existing is a path of object of referenced blob, empty one means that there is no blob yet
+		existing string
+		// concurrent is a path of object of blob created concurrently after reference is failed
+		concurrent      string
+		expectedAcquire int
+		// expectedBlob is empty when object of acquired blob is copied to path of new blob
+		expectedBlob string
+	}{
+		{
+			name:         "existing_blob_is_referenced_without_copy",
+			uploadedPath: `7/photo.jpg`,
+			existing:     concurrentPath,
+			expectedBlob: concurrentPath,
+		},
+		{
+			name:            "new_blob_is_committed_after_copy",
+			uploadedPath:    `7/photo.jpg`,
+			expectedAcquire: 1,
+		},
+		{
+			name:            "object_uploaded_to_blob_path_is_not_copied",
+			uploadedPath:    blobPath,
+			uniqueKey:       true,
+			expectedAcquire: 1,
+			expectedBlob:    blobPath,
+		},
+		{
+			name:            "concurrent_blob_is_referenced_and_copy_is_removed",
+			uploadedPath:    blobPath,
+			uniqueKey:       true,
+			concurrent:      concurrentPath,
+			expectedAcquire: 1,
+			expectedBlob:    concurrentPath,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			storage := minio.NewMemory()
+			for _, objectPath := range []string{testCase.uploadedPath, testCase.existing, testCase.concurrent} {
+				if objectPath == "" {
+					continue
+				}
+				_, err := storage.UploadFromReader(ctx, strings.NewReader(content), int64(len(content)), ``, objectPath)
+				require.NoError(t, err)
+			}
+
+			blobRepo := &blobRepositoryMock{
+				ReferenceFunc: func(ctx context.Context, sha256 string) (*ent.Blob, error) {
+					require.Equal(t, sha256Hex, sha256)
+					if testCase.existing == "" {
+						return nil, &ent.NotFoundError{}
+					}
+					return &ent.Blob{ID: 1, Sha256: sha256, ObjectPath: testCase.existing}, nil
+				},
+				AcquireFunc: func(ctx context.Context, blob *ent.Blob) (*ent.Blob, error) {
+					_, stored := storage.Content(blob.ObjectPath)
+					require.True(t, stored, `object of blob exists before blob is committed`)
+					if testCase.concurrent != "" {
+						return &ent.Blob{ID: 2, Sha256: blob.Sha256, ObjectPath: testCase.concurrent}, nil
+					}
+					return blob, nil
+				},
+			}
+			usecase := &StorageUsecase{
+				minioClient: storage,
+				blobRepo:    blobRepo,
+				logger:      log.NewHelper(log.DefaultLogger),
+			}
+
+			blob, err := usecase.storeBlob(
+				ctx,
+				&ent.File{Sha256: sha256Hex, Filename: `photo.jpg`},
+				testCase.uploadedPath,
+				testCase.uniqueKey,
+				int64(len(content)),
+				``,
+			)
+			require.NoError(t, err)
+			require.Len(t, blobRepo.AcquireCalls(), testCase.expectedAcquire)
+			if testCase.expectedBlob != "" {
+				require.Equal(t, testCase.expectedBlob, blob.ObjectPath)
+			}
+			require.Regexp(t, slugBlobKeyPattern, blob.ObjectPath)
+			require.Equal(t, []string{blob.ObjectPath}, storage.Objects())
+			stored, _ := storage.Content(blob.ObjectPath)
+			require.Equal(t, content, string(stored))
+		})
+	}
+}
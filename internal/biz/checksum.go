@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // md5 is required by s3 Content-MD5, not used for security
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"strings"
+
+	v1 "storage/api/storage/v1"
+)
+
+var errChecksumSize = errors.New(`checksum has wrong size`)
+
+const (
+	digestAlgorithmSHA256 = `sha-256`
+	digestAlgorithmMD5    = `md5`
+)
+
+// checksumReader computes checksums of content while it is streamed to s3 storage
+type checksumReader struct {
+	reader io.Reader
+	sha256 hash.Hash
+	md5    hash.Hash
+}
+
+func newChecksumReader(reader io.Reader) *checksumReader {
+	c := &checksumReader{
+		sha256: sha256.New(),
+		md5:    md5.New(), //nolint:gosec
+	}
+	c.reader = io.TeeReader(reader, io.MultiWriter(c.sha256, c.md5))
+	return c
+}
+
+func (c *checksumReader) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+func (c *checksumReader) SHA256() []byte {
+	return c.sha256.Sum(nil)
+}
+
+func (c *checksumReader) MD5() []byte {
+	return c.md5.Sum(nil)
+}
+
+// verifyETag compares md5 of streamed content with etag of stored object, so content corrupted on the way
+// to storage is rejected, etag of multipart upload is not md5 of content and is not checked
+func (c *checksumReader) verifyETag(etag string) error {
+	etag = strings.Trim(etag, `"`)
+	if strings.Contains(etag, `-`) {
+		return nil
+	}
+	if sum := hex.EncodeToString(c.MD5()); !strings.EqualFold(etag, sum) {
+		return v1.ErrorInternalError(`md5 checksum of content is [%s], but stored object has etag [%s]`, sum, etag)
+	}
+	return nil
+}
+
+// expectedChecksums are checksums of content declared by client
+type expectedChecksums struct {
+	sha256 []byte
+	md5    []byte
+}
+
+// verify compares declared checksums with computed ones, absent checksums are not checked
+func (e *expectedChecksums) verify(sha256Sum, md5Sum []byte) error {
+	if e.sha256 != nil && !bytes.Equal(e.sha256, sha256Sum) {
+		return v1.ErrorValidationFailed(
+			`sha-256 checksum of content is [%s], but expected [%s]`,
+			hex.EncodeToString(sha256Sum),
+			hex.EncodeToString(e.sha256),
+		)
+	}
+	if e.md5 != nil && !bytes.Equal(e.md5, md5Sum) {
+		return v1.ErrorValidationFailed(
+			`md5 checksum of content is [%s], but expected [%s]`,
+			hex.EncodeToString(md5Sum),
+			hex.EncodeToString(e.md5),
+		)
+	}
+	return nil
+}
+
+// parseExpectedChecksums reads Digest header like "SHA-256=base64,MD5=base64" as rfc 3230 describes
+// and X-Checksum-SHA256 header with hex or base64 value, unknown digest algorithms are skipped
+func parseExpectedChecksums(digest, checksumSHA256 string) (*expectedChecksums, error) {
+	expected := &expectedChecksums{}
+	for _, item := range strings.Split(digest, `,`) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		algorithm, value, ok := strings.Cut(item, `=`)
+		if !ok {
+			return nil, v1.ErrorValidationFailed(`digest [%s] must be in form algorithm=value`, item)
+		}
+		var err error
+		switch strings.ToLower(algorithm) {
+		case digestAlgorithmSHA256:
+			expected.sha256, err = decodeChecksum(value, sha256.Size)
+		case digestAlgorithmMD5:
+			expected.md5, err = decodeChecksum(value, md5.Size)
+		}
+		if err != nil {
+			return nil, v1.ErrorValidationFailed(`digest [%s] is invalid: %s`, item, err)
+		}
+	}
+
+	if checksumSHA256 != "" {
+		sum, err := decodeChecksum(checksumSHA256, sha256.Size)
+		if err != nil {
+			return nil, v1.ErrorValidationFailed(`sha-256 checksum [%s] is invalid: %s`, checksumSHA256, err)
+		}
+		if expected.sha256 != nil && !bytes.Equal(expected.sha256, sum) {
+			return nil, v1.ErrorValidationFailed(`sha-256 checksums in Digest and X-Checksum-SHA256 differ`)
+		}
+		expected.sha256 = sum
+	}
+	return expected, nil
+}
+
+// decodeChecksum accepts both hex and base64 encodings, they differ by length of encoded value
+func decodeChecksum(value string, size int) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	var (
+		sum []byte
+		err error
+	)
+	if len(value) == hex.EncodedLen(size) {
+		sum, err = hex.DecodeString(value)
+	} else {
+		sum, err = base64.StdEncoding.DecodeString(value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(sum) != size {
+		return nil, errChecksumSize
+	}
+	return sum, nil
+}
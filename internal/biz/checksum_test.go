@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "storage/api/storage/v1"
+)
+
+func TestChecksums(t *testing.T) {
+	const content = `hello`
+	sha256Sum := sha256.Sum256([]byte(content))
+	sha256Hex := hex.EncodeToString(sha256Sum[:])
+	sha256Base64 := base64.StdEncoding.EncodeToString(sha256Sum[:])
+	const md5Base64 = `XUFAKrxLKna5cZ2REBfFkg==`
+
+	testCases := []struct {
+		name             string
+		digest           string
+		checksumSHA256   string
+		expectedParseErr bool
+		expectedMismatch bool
+	}{
+		{
+			name: "nothing_declared",
+		},
+		{
+			name:   "digest_sha256_and_md5",
+			digest: `SHA-256=` + sha256Base64 + `, MD5=` + md5Base64,
+		},
+		{
+			name:   "digest_unknown_algorithm",
+			digest: `UNIXsum=30637`,
+		},
+		{
+			name:           "checksum_hex",
+			checksumSHA256: sha256Hex,
+		},
+		{
+			name:           "checksum_base64_with_same_digest",
+			digest:         `sha-256=` + sha256Base64,
+			checksumSHA256: sha256Base64,
+		},
+		{
+			name:             "digest_mismatch",
+			digest:           `MD5=` + base64.StdEncoding.EncodeToString(make([]byte, 16)),
+			expectedMismatch: true,
+		},
+		{
+			name:             "checksum_mismatch",
+			checksumSHA256:   strings.Repeat(`0`, 64),
+			expectedMismatch: true,
+		},
+		{
+			name:             "digest_without_value",
+			digest:           `SHA-256`,
+			expectedParseErr: true,
+		},
+		{
+			name:             "checksum_wrong_size",
+			checksumSHA256:   `abcd`,
+			expectedParseErr: true,
+		},
+		{
+			name:             "headers_differ",
+			digest:           `SHA-256=` + sha256Base64,
+			checksumSHA256:   strings.Repeat(`0`, 64),
+			expectedParseErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			expected, err := parseExpectedChecksums(testCase.digest, testCase.checksumSHA256)
+			if testCase.expectedParseErr {
+				require.True(t, v1.IsValidationFailed(err))
+				return
+			}
+			require.NoError(t, err)
+
+			reader := newChecksumReader(strings.NewReader(content))
+			_, err = io.Copy(io.Discard, reader)
+			require.NoError(t, err)
+			require.Equal(t, sha256Hex, hex.EncodeToString(reader.SHA256()))
+
+			err = expected.verify(reader.SHA256(), reader.MD5())
+			if testCase.expectedMismatch {
+				require.True(t, v1.IsValidationFailed(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChecksumsVerifyETag(t *testing.T) {
+	reader := newChecksumReader(strings.NewReader(`hello`))
+	_, err := io.Copy(io.Discard, reader)
+	require.NoError(t, err)
+
+	require.NoError(t, reader.verifyETag(`5d41402abc4b2a76b9719d911017c592`))
+	require.NoError(t, reader.verifyETag(`"5D41402ABC4B2A76B9719D911017C592"`))
+	require.NoError(t, reader.verifyETag(`a4b2e1e1d6a9d5b2c1c3d5e1f2a3b4c5-2`), `etag of multipart upload is not checked`)
+	require.True(t, v1.IsInternalError(reader.verifyETag(`00000000000000000000000000000000`)))
+}
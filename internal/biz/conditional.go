@@ -0,0 +1,72 @@
+package biz
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"storage/ent"
+)
+
+const (
+	weakETagPrefix = `W/`
+	anyETag        = `*`
+)
+
+// quoteETag makes entity tag of header value from s3 etag which comes without quotes
+func quoteETag(etag string) string {
+	if etag == "" || strings.HasPrefix(etag, `"`) {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// notModified checks If-None-Match and If-Modified-Since headers against file validators,
+// If-Modified-Since is ignored when If-None-Match is present as rfc 9110 requires
+func notModified(f *ent.File, ifNoneMatch, ifModifiedSince string) bool {
+	if ifNoneMatch != "" {
+		return etagListMatches(ifNoneMatch, f.Etag)
+	}
+	if ifModifiedSince == "" || f.LastModified == nil {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	return !f.LastModified.Truncate(time.Second).After(since)
+}
+
+// etagListMatches uses weak comparison of etags from list like `"a", W/"b"` with current etag
+func etagListMatches(list, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = quoteETag(etag)
+	for _, candidate := range strings.Split(list, `,`) {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == anyETag || strings.TrimPrefix(candidate, weakETagPrefix) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifRangeMatches checks If-Range header which is either strong etag or exact time of modification,
+// ranges must not be applied to changed file
+func ifRangeMatches(f *ent.File, ifRange string) bool {
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) {
+		return f.Etag != "" && ifRange == quoteETag(f.Etag)
+	}
+	if strings.HasPrefix(ifRange, weakETagPrefix) || f.LastModified == nil {
+		return false
+	}
+	at, err := http.ParseTime(ifRange)
+	if err != nil {
+		return false
+	}
+	return f.LastModified.Truncate(time.Second).Equal(at)
+}
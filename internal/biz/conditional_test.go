@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/require"
+
+	"storage/ent"
+)
+
+func TestConditionalHeaders(t *testing.T) {
+	modifiedAt := time.Date(2023, time.March, 14, 10, 30, 15, 500, time.UTC)
+	f := &ent.File{Etag: `5d41402abc4b2a76b9719d911017c592`, LastModified: pointer.ToTime(modifiedAt)}
+	etag := `"5d41402abc4b2a76b9719d911017c592"`
+
+	testCases := []struct {
+		name                string
+		ifNoneMatch         string
+		ifModifiedSince     string
+		ifRange             string
+		expectedNotModified bool
+		expectedRangeMatch  bool
+	}{
+		{
+			name:               "no_conditions",
+			expectedRangeMatch: true,
+		},
+		{
+			name:                "etag_in_list",
+			ifNoneMatch:         `"other", ` + etag,
+			expectedNotModified: true,
+			expectedRangeMatch:  true,
+		},
+		{
+			name:                "weak_etag",
+			ifNoneMatch:         `W/` + etag,
+			expectedNotModified: true,
+			expectedRangeMatch:  true,
+		},
+		{
+			name:                "any_etag",
+			ifNoneMatch:         `*`,
+			expectedNotModified: true,
+			expectedRangeMatch:  true,
+		},
+		{
+			name:               "if_none_match_wins",
+			ifNoneMatch:        `"other"`,
+			ifModifiedSince:    `Tue, 14 Mar 2023 10:30:15 GMT`,
+			expectedRangeMatch: true,
+		},
+		{
+			name:                "not_modified_since",
+			ifModifiedSince:     `Tue, 14 Mar 2023 10:30:15 GMT`,
+			expectedNotModified: true,
+			expectedRangeMatch:  true,
+		},
+		{
+			name:               "modified_since",
+			ifModifiedSince:    `Tue, 14 Mar 2023 10:30:14 GMT`,
+			expectedRangeMatch: true,
+		},
+		{
+			name:               "invalid_date",
+			ifModifiedSince:    `yesterday`,
+			expectedRangeMatch: true,
+		},
+		{
+			name:               "if_range_etag",
+			ifRange:            etag,
+			expectedRangeMatch: true,
+		},
+		{
+			name:    "if_range_weak_etag",
+			ifRange: `W/` + etag,
+		},
+		{
+			name:    "if_range_other_etag",
+			ifRange: `"other"`,
+		},
+		{
+			name:               "if_range_date",
+			ifRange:            `Tue, 14 Mar 2023 10:30:15 GMT`,
+			expectedRangeMatch: true,
+		},
+		{
+			name:    "if_range_other_date",
+			ifRange: `Tue, 14 Mar 2023 10:30:16 GMT`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedNotModified, notModified(f, testCase.ifNoneMatch, testCase.ifModifiedSince))
+			require.Equal(t, testCase.expectedRangeMatch, ifRangeMatches(f, testCase.ifRange))
+		})
+	}
+}
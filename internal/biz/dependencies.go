@@ -2,25 +2,81 @@ package biz
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/google/wire"
 
 	"storage/ent"
+	"storage/ent/file"
 	"storage/internal/data"
 )
 
 var (
-	BindFileRepository = wire.Bind(new(fileRepository), new(*data.FileRepo))
+	BindFileRepository      = wire.Bind(new(fileRepository), new(*data.FileRepo))
+	BindMultipartRepository = wire.Bind(new(multipartRepository), new(*data.MultipartRepo))
+	BindBlobRepository      = wire.Bind(new(blobRepository), new(*data.BlobRepo))
+	BindShareLinkRepository = wire.Bind(new(shareLinkRepository), new(*data.ShareLinkRepo))
 )
 
-//go:generate moq -out dependencies_moq_test.go . fileRepository
+//go:generate moq -out dependencies_moq_test.go . fileRepository multipartRepository blobRepository shareLinkRepository
 
 type fileRepository interface {
 	Create(ctx context.Context, file *ent.File) (*ent.File, error)
 	Delete(ctx context.Context, uid string) error
 	Restore(ctx context.Context, uid string) error
+	Activate(ctx context.Context, file *ent.File) error
+	Fail(ctx context.Context, uid string) error
+	Purge(ctx context.Context, uid string) error
+	UpdateObjectInfo(ctx context.Context, uid string, size int, etag string, lastModified time.Time) error
+	SaveScanResult(ctx context.Context, uid string, status file.ScanStatus, result string, scannedAt time.Time) error
 	FindByUID(ctx context.Context, uid string) (*ent.File, error)
+	FindPendingByUID(ctx context.Context, uid string) (*ent.File, error)
+	FindDeletedByUID(ctx context.Context, uid string) (*ent.File, error)
 	FindByUserID(ctx context.Context, userID, limit, offset int) ([]*ent.File, error)
+	FindActive(ctx context.Context, limit, offset int) ([]*ent.File, error)
+	Usage(ctx context.Context, userID int) (int64, int, error)
+	FindDeletedByUserID(ctx context.Context, userID, limit, offset int) ([]*ent.File, error)
+	FindLatestVersion(ctx context.Context, logicalPath string) (*ent.File, error)
+	FindVersion(ctx context.Context, logicalPath string, version int) (*ent.File, error)
+	FindVersions(ctx context.Context, logicalPath string) ([]*ent.File, error)
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*ent.File, error)
+	FindByStatus(ctx context.Context, status file.Status, limit, offset int) ([]*ent.File, error)
+	FindQuarantined(ctx context.Context, limit int) ([]*ent.File, error)
 	FindByFilename(ctx context.Context, filename string) (*ent.File, error)
 	FindByObjectPath(ctx context.Context, objectPath string) (*ent.File, error)
+	FindObjectPaths(ctx context.Context) ([]string, error)
+	HasObjectOwner(ctx context.Context, objectPath string) (bool, error)
+	UpdateObjectPath(ctx context.Context, uid, from, to string) error
+}
+
+type multipartRepository interface {
+	Create(ctx context.Context, upload *ent.Multipart) (*ent.Multipart, error)
+	FindByUID(ctx context.Context, uid string) (*ent.Multipart, error)
+	Complete(ctx context.Context, id int, fileUID uuid.UUID) error
+	Abort(ctx context.Context, id int) error
+	SetDetectedMimeType(ctx context.Context, id int, detectedMimeType string) error
+	Lock(ctx context.Context, id int, lockUID uuid.UUID, now, until time.Time) (bool, error)
+	Unlock(ctx context.Context, id int, lockUID uuid.UUID) error
+	SavePart(ctx context.Context, part *ent.MultipartPart) (*ent.MultipartPart, error)
+	DeletePart(ctx context.Context, multipartID, partNumber int) error
+	FindParts(ctx context.Context, multipartID int) ([]*ent.MultipartPart, error)
+}
+
+type blobRepository interface {
+	Reference(ctx context.Context, sha256 string) (*ent.Blob, error)
+	Acquire(ctx context.Context, blob *ent.Blob) (*ent.Blob, error)
+	Release(ctx context.Context, id int) (bool, error)
+	FindObjectPaths(ctx context.Context) ([]string, error)
+	UpdateObjectPath(ctx context.Context, id int, from, to string) error
+}
+
+type shareLinkRepository interface {
+	Create(ctx context.Context, link *ent.ShareLink) (*ent.ShareLink, error)
+	FindByToken(ctx context.Context, token string) (*ent.ShareLink, error)
+	FindByUID(ctx context.Context, uid string) (*ent.ShareLink, error)
+	FindByFileID(ctx context.Context, fileID int) ([]*ent.ShareLink, error)
+	Revoke(ctx context.Context, uid string) (bool, error)
+	CountDownload(ctx context.Context, id int, now time.Time) (bool, error)
+	UncountDownload(ctx context.Context, id int) error
 }
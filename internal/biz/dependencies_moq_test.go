@@ -5,8 +5,11 @@ package biz
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"storage/ent"
+	fileStatus "storage/ent/file"
 	"sync"
+	"time"
 )
 
 // Ensure, that fileRepositoryMock does implement fileRepository.
@@ -19,27 +22,84 @@ var _ fileRepository = &fileRepositoryMock{}
 //
 //		// make and configure a mocked fileRepository
 //		mockedfileRepository := &fileRepositoryMock{
+//			ActivateFunc: func(ctx context.Context, file *ent.File) error {
+//				panic("mock out the Activate method")
+//			},
 //			CreateFunc: func(ctx context.Context, file *ent.File) (*ent.File, error) {
 //				panic("mock out the Create method")
 //			},
 //			DeleteFunc: func(ctx context.Context, uid string) error {
 //				panic("mock out the Delete method")
 //			},
+//			FailFunc: func(ctx context.Context, uid string) error {
+//				panic("mock out the Fail method")
+//			},
+//			FindActiveFunc: func(ctx context.Context, limit int, offset int) ([]*ent.File, error) {
+//				panic("mock out the FindActive method")
+//			},
 //			FindByFilenameFunc: func(ctx context.Context, filename string) (*ent.File, error) {
 //				panic("mock out the FindByFilename method")
 //			},
 //			FindByObjectPathFunc: func(ctx context.Context, objectPath string) (*ent.File, error) {
 //				panic("mock out the FindByObjectPath method")
 //			},
+//			FindByStatusFunc: func(ctx context.Context, status fileStatus.Status, limit int, offset int) ([]*ent.File, error) {
+//				panic("mock out the FindByStatus method")
+//			},
 //			FindByUIDFunc: func(ctx context.Context, uid string) (*ent.File, error) {
 //				panic("mock out the FindByUID method")
 //			},
 //			FindByUserIDFunc: func(ctx context.Context, userID int, limit int, offset int) ([]*ent.File, error) {
 //				panic("mock out the FindByUserID method")
 //			},
+//			FindDeletedBeforeFunc: func(ctx context.Context, before time.Time, limit int) ([]*ent.File, error) {
+//				panic("mock out the FindDeletedBefore method")
+//			},
+//			FindDeletedByUIDFunc: func(ctx context.Context, uid string) (*ent.File, error) {
+//				panic("mock out the FindDeletedByUID method")
+//			},
+//			FindDeletedByUserIDFunc: func(ctx context.Context, userID int, limit int, offset int) ([]*ent.File, error) {
+//				panic("mock out the FindDeletedByUserID method")
+//			},
+//			FindLatestVersionFunc: func(ctx context.Context, logicalPath string) (*ent.File, error) {
+//				panic("mock out the FindLatestVersion method")
+//			},
+//			FindObjectPathsFunc: func(ctx context.Context) ([]string, error) {
+//				panic("mock out the FindObjectPaths method")
+//			},
+//			FindPendingByUIDFunc: func(ctx context.Context, uid string) (*ent.File, error) {
+//				panic("mock out the FindPendingByUID method")
+//			},
+//			FindQuarantinedFunc: func(ctx context.Context, limit int) ([]*ent.File, error) {
+//				panic("mock out the FindQuarantined method")
+//			},
+//			FindVersionFunc: func(ctx context.Context, logicalPath string, version int) (*ent.File, error) {
+//				panic("mock out the FindVersion method")
+//			},
+//			FindVersionsFunc: func(ctx context.Context, logicalPath string) ([]*ent.File, error) {
+//				panic("mock out the FindVersions method")
+//			},
+//			HasObjectOwnerFunc: func(ctx context.Context, objectPath string) (bool, error) {
+//				panic("mock out the HasObjectOwner method")
+//			},
+//			PurgeFunc: func(ctx context.Context, uid string) error {
+//				panic("mock out the Purge method")
+//			},
 //			RestoreFunc: func(ctx context.Context, uid string) error {
 //				panic("mock out the Restore method")
 //			},
+//			SaveScanResultFunc: func(ctx context.Context, uid string, status fileStatus.ScanStatus, result string, scannedAt time.Time) error {
+//				panic("mock out the SaveScanResult method")
+//			},
+//			UpdateObjectInfoFunc: func(ctx context.Context, uid string, size int, etag string, lastModified time.Time) error {
+//				panic("mock out the UpdateObjectInfo method")
+//			},
+//			UpdateObjectPathFunc: func(ctx context.Context, uid string, from string, to string) error {
+//				panic("mock out the UpdateObjectPath method")
+//			},
+//			UsageFunc: func(ctx context.Context, userID int) (int64, int, error) {
+//				panic("mock out the Usage method")
+//			},
 //		}
 //
 //		// use mockedfileRepository in code that requires fileRepository
@@ -47,29 +107,93 @@ var _ fileRepository = &fileRepositoryMock{}
 //
 //	}
 type fileRepositoryMock struct {
+	// ActivateFunc mocks the Activate method.
+	ActivateFunc func(ctx context.Context, file *ent.File) error
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, file *ent.File) (*ent.File, error)
 
 	// DeleteFunc mocks the Delete method.
 	DeleteFunc func(ctx context.Context, uid string) error
 
+	// FailFunc mocks the Fail method.
+	FailFunc func(ctx context.Context, uid string) error
+
+	// FindActiveFunc mocks the FindActive method.
+	FindActiveFunc func(ctx context.Context, limit int, offset int) ([]*ent.File, error)
+
 	// FindByFilenameFunc mocks the FindByFilename method.
 	FindByFilenameFunc func(ctx context.Context, filename string) (*ent.File, error)
 
 	// FindByObjectPathFunc mocks the FindByObjectPath method.
 	FindByObjectPathFunc func(ctx context.Context, objectPath string) (*ent.File, error)
 
+	// FindByStatusFunc mocks the FindByStatus method.
+	FindByStatusFunc func(ctx context.Context, status fileStatus.Status, limit int, offset int) ([]*ent.File, error)
+
 	// FindByUIDFunc mocks the FindByUID method.
 	FindByUIDFunc func(ctx context.Context, uid string) (*ent.File, error)
 
 	// FindByUserIDFunc mocks the FindByUserID method.
 	FindByUserIDFunc func(ctx context.Context, userID int, limit int, offset int) ([]*ent.File, error)
 
+	// FindDeletedBeforeFunc mocks the FindDeletedBefore method.
+	FindDeletedBeforeFunc func(ctx context.Context, before time.Time, limit int) ([]*ent.File, error)
+
+	// FindDeletedByUIDFunc mocks the FindDeletedByUID method.
+	FindDeletedByUIDFunc func(ctx context.Context, uid string) (*ent.File, error)
+
+	// FindDeletedByUserIDFunc mocks the FindDeletedByUserID method.
+	FindDeletedByUserIDFunc func(ctx context.Context, userID int, limit int, offset int) ([]*ent.File, error)
+
+	// FindLatestVersionFunc mocks the FindLatestVersion method.
+	FindLatestVersionFunc func(ctx context.Context, logicalPath string) (*ent.File, error)
+
+	// FindObjectPathsFunc mocks the FindObjectPaths method.
+	FindObjectPathsFunc func(ctx context.Context) ([]string, error)
+
+	// FindPendingByUIDFunc mocks the FindPendingByUID method.
+	FindPendingByUIDFunc func(ctx context.Context, uid string) (*ent.File, error)
+
+	// FindQuarantinedFunc mocks the FindQuarantined method.
+	FindQuarantinedFunc func(ctx context.Context, limit int) ([]*ent.File, error)
+
+	// FindVersionFunc mocks the FindVersion method.
+	FindVersionFunc func(ctx context.Context, logicalPath string, version int) (*ent.File, error)
+
+	// FindVersionsFunc mocks the FindVersions method.
+	FindVersionsFunc func(ctx context.Context, logicalPath string) ([]*ent.File, error)
+
+	// HasObjectOwnerFunc mocks the HasObjectOwner method.
+	HasObjectOwnerFunc func(ctx context.Context, objectPath string) (bool, error)
+
+	// PurgeFunc mocks the Purge method.
+	PurgeFunc func(ctx context.Context, uid string) error
+
 	// RestoreFunc mocks the Restore method.
 	RestoreFunc func(ctx context.Context, uid string) error
 
+	// SaveScanResultFunc mocks the SaveScanResult method.
+	SaveScanResultFunc func(ctx context.Context, uid string, status fileStatus.ScanStatus, result string, scannedAt time.Time) error
+
+	// UpdateObjectInfoFunc mocks the UpdateObjectInfo method.
+	UpdateObjectInfoFunc func(ctx context.Context, uid string, size int, etag string, lastModified time.Time) error
+
+	// UpdateObjectPathFunc mocks the UpdateObjectPath method.
+	UpdateObjectPathFunc func(ctx context.Context, uid string, from string, to string) error
+
+	// UsageFunc mocks the Usage method.
+	UsageFunc func(ctx context.Context, userID int) (int64, int, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// Activate holds details about calls to the Activate method.
+		Activate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// File is the file argument value.
+			File *ent.File
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Ctx is the ctx argument value.
@@ -84,6 +208,22 @@ type fileRepositoryMock struct {
 			// UID is the uid argument value.
 			UID string
 		}
+		// Fail holds details about calls to the Fail method.
+		Fail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// FindActive holds details about calls to the FindActive method.
+		FindActive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
 		// FindByFilename holds details about calls to the FindByFilename method.
 		FindByFilename []struct {
 			// Ctx is the ctx argument value.
@@ -98,6 +238,17 @@ type fileRepositoryMock struct {
 			// ObjectPath is the objectPath argument value.
 			ObjectPath string
 		}
+		// FindByStatus holds details about calls to the FindByStatus method.
+		FindByStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status fileStatus.Status
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
 		// FindByUID holds details about calls to the FindByUID method.
 		FindByUID []struct {
 			// Ctx is the ctx argument value.
@@ -116,6 +267,89 @@ type fileRepositoryMock struct {
 			// Offset is the offset argument value.
 			Offset int
 		}
+		// FindDeletedBefore holds details about calls to the FindDeletedBefore method.
+		FindDeletedBefore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// FindDeletedByUID holds details about calls to the FindDeletedByUID method.
+		FindDeletedByUID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// FindDeletedByUserID holds details about calls to the FindDeletedByUserID method.
+		FindDeletedByUserID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID int
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// FindLatestVersion holds details about calls to the FindLatestVersion method.
+		FindLatestVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LogicalPath is the logicalPath argument value.
+			LogicalPath string
+		}
+		// FindObjectPaths holds details about calls to the FindObjectPaths method.
+		FindObjectPaths []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// FindPendingByUID holds details about calls to the FindPendingByUID method.
+		FindPendingByUID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// FindQuarantined holds details about calls to the FindQuarantined method.
+		FindQuarantined []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// FindVersion holds details about calls to the FindVersion method.
+		FindVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LogicalPath is the logicalPath argument value.
+			LogicalPath string
+			// Version is the version argument value.
+			Version int
+		}
+		// FindVersions holds details about calls to the FindVersions method.
+		FindVersions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LogicalPath is the logicalPath argument value.
+			LogicalPath string
+		}
+		// HasObjectOwner holds details about calls to the HasObjectOwner method.
+		HasObjectOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ObjectPath is the objectPath argument value.
+			ObjectPath string
+		}
+		// Purge holds details about calls to the Purge method.
+		Purge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
 		// Restore holds details about calls to the Restore method.
 		Restore []struct {
 			// Ctx is the ctx argument value.
@@ -123,14 +357,113 @@ type fileRepositoryMock struct {
 			// UID is the uid argument value.
 			UID string
 		}
+		// SaveScanResult holds details about calls to the SaveScanResult method.
+		SaveScanResult []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+			// Status is the status argument value.
+			Status fileStatus.ScanStatus
+			// Result is the result argument value.
+			Result string
+			// ScannedAt is the scannedAt argument value.
+			ScannedAt time.Time
+		}
+		// UpdateObjectInfo holds details about calls to the UpdateObjectInfo method.
+		UpdateObjectInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+			// Size is the size argument value.
+			Size int
+			// Etag is the etag argument value.
+			Etag string
+			// LastModified is the lastModified argument value.
+			LastModified time.Time
+		}
+		// UpdateObjectPath holds details about calls to the UpdateObjectPath method.
+		UpdateObjectPath []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+			// From is the from argument value.
+			From string
+			// To is the to argument value.
+			To string
+		}
+		// Usage holds details about calls to the Usage method.
+		Usage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID int
+		}
+	}
+	lockActivate            sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockDelete              sync.RWMutex
+	lockFail                sync.RWMutex
+	lockFindActive          sync.RWMutex
+	lockFindByFilename      sync.RWMutex
+	lockFindByObjectPath    sync.RWMutex
+	lockFindByStatus        sync.RWMutex
+	lockFindByUID           sync.RWMutex
+	lockFindByUserID        sync.RWMutex
+	lockFindDeletedBefore   sync.RWMutex
+	lockFindDeletedByUID    sync.RWMutex
+	lockFindDeletedByUserID sync.RWMutex
+	lockFindLatestVersion   sync.RWMutex
+	lockFindObjectPaths     sync.RWMutex
+	lockFindPendingByUID    sync.RWMutex
+	lockFindQuarantined     sync.RWMutex
+	lockFindVersion         sync.RWMutex
+	lockFindVersions        sync.RWMutex
+	lockHasObjectOwner      sync.RWMutex
+	lockPurge               sync.RWMutex
+	lockRestore             sync.RWMutex
+	lockSaveScanResult      sync.RWMutex
+	lockUpdateObjectInfo    sync.RWMutex
+	lockUpdateObjectPath    sync.RWMutex
+	lockUsage               sync.RWMutex
+}
+
+// Activate calls ActivateFunc.
+func (mock *fileRepositoryMock) Activate(ctx context.Context, file *ent.File) error {
+	if mock.ActivateFunc == nil {
+		panic("fileRepositoryMock.ActivateFunc: method is nil but fileRepository.Activate was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		File *ent.File
+	}{
+		Ctx:  ctx,
+		File: file,
+	}
+	mock.lockActivate.Lock()
+	mock.calls.Activate = append(mock.calls.Activate, callInfo)
+	mock.lockActivate.Unlock()
+	return mock.ActivateFunc(ctx, file)
+}
+
+// ActivateCalls gets all the calls that were made to Activate.
+// Check the length with:
+//
+//	len(mockedfileRepository.ActivateCalls())
+func (mock *fileRepositoryMock) ActivateCalls() []struct {
+	Ctx  context.Context
+	File *ent.File
+} {
+	var calls []struct {
+		Ctx  context.Context
+		File *ent.File
 	}
-	lockCreate           sync.RWMutex
-	lockDelete           sync.RWMutex
-	lockFindByFilename   sync.RWMutex
-	lockFindByObjectPath sync.RWMutex
-	lockFindByUID        sync.RWMutex
-	lockFindByUserID     sync.RWMutex
-	lockRestore          sync.RWMutex
+	mock.lockActivate.RLock()
+	calls = mock.calls.Activate
+	mock.lockActivate.RUnlock()
+	return calls
 }
 
 // Create calls CreateFunc.
@@ -205,6 +538,82 @@ func (mock *fileRepositoryMock) DeleteCalls() []struct {
 	return calls
 }
 
+// Fail calls FailFunc.
+func (mock *fileRepositoryMock) Fail(ctx context.Context, uid string) error {
+	if mock.FailFunc == nil {
+		panic("fileRepositoryMock.FailFunc: method is nil but fileRepository.Fail was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockFail.Lock()
+	mock.calls.Fail = append(mock.calls.Fail, callInfo)
+	mock.lockFail.Unlock()
+	return mock.FailFunc(ctx, uid)
+}
+
+// FailCalls gets all the calls that were made to Fail.
+// Check the length with:
+//
+//	len(mockedfileRepository.FailCalls())
+func (mock *fileRepositoryMock) FailCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockFail.RLock()
+	calls = mock.calls.Fail
+	mock.lockFail.RUnlock()
+	return calls
+}
+
+// FindActive calls FindActiveFunc.
+func (mock *fileRepositoryMock) FindActive(ctx context.Context, limit int, offset int) ([]*ent.File, error) {
+	if mock.FindActiveFunc == nil {
+		panic("fileRepositoryMock.FindActiveFunc: method is nil but fileRepository.FindActive was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockFindActive.Lock()
+	mock.calls.FindActive = append(mock.calls.FindActive, callInfo)
+	mock.lockFindActive.Unlock()
+	return mock.FindActiveFunc(ctx, limit, offset)
+}
+
+// FindActiveCalls gets all the calls that were made to FindActive.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindActiveCalls())
+func (mock *fileRepositoryMock) FindActiveCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockFindActive.RLock()
+	calls = mock.calls.FindActive
+	mock.lockFindActive.RUnlock()
+	return calls
+}
+
 // FindByFilename calls FindByFilenameFunc.
 func (mock *fileRepositoryMock) FindByFilename(ctx context.Context, filename string) (*ent.File, error) {
 	if mock.FindByFilenameFunc == nil {
@@ -277,6 +686,50 @@ func (mock *fileRepositoryMock) FindByObjectPathCalls() []struct {
 	return calls
 }
 
+// FindByStatus calls FindByStatusFunc.
+func (mock *fileRepositoryMock) FindByStatus(ctx context.Context, status fileStatus.Status, limit int, offset int) ([]*ent.File, error) {
+	if mock.FindByStatusFunc == nil {
+		panic("fileRepositoryMock.FindByStatusFunc: method is nil but fileRepository.FindByStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status fileStatus.Status
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockFindByStatus.Lock()
+	mock.calls.FindByStatus = append(mock.calls.FindByStatus, callInfo)
+	mock.lockFindByStatus.Unlock()
+	return mock.FindByStatusFunc(ctx, status, limit, offset)
+}
+
+// FindByStatusCalls gets all the calls that were made to FindByStatus.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindByStatusCalls())
+func (mock *fileRepositoryMock) FindByStatusCalls() []struct {
+	Ctx    context.Context
+	Status fileStatus.Status
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status fileStatus.Status
+		Limit  int
+		Offset int
+	}
+	mock.lockFindByStatus.RLock()
+	calls = mock.calls.FindByStatus
+	mock.lockFindByStatus.RUnlock()
+	return calls
+}
+
 // FindByUID calls FindByUIDFunc.
 func (mock *fileRepositoryMock) FindByUID(ctx context.Context, uid string) (*ent.File, error) {
 	if mock.FindByUIDFunc == nil {
@@ -357,38 +810,1842 @@ func (mock *fileRepositoryMock) FindByUserIDCalls() []struct {
 	return calls
 }
 
-// Restore calls RestoreFunc.
-func (mock *fileRepositoryMock) Restore(ctx context.Context, uid string) error {
-	if mock.RestoreFunc == nil {
-		panic("fileRepositoryMock.RestoreFunc: method is nil but fileRepository.Restore was just called")
+// FindDeletedBefore calls FindDeletedBeforeFunc.
+func (mock *fileRepositoryMock) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*ent.File, error) {
+	if mock.FindDeletedBeforeFunc == nil {
+		panic("fileRepositoryMock.FindDeletedBeforeFunc: method is nil but fileRepository.FindDeletedBefore was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		UID string
+		Ctx    context.Context
+		Before time.Time
+		Limit  int
 	}{
-		Ctx: ctx,
-		UID: uid,
+		Ctx:    ctx,
+		Before: before,
+		Limit:  limit,
 	}
-	mock.lockRestore.Lock()
-	mock.calls.Restore = append(mock.calls.Restore, callInfo)
-	mock.lockRestore.Unlock()
-	return mock.RestoreFunc(ctx, uid)
+	mock.lockFindDeletedBefore.Lock()
+	mock.calls.FindDeletedBefore = append(mock.calls.FindDeletedBefore, callInfo)
+	mock.lockFindDeletedBefore.Unlock()
+	return mock.FindDeletedBeforeFunc(ctx, before, limit)
 }
 
-// RestoreCalls gets all the calls that were made to Restore.
+// FindDeletedBeforeCalls gets all the calls that were made to FindDeletedBefore.
 // Check the length with:
 //
-//	len(mockedfileRepository.RestoreCalls())
-func (mock *fileRepositoryMock) RestoreCalls() []struct {
-	Ctx context.Context
-	UID string
+//	len(mockedfileRepository.FindDeletedBeforeCalls())
+func (mock *fileRepositoryMock) FindDeletedBeforeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+	Limit  int
 } {
 	var calls []struct {
-		Ctx context.Context
-		UID string
+		Ctx    context.Context
+		Before time.Time
+		Limit  int
+	}
+	mock.lockFindDeletedBefore.RLock()
+	calls = mock.calls.FindDeletedBefore
+	mock.lockFindDeletedBefore.RUnlock()
+	return calls
+}
+
+// FindDeletedByUID calls FindDeletedByUIDFunc.
+func (mock *fileRepositoryMock) FindDeletedByUID(ctx context.Context, uid string) (*ent.File, error) {
+	if mock.FindDeletedByUIDFunc == nil {
+		panic("fileRepositoryMock.FindDeletedByUIDFunc: method is nil but fileRepository.FindDeletedByUID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockFindDeletedByUID.Lock()
+	mock.calls.FindDeletedByUID = append(mock.calls.FindDeletedByUID, callInfo)
+	mock.lockFindDeletedByUID.Unlock()
+	return mock.FindDeletedByUIDFunc(ctx, uid)
+}
+
+// FindDeletedByUIDCalls gets all the calls that were made to FindDeletedByUID.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindDeletedByUIDCalls())
+func (mock *fileRepositoryMock) FindDeletedByUIDCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockFindDeletedByUID.RLock()
+	calls = mock.calls.FindDeletedByUID
+	mock.lockFindDeletedByUID.RUnlock()
+	return calls
+}
+
+// FindDeletedByUserID calls FindDeletedByUserIDFunc.
+func (mock *fileRepositoryMock) FindDeletedByUserID(ctx context.Context, userID int, limit int, offset int) ([]*ent.File, error) {
+	if mock.FindDeletedByUserIDFunc == nil {
+		panic("fileRepositoryMock.FindDeletedByUserIDFunc: method is nil but fileRepository.FindDeletedByUserID was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID int
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockFindDeletedByUserID.Lock()
+	mock.calls.FindDeletedByUserID = append(mock.calls.FindDeletedByUserID, callInfo)
+	mock.lockFindDeletedByUserID.Unlock()
+	return mock.FindDeletedByUserIDFunc(ctx, userID, limit, offset)
+}
+
+// FindDeletedByUserIDCalls gets all the calls that were made to FindDeletedByUserID.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindDeletedByUserIDCalls())
+func (mock *fileRepositoryMock) FindDeletedByUserIDCalls() []struct {
+	Ctx    context.Context
+	UserID int
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID int
+		Limit  int
+		Offset int
+	}
+	mock.lockFindDeletedByUserID.RLock()
+	calls = mock.calls.FindDeletedByUserID
+	mock.lockFindDeletedByUserID.RUnlock()
+	return calls
+}
+
+// FindLatestVersion calls FindLatestVersionFunc.
+func (mock *fileRepositoryMock) FindLatestVersion(ctx context.Context, logicalPath string) (*ent.File, error) {
+	if mock.FindLatestVersionFunc == nil {
+		panic("fileRepositoryMock.FindLatestVersionFunc: method is nil but fileRepository.FindLatestVersion was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		LogicalPath string
+	}{
+		Ctx:         ctx,
+		LogicalPath: logicalPath,
+	}
+	mock.lockFindLatestVersion.Lock()
+	mock.calls.FindLatestVersion = append(mock.calls.FindLatestVersion, callInfo)
+	mock.lockFindLatestVersion.Unlock()
+	return mock.FindLatestVersionFunc(ctx, logicalPath)
+}
+
+// FindLatestVersionCalls gets all the calls that were made to FindLatestVersion.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindLatestVersionCalls())
+func (mock *fileRepositoryMock) FindLatestVersionCalls() []struct {
+	Ctx         context.Context
+	LogicalPath string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		LogicalPath string
+	}
+	mock.lockFindLatestVersion.RLock()
+	calls = mock.calls.FindLatestVersion
+	mock.lockFindLatestVersion.RUnlock()
+	return calls
+}
+
+// FindObjectPaths calls FindObjectPathsFunc.
+func (mock *fileRepositoryMock) FindObjectPaths(ctx context.Context) ([]string, error) {
+	if mock.FindObjectPathsFunc == nil {
+		panic("fileRepositoryMock.FindObjectPathsFunc: method is nil but fileRepository.FindObjectPaths was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFindObjectPaths.Lock()
+	mock.calls.FindObjectPaths = append(mock.calls.FindObjectPaths, callInfo)
+	mock.lockFindObjectPaths.Unlock()
+	return mock.FindObjectPathsFunc(ctx)
+}
+
+// FindObjectPathsCalls gets all the calls that were made to FindObjectPaths.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindObjectPathsCalls())
+func (mock *fileRepositoryMock) FindObjectPathsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFindObjectPaths.RLock()
+	calls = mock.calls.FindObjectPaths
+	mock.lockFindObjectPaths.RUnlock()
+	return calls
+}
+
+// FindPendingByUID calls FindPendingByUIDFunc.
+func (mock *fileRepositoryMock) FindPendingByUID(ctx context.Context, uid string) (*ent.File, error) {
+	if mock.FindPendingByUIDFunc == nil {
+		panic("fileRepositoryMock.FindPendingByUIDFunc: method is nil but fileRepository.FindPendingByUID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockFindPendingByUID.Lock()
+	mock.calls.FindPendingByUID = append(mock.calls.FindPendingByUID, callInfo)
+	mock.lockFindPendingByUID.Unlock()
+	return mock.FindPendingByUIDFunc(ctx, uid)
+}
+
+// FindPendingByUIDCalls gets all the calls that were made to FindPendingByUID.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindPendingByUIDCalls())
+func (mock *fileRepositoryMock) FindPendingByUIDCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockFindPendingByUID.RLock()
+	calls = mock.calls.FindPendingByUID
+	mock.lockFindPendingByUID.RUnlock()
+	return calls
+}
+
+// FindQuarantined calls FindQuarantinedFunc.
+func (mock *fileRepositoryMock) FindQuarantined(ctx context.Context, limit int) ([]*ent.File, error) {
+	if mock.FindQuarantinedFunc == nil {
+		panic("fileRepositoryMock.FindQuarantinedFunc: method is nil but fileRepository.FindQuarantined was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockFindQuarantined.Lock()
+	mock.calls.FindQuarantined = append(mock.calls.FindQuarantined, callInfo)
+	mock.lockFindQuarantined.Unlock()
+	return mock.FindQuarantinedFunc(ctx, limit)
+}
+
+// FindQuarantinedCalls gets all the calls that were made to FindQuarantined.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindQuarantinedCalls())
+func (mock *fileRepositoryMock) FindQuarantinedCalls() []struct {
+	Ctx   context.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+	}
+	mock.lockFindQuarantined.RLock()
+	calls = mock.calls.FindQuarantined
+	mock.lockFindQuarantined.RUnlock()
+	return calls
+}
+
+// FindVersion calls FindVersionFunc.
+func (mock *fileRepositoryMock) FindVersion(ctx context.Context, logicalPath string, version int) (*ent.File, error) {
+	if mock.FindVersionFunc == nil {
+		panic("fileRepositoryMock.FindVersionFunc: method is nil but fileRepository.FindVersion was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		LogicalPath string
+		Version     int
+	}{
+		Ctx:         ctx,
+		LogicalPath: logicalPath,
+		Version:     version,
+	}
+	mock.lockFindVersion.Lock()
+	mock.calls.FindVersion = append(mock.calls.FindVersion, callInfo)
+	mock.lockFindVersion.Unlock()
+	return mock.FindVersionFunc(ctx, logicalPath, version)
+}
+
+// FindVersionCalls gets all the calls that were made to FindVersion.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindVersionCalls())
+func (mock *fileRepositoryMock) FindVersionCalls() []struct {
+	Ctx         context.Context
+	LogicalPath string
+	Version     int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		LogicalPath string
+		Version     int
+	}
+	mock.lockFindVersion.RLock()
+	calls = mock.calls.FindVersion
+	mock.lockFindVersion.RUnlock()
+	return calls
+}
+
+// FindVersions calls FindVersionsFunc.
+func (mock *fileRepositoryMock) FindVersions(ctx context.Context, logicalPath string) ([]*ent.File, error) {
+	if mock.FindVersionsFunc == nil {
+		panic("fileRepositoryMock.FindVersionsFunc: method is nil but fileRepository.FindVersions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		LogicalPath string
+	}{
+		Ctx:         ctx,
+		LogicalPath: logicalPath,
+	}
+	mock.lockFindVersions.Lock()
+	mock.calls.FindVersions = append(mock.calls.FindVersions, callInfo)
+	mock.lockFindVersions.Unlock()
+	return mock.FindVersionsFunc(ctx, logicalPath)
+}
+
+// FindVersionsCalls gets all the calls that were made to FindVersions.
+// Check the length with:
+//
+//	len(mockedfileRepository.FindVersionsCalls())
+func (mock *fileRepositoryMock) FindVersionsCalls() []struct {
+	Ctx         context.Context
+	LogicalPath string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		LogicalPath string
+	}
+	mock.lockFindVersions.RLock()
+	calls = mock.calls.FindVersions
+	mock.lockFindVersions.RUnlock()
+	return calls
+}
+
+// HasObjectOwner calls HasObjectOwnerFunc.
+func (mock *fileRepositoryMock) HasObjectOwner(ctx context.Context, objectPath string) (bool, error) {
+	if mock.HasObjectOwnerFunc == nil {
+		panic("fileRepositoryMock.HasObjectOwnerFunc: method is nil but fileRepository.HasObjectOwner was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ObjectPath string
+	}{
+		Ctx:        ctx,
+		ObjectPath: objectPath,
+	}
+	mock.lockHasObjectOwner.Lock()
+	mock.calls.HasObjectOwner = append(mock.calls.HasObjectOwner, callInfo)
+	mock.lockHasObjectOwner.Unlock()
+	return mock.HasObjectOwnerFunc(ctx, objectPath)
+}
+
+// HasObjectOwnerCalls gets all the calls that were made to HasObjectOwner.
+// Check the length with:
+//
+//	len(mockedfileRepository.HasObjectOwnerCalls())
+func (mock *fileRepositoryMock) HasObjectOwnerCalls() []struct {
+	Ctx        context.Context
+	ObjectPath string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ObjectPath string
+	}
+	mock.lockHasObjectOwner.RLock()
+	calls = mock.calls.HasObjectOwner
+	mock.lockHasObjectOwner.RUnlock()
+	return calls
+}
+
+// Purge calls PurgeFunc.
+func (mock *fileRepositoryMock) Purge(ctx context.Context, uid string) error {
+	if mock.PurgeFunc == nil {
+		panic("fileRepositoryMock.PurgeFunc: method is nil but fileRepository.Purge was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockPurge.Lock()
+	mock.calls.Purge = append(mock.calls.Purge, callInfo)
+	mock.lockPurge.Unlock()
+	return mock.PurgeFunc(ctx, uid)
+}
+
+// PurgeCalls gets all the calls that were made to Purge.
+// Check the length with:
+//
+//	len(mockedfileRepository.PurgeCalls())
+func (mock *fileRepositoryMock) PurgeCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockPurge.RLock()
+	calls = mock.calls.Purge
+	mock.lockPurge.RUnlock()
+	return calls
+}
+
+// Restore calls RestoreFunc.
+func (mock *fileRepositoryMock) Restore(ctx context.Context, uid string) error {
+	if mock.RestoreFunc == nil {
+		panic("fileRepositoryMock.RestoreFunc: method is nil but fileRepository.Restore was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockRestore.Lock()
+	mock.calls.Restore = append(mock.calls.Restore, callInfo)
+	mock.lockRestore.Unlock()
+	return mock.RestoreFunc(ctx, uid)
+}
+
+// RestoreCalls gets all the calls that were made to Restore.
+// Check the length with:
+//
+//	len(mockedfileRepository.RestoreCalls())
+func (mock *fileRepositoryMock) RestoreCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
 	}
 	mock.lockRestore.RLock()
 	calls = mock.calls.Restore
 	mock.lockRestore.RUnlock()
 	return calls
 }
+
+// SaveScanResult calls SaveScanResultFunc.
+func (mock *fileRepositoryMock) SaveScanResult(ctx context.Context, uid string, status fileStatus.ScanStatus, result string, scannedAt time.Time) error {
+	if mock.SaveScanResultFunc == nil {
+		panic("fileRepositoryMock.SaveScanResultFunc: method is nil but fileRepository.SaveScanResult was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UID       string
+		Status    fileStatus.ScanStatus
+		Result    string
+		ScannedAt time.Time
+	}{
+		Ctx:       ctx,
+		UID:       uid,
+		Status:    status,
+		Result:    result,
+		ScannedAt: scannedAt,
+	}
+	mock.lockSaveScanResult.Lock()
+	mock.calls.SaveScanResult = append(mock.calls.SaveScanResult, callInfo)
+	mock.lockSaveScanResult.Unlock()
+	return mock.SaveScanResultFunc(ctx, uid, status, result, scannedAt)
+}
+
+// SaveScanResultCalls gets all the calls that were made to SaveScanResult.
+// Check the length with:
+//
+//	len(mockedfileRepository.SaveScanResultCalls())
+func (mock *fileRepositoryMock) SaveScanResultCalls() []struct {
+	Ctx       context.Context
+	UID       string
+	Status    fileStatus.ScanStatus
+	Result    string
+	ScannedAt time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UID       string
+		Status    fileStatus.ScanStatus
+		Result    string
+		ScannedAt time.Time
+	}
+	mock.lockSaveScanResult.RLock()
+	calls = mock.calls.SaveScanResult
+	mock.lockSaveScanResult.RUnlock()
+	return calls
+}
+
+// UpdateObjectInfo calls UpdateObjectInfoFunc.
+func (mock *fileRepositoryMock) UpdateObjectInfo(ctx context.Context, uid string, size int, etag string, lastModified time.Time) error {
+	if mock.UpdateObjectInfoFunc == nil {
+		panic("fileRepositoryMock.UpdateObjectInfoFunc: method is nil but fileRepository.UpdateObjectInfo was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UID          string
+		Size         int
+		Etag         string
+		LastModified time.Time
+	}{
+		Ctx:          ctx,
+		UID:          uid,
+		Size:         size,
+		Etag:         etag,
+		LastModified: lastModified,
+	}
+	mock.lockUpdateObjectInfo.Lock()
+	mock.calls.UpdateObjectInfo = append(mock.calls.UpdateObjectInfo, callInfo)
+	mock.lockUpdateObjectInfo.Unlock()
+	return mock.UpdateObjectInfoFunc(ctx, uid, size, etag, lastModified)
+}
+
+// UpdateObjectInfoCalls gets all the calls that were made to UpdateObjectInfo.
+// Check the length with:
+//
+//	len(mockedfileRepository.UpdateObjectInfoCalls())
+func (mock *fileRepositoryMock) UpdateObjectInfoCalls() []struct {
+	Ctx          context.Context
+	UID          string
+	Size         int
+	Etag         string
+	LastModified time.Time
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UID          string
+		Size         int
+		Etag         string
+		LastModified time.Time
+	}
+	mock.lockUpdateObjectInfo.RLock()
+	calls = mock.calls.UpdateObjectInfo
+	mock.lockUpdateObjectInfo.RUnlock()
+	return calls
+}
+
+// UpdateObjectPath calls UpdateObjectPathFunc.
+func (mock *fileRepositoryMock) UpdateObjectPath(ctx context.Context, uid string, from string, to string) error {
+	if mock.UpdateObjectPathFunc == nil {
+		panic("fileRepositoryMock.UpdateObjectPathFunc: method is nil but fileRepository.UpdateObjectPath was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UID  string
+		From string
+		To   string
+	}{
+		Ctx:  ctx,
+		UID:  uid,
+		From: from,
+		To:   to,
+	}
+	mock.lockUpdateObjectPath.Lock()
+	mock.calls.UpdateObjectPath = append(mock.calls.UpdateObjectPath, callInfo)
+	mock.lockUpdateObjectPath.Unlock()
+	return mock.UpdateObjectPathFunc(ctx, uid, from, to)
+}
+
+// UpdateObjectPathCalls gets all the calls that were made to UpdateObjectPath.
+// Check the length with:
+//
+//	len(mockedfileRepository.UpdateObjectPathCalls())
+func (mock *fileRepositoryMock) UpdateObjectPathCalls() []struct {
+	Ctx  context.Context
+	UID  string
+	From string
+	To   string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UID  string
+		From string
+		To   string
+	}
+	mock.lockUpdateObjectPath.RLock()
+	calls = mock.calls.UpdateObjectPath
+	mock.lockUpdateObjectPath.RUnlock()
+	return calls
+}
+
+// Usage calls UsageFunc.
+func (mock *fileRepositoryMock) Usage(ctx context.Context, userID int) (int64, int, error) {
+	if mock.UsageFunc == nil {
+		panic("fileRepositoryMock.UsageFunc: method is nil but fileRepository.Usage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID int
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockUsage.Lock()
+	mock.calls.Usage = append(mock.calls.Usage, callInfo)
+	mock.lockUsage.Unlock()
+	return mock.UsageFunc(ctx, userID)
+}
+
+// UsageCalls gets all the calls that were made to Usage.
+// Check the length with:
+//
+//	len(mockedfileRepository.UsageCalls())
+func (mock *fileRepositoryMock) UsageCalls() []struct {
+	Ctx    context.Context
+	UserID int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID int
+	}
+	mock.lockUsage.RLock()
+	calls = mock.calls.Usage
+	mock.lockUsage.RUnlock()
+	return calls
+}
+
+// Ensure, that multipartRepositoryMock does implement multipartRepository.
+// If this is not the case, regenerate this file with moq.
+var _ multipartRepository = &multipartRepositoryMock{}
+
+// multipartRepositoryMock is a mock implementation of multipartRepository.
+//
+//	func TestSomethingThatUsesmultipartRepository(t *testing.T) {
+//
+//		// make and configure a mocked multipartRepository
+//		mockedmultipartRepository := &multipartRepositoryMock{
+//			AbortFunc: func(ctx context.Context, id int) error {
+//				panic("mock out the Abort method")
+//			},
+//			CompleteFunc: func(ctx context.Context, id int, fileUID uuid.UUID) error {
+//				panic("mock out the Complete method")
+//			},
+//			CreateFunc: func(ctx context.Context, upload *ent.Multipart) (*ent.Multipart, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeletePartFunc: func(ctx context.Context, multipartID int, partNumber int) error {
+//				panic("mock out the DeletePart method")
+//			},
+//			FindByUIDFunc: func(ctx context.Context, uid string) (*ent.Multipart, error) {
+//				panic("mock out the FindByUID method")
+//			},
+//			FindPartsFunc: func(ctx context.Context, multipartID int) ([]*ent.MultipartPart, error) {
+//				panic("mock out the FindParts method")
+//			},
+//			LockFunc: func(ctx context.Context, id int, lockUID uuid.UUID, now time.Time, until time.Time) (bool, error) {
+//				panic("mock out the Lock method")
+//			},
+//			SavePartFunc: func(ctx context.Context, part *ent.MultipartPart) (*ent.MultipartPart, error) {
+//				panic("mock out the SavePart method")
+//			},
+//			SetDetectedMimeTypeFunc: func(ctx context.Context, id int, detectedMimeType string) error {
+//				panic("mock out the SetDetectedMimeType method")
+//			},
+//			UnlockFunc: func(ctx context.Context, id int, lockUID uuid.UUID) error {
+//				panic("mock out the Unlock method")
+//			},
+//		}
+//
+//		// use mockedmultipartRepository in code that requires multipartRepository
+//		// and then make assertions.
+//
+//	}
+type multipartRepositoryMock struct {
+	// AbortFunc mocks the Abort method.
+	AbortFunc func(ctx context.Context, id int) error
+
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(ctx context.Context, id int, fileUID uuid.UUID) error
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, upload *ent.Multipart) (*ent.Multipart, error)
+
+	// DeletePartFunc mocks the DeletePart method.
+	DeletePartFunc func(ctx context.Context, multipartID int, partNumber int) error
+
+	// FindByUIDFunc mocks the FindByUID method.
+	FindByUIDFunc func(ctx context.Context, uid string) (*ent.Multipart, error)
+
+	// FindPartsFunc mocks the FindParts method.
+	FindPartsFunc func(ctx context.Context, multipartID int) ([]*ent.MultipartPart, error)
+
+	// LockFunc mocks the Lock method.
+	LockFunc func(ctx context.Context, id int, lockUID uuid.UUID, now time.Time, until time.Time) (bool, error)
+
+	// SavePartFunc mocks the SavePart method.
+	SavePartFunc func(ctx context.Context, part *ent.MultipartPart) (*ent.MultipartPart, error)
+
+	// SetDetectedMimeTypeFunc mocks the SetDetectedMimeType method.
+	SetDetectedMimeTypeFunc func(ctx context.Context, id int, detectedMimeType string) error
+
+	// UnlockFunc mocks the Unlock method.
+	UnlockFunc func(ctx context.Context, id int, lockUID uuid.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Abort holds details about calls to the Abort method.
+		Abort []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+		}
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// FileUID is the fileUID argument value.
+			FileUID uuid.UUID
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Upload is the upload argument value.
+			Upload *ent.Multipart
+		}
+		// DeletePart holds details about calls to the DeletePart method.
+		DeletePart []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// MultipartID is the multipartID argument value.
+			MultipartID int
+			// PartNumber is the partNumber argument value.
+			PartNumber int
+		}
+		// FindByUID holds details about calls to the FindByUID method.
+		FindByUID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// FindParts holds details about calls to the FindParts method.
+		FindParts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// MultipartID is the multipartID argument value.
+			MultipartID int
+		}
+		// Lock holds details about calls to the Lock method.
+		Lock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// LockUID is the lockUID argument value.
+			LockUID uuid.UUID
+			// Now is the now argument value.
+			Now time.Time
+			// Until is the until argument value.
+			Until time.Time
+		}
+		// SavePart holds details about calls to the SavePart method.
+		SavePart []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Part is the part argument value.
+			Part *ent.MultipartPart
+		}
+		// SetDetectedMimeType holds details about calls to the SetDetectedMimeType method.
+		SetDetectedMimeType []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// DetectedMimeType is the detectedMimeType argument value.
+			DetectedMimeType string
+		}
+		// Unlock holds details about calls to the Unlock method.
+		Unlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// LockUID is the lockUID argument value.
+			LockUID uuid.UUID
+		}
+	}
+	lockAbort               sync.RWMutex
+	lockComplete            sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockDeletePart          sync.RWMutex
+	lockFindByUID           sync.RWMutex
+	lockFindParts           sync.RWMutex
+	lockLock                sync.RWMutex
+	lockSavePart            sync.RWMutex
+	lockSetDetectedMimeType sync.RWMutex
+	lockUnlock              sync.RWMutex
+}
+
+// Abort calls AbortFunc.
+func (mock *multipartRepositoryMock) Abort(ctx context.Context, id int) error {
+	if mock.AbortFunc == nil {
+		panic("multipartRepositoryMock.AbortFunc: method is nil but multipartRepository.Abort was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  int
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockAbort.Lock()
+	mock.calls.Abort = append(mock.calls.Abort, callInfo)
+	mock.lockAbort.Unlock()
+	return mock.AbortFunc(ctx, id)
+}
+
+// AbortCalls gets all the calls that were made to Abort.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.AbortCalls())
+func (mock *multipartRepositoryMock) AbortCalls() []struct {
+	Ctx context.Context
+	ID  int
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  int
+	}
+	mock.lockAbort.RLock()
+	calls = mock.calls.Abort
+	mock.lockAbort.RUnlock()
+	return calls
+}
+
+// Complete calls CompleteFunc.
+func (mock *multipartRepositoryMock) Complete(ctx context.Context, id int, fileUID uuid.UUID) error {
+	if mock.CompleteFunc == nil {
+		panic("multipartRepositoryMock.CompleteFunc: method is nil but multipartRepository.Complete was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      int
+		FileUID uuid.UUID
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		FileUID: fileUID,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(ctx, id, fileUID)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.CompleteCalls())
+func (mock *multipartRepositoryMock) CompleteCalls() []struct {
+	Ctx     context.Context
+	ID      int
+	FileUID uuid.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      int
+		FileUID uuid.UUID
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *multipartRepositoryMock) Create(ctx context.Context, upload *ent.Multipart) (*ent.Multipart, error) {
+	if mock.CreateFunc == nil {
+		panic("multipartRepositoryMock.CreateFunc: method is nil but multipartRepository.Create was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Upload *ent.Multipart
+	}{
+		Ctx:    ctx,
+		Upload: upload,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, upload)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.CreateCalls())
+func (mock *multipartRepositoryMock) CreateCalls() []struct {
+	Ctx    context.Context
+	Upload *ent.Multipart
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Upload *ent.Multipart
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// DeletePart calls DeletePartFunc.
+func (mock *multipartRepositoryMock) DeletePart(ctx context.Context, multipartID int, partNumber int) error {
+	if mock.DeletePartFunc == nil {
+		panic("multipartRepositoryMock.DeletePartFunc: method is nil but multipartRepository.DeletePart was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		MultipartID int
+		PartNumber  int
+	}{
+		Ctx:         ctx,
+		MultipartID: multipartID,
+		PartNumber:  partNumber,
+	}
+	mock.lockDeletePart.Lock()
+	mock.calls.DeletePart = append(mock.calls.DeletePart, callInfo)
+	mock.lockDeletePart.Unlock()
+	return mock.DeletePartFunc(ctx, multipartID, partNumber)
+}
+
+// DeletePartCalls gets all the calls that were made to DeletePart.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.DeletePartCalls())
+func (mock *multipartRepositoryMock) DeletePartCalls() []struct {
+	Ctx         context.Context
+	MultipartID int
+	PartNumber  int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		MultipartID int
+		PartNumber  int
+	}
+	mock.lockDeletePart.RLock()
+	calls = mock.calls.DeletePart
+	mock.lockDeletePart.RUnlock()
+	return calls
+}
+
+// FindByUID calls FindByUIDFunc.
+func (mock *multipartRepositoryMock) FindByUID(ctx context.Context, uid string) (*ent.Multipart, error) {
+	if mock.FindByUIDFunc == nil {
+		panic("multipartRepositoryMock.FindByUIDFunc: method is nil but multipartRepository.FindByUID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockFindByUID.Lock()
+	mock.calls.FindByUID = append(mock.calls.FindByUID, callInfo)
+	mock.lockFindByUID.Unlock()
+	return mock.FindByUIDFunc(ctx, uid)
+}
+
+// FindByUIDCalls gets all the calls that were made to FindByUID.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.FindByUIDCalls())
+func (mock *multipartRepositoryMock) FindByUIDCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockFindByUID.RLock()
+	calls = mock.calls.FindByUID
+	mock.lockFindByUID.RUnlock()
+	return calls
+}
+
+// FindParts calls FindPartsFunc.
+func (mock *multipartRepositoryMock) FindParts(ctx context.Context, multipartID int) ([]*ent.MultipartPart, error) {
+	if mock.FindPartsFunc == nil {
+		panic("multipartRepositoryMock.FindPartsFunc: method is nil but multipartRepository.FindParts was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		MultipartID int
+	}{
+		Ctx:         ctx,
+		MultipartID: multipartID,
+	}
+	mock.lockFindParts.Lock()
+	mock.calls.FindParts = append(mock.calls.FindParts, callInfo)
+	mock.lockFindParts.Unlock()
+	return mock.FindPartsFunc(ctx, multipartID)
+}
+
+// FindPartsCalls gets all the calls that were made to FindParts.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.FindPartsCalls())
+func (mock *multipartRepositoryMock) FindPartsCalls() []struct {
+	Ctx         context.Context
+	MultipartID int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		MultipartID int
+	}
+	mock.lockFindParts.RLock()
+	calls = mock.calls.FindParts
+	mock.lockFindParts.RUnlock()
+	return calls
+}
+
+// Lock calls LockFunc.
+func (mock *multipartRepositoryMock) Lock(ctx context.Context, id int, lockUID uuid.UUID, now time.Time, until time.Time) (bool, error) {
+	if mock.LockFunc == nil {
+		panic("multipartRepositoryMock.LockFunc: method is nil but multipartRepository.Lock was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      int
+		LockUID uuid.UUID
+		Now     time.Time
+		Until   time.Time
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		LockUID: lockUID,
+		Now:     now,
+		Until:   until,
+	}
+	mock.lockLock.Lock()
+	mock.calls.Lock = append(mock.calls.Lock, callInfo)
+	mock.lockLock.Unlock()
+	return mock.LockFunc(ctx, id, lockUID, now, until)
+}
+
+// LockCalls gets all the calls that were made to Lock.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.LockCalls())
+func (mock *multipartRepositoryMock) LockCalls() []struct {
+	Ctx     context.Context
+	ID      int
+	LockUID uuid.UUID
+	Now     time.Time
+	Until   time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      int
+		LockUID uuid.UUID
+		Now     time.Time
+		Until   time.Time
+	}
+	mock.lockLock.RLock()
+	calls = mock.calls.Lock
+	mock.lockLock.RUnlock()
+	return calls
+}
+
+// SavePart calls SavePartFunc.
+func (mock *multipartRepositoryMock) SavePart(ctx context.Context, part *ent.MultipartPart) (*ent.MultipartPart, error) {
+	if mock.SavePartFunc == nil {
+		panic("multipartRepositoryMock.SavePartFunc: method is nil but multipartRepository.SavePart was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Part *ent.MultipartPart
+	}{
+		Ctx:  ctx,
+		Part: part,
+	}
+	mock.lockSavePart.Lock()
+	mock.calls.SavePart = append(mock.calls.SavePart, callInfo)
+	mock.lockSavePart.Unlock()
+	return mock.SavePartFunc(ctx, part)
+}
+
+// SavePartCalls gets all the calls that were made to SavePart.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.SavePartCalls())
+func (mock *multipartRepositoryMock) SavePartCalls() []struct {
+	Ctx  context.Context
+	Part *ent.MultipartPart
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Part *ent.MultipartPart
+	}
+	mock.lockSavePart.RLock()
+	calls = mock.calls.SavePart
+	mock.lockSavePart.RUnlock()
+	return calls
+}
+
+// SetDetectedMimeType calls SetDetectedMimeTypeFunc.
+func (mock *multipartRepositoryMock) SetDetectedMimeType(ctx context.Context, id int, detectedMimeType string) error {
+	if mock.SetDetectedMimeTypeFunc == nil {
+		panic("multipartRepositoryMock.SetDetectedMimeTypeFunc: method is nil but multipartRepository.SetDetectedMimeType was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		ID               int
+		DetectedMimeType string
+	}{
+		Ctx:              ctx,
+		ID:               id,
+		DetectedMimeType: detectedMimeType,
+	}
+	mock.lockSetDetectedMimeType.Lock()
+	mock.calls.SetDetectedMimeType = append(mock.calls.SetDetectedMimeType, callInfo)
+	mock.lockSetDetectedMimeType.Unlock()
+	return mock.SetDetectedMimeTypeFunc(ctx, id, detectedMimeType)
+}
+
+// SetDetectedMimeTypeCalls gets all the calls that were made to SetDetectedMimeType.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.SetDetectedMimeTypeCalls())
+func (mock *multipartRepositoryMock) SetDetectedMimeTypeCalls() []struct {
+	Ctx              context.Context
+	ID               int
+	DetectedMimeType string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		ID               int
+		DetectedMimeType string
+	}
+	mock.lockSetDetectedMimeType.RLock()
+	calls = mock.calls.SetDetectedMimeType
+	mock.lockSetDetectedMimeType.RUnlock()
+	return calls
+}
+
+// Unlock calls UnlockFunc.
+func (mock *multipartRepositoryMock) Unlock(ctx context.Context, id int, lockUID uuid.UUID) error {
+	if mock.UnlockFunc == nil {
+		panic("multipartRepositoryMock.UnlockFunc: method is nil but multipartRepository.Unlock was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      int
+		LockUID uuid.UUID
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		LockUID: lockUID,
+	}
+	mock.lockUnlock.Lock()
+	mock.calls.Unlock = append(mock.calls.Unlock, callInfo)
+	mock.lockUnlock.Unlock()
+	return mock.UnlockFunc(ctx, id, lockUID)
+}
+
+// UnlockCalls gets all the calls that were made to Unlock.
+// Check the length with:
+//
+//	len(mockedmultipartRepository.UnlockCalls())
+func (mock *multipartRepositoryMock) UnlockCalls() []struct {
+	Ctx     context.Context
+	ID      int
+	LockUID uuid.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      int
+		LockUID uuid.UUID
+	}
+	mock.lockUnlock.RLock()
+	calls = mock.calls.Unlock
+	mock.lockUnlock.RUnlock()
+	return calls
+}
+
+// Ensure, that blobRepositoryMock does implement blobRepository.
+// If this is not the case, regenerate this file with moq.
+var _ blobRepository = &blobRepositoryMock{}
+
+// blobRepositoryMock is a mock implementation of blobRepository.
+//
+//	func TestSomethingThatUsesblobRepository(t *testing.T) {
+//
+//		// make and configure a mocked blobRepository
+//		mockedblobRepository := &blobRepositoryMock{
+//			AcquireFunc: func(ctx context.Context, blob *ent.Blob) (*ent.Blob, error) {
+//				panic("mock out the Acquire method")
+//			},
+//			FindObjectPathsFunc: func(ctx context.Context) ([]string, error) {
+//				panic("mock out the FindObjectPaths method")
+//			},
+//			ReferenceFunc: func(ctx context.Context, sha256 string) (*ent.Blob, error) {
+//				panic("mock out the Reference method")
+//			},
+//			ReleaseFunc: func(ctx context.Context, id int) (bool, error) {
+//				panic("mock out the Release method")
+//			},
+//			UpdateObjectPathFunc: func(ctx context.Context, id int, from string, to string) error {
+//				panic("mock out the UpdateObjectPath method")
+//			},
+//		}
+//
+//		// use mockedblobRepository in code that requires blobRepository
+//		// and then make assertions.
+//
+//	}
+type blobRepositoryMock struct {
+	// AcquireFunc mocks the Acquire method.
+	AcquireFunc func(ctx context.Context, blob *ent.Blob) (*ent.Blob, error)
+
+	// FindObjectPathsFunc mocks the FindObjectPaths method.
+	FindObjectPathsFunc func(ctx context.Context) ([]string, error)
+
+	// ReferenceFunc mocks the Reference method.
+	ReferenceFunc func(ctx context.Context, sha256 string) (*ent.Blob, error)
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(ctx context.Context, id int) (bool, error)
+
+	// UpdateObjectPathFunc mocks the UpdateObjectPath method.
+	UpdateObjectPathFunc func(ctx context.Context, id int, from string, to string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Acquire holds details about calls to the Acquire method.
+		Acquire []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Blob is the blob argument value.
+			Blob *ent.Blob
+		}
+		// FindObjectPaths holds details about calls to the FindObjectPaths method.
+		FindObjectPaths []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Reference holds details about calls to the Reference method.
+		Reference []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sha256 is the sha256 argument value.
+			Sha256 string
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+		}
+		// UpdateObjectPath holds details about calls to the UpdateObjectPath method.
+		UpdateObjectPath []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// From is the from argument value.
+			From string
+			// To is the to argument value.
+			To string
+		}
+	}
+	lockAcquire          sync.RWMutex
+	lockFindObjectPaths  sync.RWMutex
+	lockReference        sync.RWMutex
+	lockRelease          sync.RWMutex
+	lockUpdateObjectPath sync.RWMutex
+}
+
+// Acquire calls AcquireFunc.
+func (mock *blobRepositoryMock) Acquire(ctx context.Context, blob *ent.Blob) (*ent.Blob, error) {
+	if mock.AcquireFunc == nil {
+		panic("blobRepositoryMock.AcquireFunc: method is nil but blobRepository.Acquire was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Blob *ent.Blob
+	}{
+		Ctx:  ctx,
+		Blob: blob,
+	}
+	mock.lockAcquire.Lock()
+	mock.calls.Acquire = append(mock.calls.Acquire, callInfo)
+	mock.lockAcquire.Unlock()
+	return mock.AcquireFunc(ctx, blob)
+}
+
+// AcquireCalls gets all the calls that were made to Acquire.
+// Check the length with:
+//
+//	len(mockedblobRepository.AcquireCalls())
+func (mock *blobRepositoryMock) AcquireCalls() []struct {
+	Ctx  context.Context
+	Blob *ent.Blob
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Blob *ent.Blob
+	}
+	mock.lockAcquire.RLock()
+	calls = mock.calls.Acquire
+	mock.lockAcquire.RUnlock()
+	return calls
+}
+
+// FindObjectPaths calls FindObjectPathsFunc.
+func (mock *blobRepositoryMock) FindObjectPaths(ctx context.Context) ([]string, error) {
+	if mock.FindObjectPathsFunc == nil {
+		panic("blobRepositoryMock.FindObjectPathsFunc: method is nil but blobRepository.FindObjectPaths was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFindObjectPaths.Lock()
+	mock.calls.FindObjectPaths = append(mock.calls.FindObjectPaths, callInfo)
+	mock.lockFindObjectPaths.Unlock()
+	return mock.FindObjectPathsFunc(ctx)
+}
+
+// FindObjectPathsCalls gets all the calls that were made to FindObjectPaths.
+// Check the length with:
+//
+//	len(mockedblobRepository.FindObjectPathsCalls())
+func (mock *blobRepositoryMock) FindObjectPathsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFindObjectPaths.RLock()
+	calls = mock.calls.FindObjectPaths
+	mock.lockFindObjectPaths.RUnlock()
+	return calls
+}
+
+// Reference calls ReferenceFunc.
+func (mock *blobRepositoryMock) Reference(ctx context.Context, sha256 string) (*ent.Blob, error) {
+	if mock.ReferenceFunc == nil {
+		panic("blobRepositoryMock.ReferenceFunc: method is nil but blobRepository.Reference was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Sha256 string
+	}{
+		Ctx:    ctx,
+		Sha256: sha256,
+	}
+	mock.lockReference.Lock()
+	mock.calls.Reference = append(mock.calls.Reference, callInfo)
+	mock.lockReference.Unlock()
+	return mock.ReferenceFunc(ctx, sha256)
+}
+
+// ReferenceCalls gets all the calls that were made to Reference.
+// Check the length with:
+//
+//	len(mockedblobRepository.ReferenceCalls())
+func (mock *blobRepositoryMock) ReferenceCalls() []struct {
+	Ctx    context.Context
+	Sha256 string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Sha256 string
+	}
+	mock.lockReference.RLock()
+	calls = mock.calls.Reference
+	mock.lockReference.RUnlock()
+	return calls
+}
+
+// Release calls ReleaseFunc.
+func (mock *blobRepositoryMock) Release(ctx context.Context, id int) (bool, error) {
+	if mock.ReleaseFunc == nil {
+		panic("blobRepositoryMock.ReleaseFunc: method is nil but blobRepository.Release was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  int
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(ctx, id)
+}
+
+// ReleaseCalls gets all the calls that were made to Release.
+// Check the length with:
+//
+//	len(mockedblobRepository.ReleaseCalls())
+func (mock *blobRepositoryMock) ReleaseCalls() []struct {
+	Ctx context.Context
+	ID  int
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  int
+	}
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
+	return calls
+}
+
+// UpdateObjectPath calls UpdateObjectPathFunc.
+func (mock *blobRepositoryMock) UpdateObjectPath(ctx context.Context, id int, from string, to string) error {
+	if mock.UpdateObjectPathFunc == nil {
+		panic("blobRepositoryMock.UpdateObjectPathFunc: method is nil but blobRepository.UpdateObjectPath was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   int
+		From string
+		To   string
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		From: from,
+		To:   to,
+	}
+	mock.lockUpdateObjectPath.Lock()
+	mock.calls.UpdateObjectPath = append(mock.calls.UpdateObjectPath, callInfo)
+	mock.lockUpdateObjectPath.Unlock()
+	return mock.UpdateObjectPathFunc(ctx, id, from, to)
+}
+
+// UpdateObjectPathCalls gets all the calls that were made to UpdateObjectPath.
+// Check the length with:
+//
+//	len(mockedblobRepository.UpdateObjectPathCalls())
+func (mock *blobRepositoryMock) UpdateObjectPathCalls() []struct {
+	Ctx  context.Context
+	ID   int
+	From string
+	To   string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   int
+		From string
+		To   string
+	}
+	mock.lockUpdateObjectPath.RLock()
+	calls = mock.calls.UpdateObjectPath
+	mock.lockUpdateObjectPath.RUnlock()
+	return calls
+}
+
+// Ensure, that shareLinkRepositoryMock does implement shareLinkRepository.
+// If this is not the case, regenerate this file with moq.
+var _ shareLinkRepository = &shareLinkRepositoryMock{}
+
+// shareLinkRepositoryMock is a mock implementation of shareLinkRepository.
+//
+//	func TestSomethingThatUsesshareLinkRepository(t *testing.T) {
+//
+//		// make and configure a mocked shareLinkRepository
+//		mockedshareLinkRepository := &shareLinkRepositoryMock{
+//			CountDownloadFunc: func(ctx context.Context, id int, now time.Time) (bool, error) {
+//				panic("mock out the CountDownload method")
+//			},
+//			CreateFunc: func(ctx context.Context, link *ent.ShareLink) (*ent.ShareLink, error) {
+//				panic("mock out the Create method")
+//			},
+//			FindByFileIDFunc: func(ctx context.Context, fileID int) ([]*ent.ShareLink, error) {
+//				panic("mock out the FindByFileID method")
+//			},
+//			FindByTokenFunc: func(ctx context.Context, token string) (*ent.ShareLink, error) {
+//				panic("mock out the FindByToken method")
+//			},
+//			FindByUIDFunc: func(ctx context.Context, uid string) (*ent.ShareLink, error) {
+//				panic("mock out the FindByUID method")
+//			},
+//			RevokeFunc: func(ctx context.Context, uid string) (bool, error) {
+//				panic("mock out the Revoke method")
+//			},
+//			UncountDownloadFunc: func(ctx context.Context, id int) error {
+//				panic("mock out the UncountDownload method")
+//			},
+//		}
+//
+//		// use mockedshareLinkRepository in code that requires shareLinkRepository
+//		// and then make assertions.
+//
+//	}
+type shareLinkRepositoryMock struct {
+	// CountDownloadFunc mocks the CountDownload method.
+	CountDownloadFunc func(ctx context.Context, id int, now time.Time) (bool, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, link *ent.ShareLink) (*ent.ShareLink, error)
+
+	// FindByFileIDFunc mocks the FindByFileID method.
+	FindByFileIDFunc func(ctx context.Context, fileID int) ([]*ent.ShareLink, error)
+
+	// FindByTokenFunc mocks the FindByToken method.
+	FindByTokenFunc func(ctx context.Context, token string) (*ent.ShareLink, error)
+
+	// FindByUIDFunc mocks the FindByUID method.
+	FindByUIDFunc func(ctx context.Context, uid string) (*ent.ShareLink, error)
+
+	// RevokeFunc mocks the Revoke method.
+	RevokeFunc func(ctx context.Context, uid string) (bool, error)
+
+	// UncountDownloadFunc mocks the UncountDownload method.
+	UncountDownloadFunc func(ctx context.Context, id int) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CountDownload holds details about calls to the CountDownload method.
+		CountDownload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Link is the link argument value.
+			Link *ent.ShareLink
+		}
+		// FindByFileID holds details about calls to the FindByFileID method.
+		FindByFileID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID int
+		}
+		// FindByToken holds details about calls to the FindByToken method.
+		FindByToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// FindByUID holds details about calls to the FindByUID method.
+		FindByUID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// Revoke holds details about calls to the Revoke method.
+		Revoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID string
+		}
+		// UncountDownload holds details about calls to the UncountDownload method.
+		UncountDownload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int
+		}
+	}
+	lockCountDownload   sync.RWMutex
+	lockCreate          sync.RWMutex
+	lockFindByFileID    sync.RWMutex
+	lockFindByToken     sync.RWMutex
+	lockFindByUID       sync.RWMutex
+	lockRevoke          sync.RWMutex
+	lockUncountDownload sync.RWMutex
+}
+
+// CountDownload calls CountDownloadFunc.
+func (mock *shareLinkRepositoryMock) CountDownload(ctx context.Context, id int, now time.Time) (bool, error) {
+	if mock.CountDownloadFunc == nil {
+		panic("shareLinkRepositoryMock.CountDownloadFunc: method is nil but shareLinkRepository.CountDownload was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  int
+		Now time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Now: now,
+	}
+	mock.lockCountDownload.Lock()
+	mock.calls.CountDownload = append(mock.calls.CountDownload, callInfo)
+	mock.lockCountDownload.Unlock()
+	return mock.CountDownloadFunc(ctx, id, now)
+}
+
+// CountDownloadCalls gets all the calls that were made to CountDownload.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.CountDownloadCalls())
+func (mock *shareLinkRepositoryMock) CountDownloadCalls() []struct {
+	Ctx context.Context
+	ID  int
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  int
+		Now time.Time
+	}
+	mock.lockCountDownload.RLock()
+	calls = mock.calls.CountDownload
+	mock.lockCountDownload.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *shareLinkRepositoryMock) Create(ctx context.Context, link *ent.ShareLink) (*ent.ShareLink, error) {
+	if mock.CreateFunc == nil {
+		panic("shareLinkRepositoryMock.CreateFunc: method is nil but shareLinkRepository.Create was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Link *ent.ShareLink
+	}{
+		Ctx:  ctx,
+		Link: link,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, link)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.CreateCalls())
+func (mock *shareLinkRepositoryMock) CreateCalls() []struct {
+	Ctx  context.Context
+	Link *ent.ShareLink
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Link *ent.ShareLink
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// FindByFileID calls FindByFileIDFunc.
+func (mock *shareLinkRepositoryMock) FindByFileID(ctx context.Context, fileID int) ([]*ent.ShareLink, error) {
+	if mock.FindByFileIDFunc == nil {
+		panic("shareLinkRepositoryMock.FindByFileIDFunc: method is nil but shareLinkRepository.FindByFileID was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID int
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockFindByFileID.Lock()
+	mock.calls.FindByFileID = append(mock.calls.FindByFileID, callInfo)
+	mock.lockFindByFileID.Unlock()
+	return mock.FindByFileIDFunc(ctx, fileID)
+}
+
+// FindByFileIDCalls gets all the calls that were made to FindByFileID.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.FindByFileIDCalls())
+func (mock *shareLinkRepositoryMock) FindByFileIDCalls() []struct {
+	Ctx    context.Context
+	FileID int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID int
+	}
+	mock.lockFindByFileID.RLock()
+	calls = mock.calls.FindByFileID
+	mock.lockFindByFileID.RUnlock()
+	return calls
+}
+
+// FindByToken calls FindByTokenFunc.
+func (mock *shareLinkRepositoryMock) FindByToken(ctx context.Context, token string) (*ent.ShareLink, error) {
+	if mock.FindByTokenFunc == nil {
+		panic("shareLinkRepositoryMock.FindByTokenFunc: method is nil but shareLinkRepository.FindByToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockFindByToken.Lock()
+	mock.calls.FindByToken = append(mock.calls.FindByToken, callInfo)
+	mock.lockFindByToken.Unlock()
+	return mock.FindByTokenFunc(ctx, token)
+}
+
+// FindByTokenCalls gets all the calls that were made to FindByToken.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.FindByTokenCalls())
+func (mock *shareLinkRepositoryMock) FindByTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockFindByToken.RLock()
+	calls = mock.calls.FindByToken
+	mock.lockFindByToken.RUnlock()
+	return calls
+}
+
+// FindByUID calls FindByUIDFunc.
+func (mock *shareLinkRepositoryMock) FindByUID(ctx context.Context, uid string) (*ent.ShareLink, error) {
+	if mock.FindByUIDFunc == nil {
+		panic("shareLinkRepositoryMock.FindByUIDFunc: method is nil but shareLinkRepository.FindByUID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockFindByUID.Lock()
+	mock.calls.FindByUID = append(mock.calls.FindByUID, callInfo)
+	mock.lockFindByUID.Unlock()
+	return mock.FindByUIDFunc(ctx, uid)
+}
+
+// FindByUIDCalls gets all the calls that were made to FindByUID.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.FindByUIDCalls())
+func (mock *shareLinkRepositoryMock) FindByUIDCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockFindByUID.RLock()
+	calls = mock.calls.FindByUID
+	mock.lockFindByUID.RUnlock()
+	return calls
+}
+
+// Revoke calls RevokeFunc.
+func (mock *shareLinkRepositoryMock) Revoke(ctx context.Context, uid string) (bool, error) {
+	if mock.RevokeFunc == nil {
+		panic("shareLinkRepositoryMock.RevokeFunc: method is nil but shareLinkRepository.Revoke was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID string
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockRevoke.Lock()
+	mock.calls.Revoke = append(mock.calls.Revoke, callInfo)
+	mock.lockRevoke.Unlock()
+	return mock.RevokeFunc(ctx, uid)
+}
+
+// RevokeCalls gets all the calls that were made to Revoke.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.RevokeCalls())
+func (mock *shareLinkRepositoryMock) RevokeCalls() []struct {
+	Ctx context.Context
+	UID string
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID string
+	}
+	mock.lockRevoke.RLock()
+	calls = mock.calls.Revoke
+	mock.lockRevoke.RUnlock()
+	return calls
+}
+
+// UncountDownload calls UncountDownloadFunc.
+func (mock *shareLinkRepositoryMock) UncountDownload(ctx context.Context, id int) error {
+	if mock.UncountDownloadFunc == nil {
+		panic("shareLinkRepositoryMock.UncountDownloadFunc: method is nil but shareLinkRepository.UncountDownload was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  int
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockUncountDownload.Lock()
+	mock.calls.UncountDownload = append(mock.calls.UncountDownload, callInfo)
+	mock.lockUncountDownload.Unlock()
+	return mock.UncountDownloadFunc(ctx, id)
+}
+
+// UncountDownloadCalls gets all the calls that were made to UncountDownload.
+// Check the length with:
+//
+//	len(mockedshareLinkRepository.UncountDownloadCalls())
+func (mock *shareLinkRepositoryMock) UncountDownloadCalls() []struct {
+	Ctx context.Context
+	ID  int
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  int
+	}
+	mock.lockUncountDownload.RLock()
+	calls = mock.calls.UncountDownload
+	mock.lockUncountDownload.RUnlock()
+	return calls
+}
@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"time"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+	"storage/internal/clients/minio"
+)
+
+const (
+	defaultUploadURLExpiry = time.Hour
+
+	// directStagingPrefix is a prefix of objects uploaded by presigned urls, they are not referenced by files
+	directStagingPrefix = `direct/`
+)
+
+// DirectUpload is a slot for uploading file right to s3 storage by presigned PUT url or POST policy
+type DirectUpload struct {
+	File       *ent.File
+	PutURL     string
+	PostURL    string
+	PostFields map[string]string
+	ExpiresAt  time.Time
+}
+
+// DirectUploadInitiate creates pending file and presigns urls for uploading it to s3 storage bypassing the service
+func (s *StorageUsecase) DirectUploadInitiate(
+	ctx context.Context,
+	filename string,
+	size int64,
+	visibility string,
+) (*DirectUpload, error) {
+	if size <= 0 {
+		return nil, v1.ErrorValidationFailed(`size of file must be positive`)
+	}
+	fileVisibility, err := parseVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+	userID, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := contentTypeByFilename(filename)
+	if err = s.checkUploadPolicy(role, filename, size); err != nil {
+		return nil, err
+	}
+	if err = s.checkQuota(ctx, userID, role, size, 1); err != nil {
+		return nil, err
+	}
+
+	logicalPath := makeObjectPath(userID, filename)
+	objectPath, err := s.newObjectPath(ctx, userID, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.fileRepo.Create(ctx, &ent.File{
+		UserID:      userID,
+		Filename:    filename,
+		ObjectPath:  objectPath,
+		LogicalPath: logicalPath,
+		Size:        int(size),
+		MimeType:    contentType,
+		Status:      fileStatus.StatusPending,
+		Visibility:  fileVisibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// urls lead to staging object, so client can not replace content of file after completion
+	stagingPath := directStagingPath(saved)
+	expires := s.uploadURLExpiry()
+	putURL, err := s.minioClient.PresignedPutURL(ctx, stagingPath, expires)
+	if errors.Is(err, minio.ErrPresignNotSupported) {
+		s.failFile(ctx, saved)
+		return nil, v1.ErrorValidationFailed(`direct uploads are not supported by current storage backend`)
+	}
+	if err != nil {
+		s.failFile(ctx, saved)
+		return nil, err
+	}
+	postURL, postFields, err := s.minioClient.PresignedPostPolicy(ctx, stagingPath, contentType, size, expires)
+	if err != nil {
+		s.failFile(ctx, saved)
+		return nil, err
+	}
+
+	return &DirectUpload{
+		File:       saved,
+		PutURL:     putURL.String(),
+		PostURL:    postURL.String(),
+		PostFields: postFields,
+		ExpiresAt:  time.Now().Add(expires),
+	}, nil
+}
+
+// DirectUploadComplete moves object uploaded by client from staging key to file and activates file,
+// object is checked after it is moved, so client can not replace it while it is checked,
+// object which does not match declared size or mime type or which content has another type is removed.
+// Content never passes through the service, so like completed multipart upload file has no checksums
+// and is not deduplicated
+func (s *StorageUsecase) DirectUploadComplete(ctx context.Context, uid string) (*ent.File, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.fileRepo.FindPendingByUID(ctx, uid)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if f == nil {
+		return nil, v1.ErrorNotFound(`pending file [%s] is not found`, uid)
+	}
+	if f.UserID != userID {
+		return nil, v1.ErrorAccessDenied(`file [%s] belongs to another user`, uid)
+	}
+	_, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// size is checked before the only copy, so huge object put by presigned url is not copied
+	stagingPath := directStagingPath(f)
+	info, err := s.minioClient.StatObject(ctx, stagingPath)
+	if minio.IsNotFound(err) {
+		return nil, v1.ErrorValidationFailed(`file [%s] is not uploaded to storage yet`, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Size != int64(f.Size) {
+		return nil, s.rejectDirectObject(ctx, f, stagingPath, info.Size, info.ContentType)
+	}
+	if _, err = s.minioClient.CopyObject(ctx, stagingPath, f.ObjectPath); err != nil {
+		return nil, err
+	}
+	if err = s.minioClient.Remove(ctx, stagingPath); err != nil {
+		return nil, err
+	}
+
+	info, err = s.minioClient.StatObject(ctx, f.ObjectPath)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size != int64(f.Size) || !sameMimeType(info.ContentType, f.MimeType) {
+		return nil, s.rejectDirectObject(ctx, f, f.ObjectPath, info.Size, info.ContentType)
+	}
+
+	// content bypasses the service, so its type is detected by the first bytes of uploaded object
+	detected, err := s.detectObjectType(ctx, f.ObjectPath, info.Size)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkDetectedType(role, f.MimeType, detected); err != nil {
+		if removeErr := s.minioClient.Remove(ctx, f.ObjectPath); removeErr != nil {
+			return nil, removeErr
+		}
+		return nil, err
+	}
+	f.DetectedMimeType = detected
+
+	if err = s.activateFile(ctx, f, info.Size, info.ETag, info.LastModified); err != nil {
+		return nil, err
+	}
+	s.scanStoredFile(ctx, f)
+
+	return f, nil
+}
+
+// rejectDirectObject removes object which does not match declared size or mime type, so upload may be repeated
+func (s *StorageUsecase) rejectDirectObject(
+	ctx context.Context,
+	f *ent.File,
+	objectPath string,
+	size int64,
+	contentType string,
+) error {
+	if err := s.minioClient.Remove(ctx, objectPath); err != nil {
+		return err
+	}
+	return v1.ErrorValidationFailed(
+		`uploaded object has size %d and type [%s], but expected size %d and type [%s]`,
+		size,
+		contentType,
+		f.Size,
+		f.MimeType,
+	)
+}
+
+// detectObjectType detects type of stored object by its first bytes
+func (s *StorageUsecase) detectObjectType(ctx context.Context, objectPath string, size int64) (string, error) {
+	length := int64(sniffLen)
+	if size < length {
+		length = size
+	}
+	if length <= 0 {
+		return ``, nil
+	}
+
+	head := bytes.NewBuffer(make([]byte, 0, length))
+	if err := s.minioClient.DownloadRangeToWriter(ctx, head, objectPath, 0, length); err != nil {
+		return ``, err
+	}
+	return detectContentType(head.Bytes()), nil
+}
+
+// uploadURLExpiry is limited by pending timeout, so url does not outlive file which is failed by reconciliation
+func (s *StorageUsecase) uploadURLExpiry() time.Duration {
+	expiry := defaultUploadURLExpiry
+	if configured := s.storage.GetUpload().GetUrlExpiry(); configured != nil && configured.AsDuration() > 0 {
+		expiry = configured.AsDuration()
+	}
+	if timeout := s.reconcilePendingTimeout(); expiry > timeout {
+		expiry = timeout
+	}
+	return expiry
+}
+
+// directStagingPath makes key of object which client uploads to, file gets the object only on completion
+func directStagingPath(f *ent.File) string {
+	return directStagingPrefix + f.UID.String()
+}
+
+// sameMimeType compares mime types without parameters like charset
+func sameMimeType(actual, expected string) bool {
+	actualType, _, err := mime.ParseMediaType(actual)
+	if err != nil {
+		return false
+	}
+	expectedType, _, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	return actualType == expectedType
+}
@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+
+	"storage/ent"
+	fileStatus "storage/ent/file"
+	"storage/internal/conf"
+)
+
+const (
+	uuidKeyPattern = `[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`
+	extKeyPattern  = `(\.[0-9a-z-]+)?`
+
+	keysMigrationPageSize = 1000
+)
+
+// objectKeyPatterns recognize keys made by layouts, so migration skips objects which are already in place
+var objectKeyPatterns = map[conf.Storage_Keys_Layout]*regexp.Regexp{
+	conf.Storage_Keys_slug: regexp.MustCompile(`^\d+/[^/]+$`),
+	conf.Storage_Keys_uuid: regexp.MustCompile(`^` + uuidKeyPattern + extKeyPattern + `$`),
+	conf.Storage_Keys_date: regexp.MustCompile(`^\d{4}/\d{2}/\d{2}/` + uuidKeyPattern + extKeyPattern + `$`),
+	conf.Storage_Keys_hash: regexp.MustCompile(`^[0-9a-f]{2}/[0-9a-f]{2}/` + uuidKeyPattern + extKeyPattern + `$`),
+}
+
+// slugBlobKeyPattern recognizes keys of blobs made by slug layout, they are kept by checksum instead of filename
+var slugBlobKeyPattern = regexp.MustCompile(`^` + blobsPrefix + `/[0-9a-f]{2}/[0-9a-f]{64}-` + uuidKeyPattern + `$`)
+
+// newObjectPath chooses key of object for new version of file by configured layout,
+// slug keys are made of user id and filename, other layouts make unique keys which do not reveal them
+func (s *StorageUsecase) newObjectPath(ctx context.Context, userID int, filename string) (string, error) {
+	layout := s.storage.GetKeys().GetLayout()
+	if layout == conf.Storage_Keys_slug {
+		return s.objectPathForVersion(ctx, makeObjectPath(userID, filename))
+	}
+	return makeLayoutKey(layout, filename, uuid.NewString(), time.Now()), nil
+}
+
+// makeLayoutKey makes unique key by layout: uuid keys are put in the root, date keys are sharded by day of upload,
+// hash keys are sharded by prefix of hash of uuid to spread objects evenly over prefixes
+func makeLayoutKey(layout conf.Storage_Keys_Layout, filename, id string, now time.Time) string {
+	key := id + keyExt(filename)
+	switch layout {
+	case conf.Storage_Keys_date:
+		return now.UTC().Format(`2006/01/02`) + `/` + key
+	case conf.Storage_Keys_hash:
+		sum := sha256.Sum256([]byte(id))
+		prefix := hex.EncodeToString(sum[:2])
+		return fmt.Sprintf(`%s/%s/%s`, prefix[:2], prefix[2:], key)
+	default:
+		return key
+	}
+}
+
+// keyExt keeps extension of file in key, so objects are recognizable in s3 storage browsers
+func keyExt(filename string) string {
+	ext := slug.Make(filepath.Ext(filename))
+	if ext == "" {
+		return ""
+	}
+	return `.` + ext
+}
+
+// matchesKeyLayout checks that key looks like made by layout
+func matchesKeyLayout(layout conf.Storage_Keys_Layout, objectPath string) bool {
+	pattern, ok := objectKeyPatterns[layout]
+	return ok && pattern.MatchString(objectPath)
+}
+
+// followsKeyLayout checks that object with content of file has key made by layout, deduplicated files
+// share object of their blob
+func followsKeyLayout(layout conf.Storage_Keys_Layout, f *ent.File) bool {
+	if f.Edges.Blob != nil && layout == conf.Storage_Keys_slug {
+		return slugBlobKeyPattern.MatchString(f.Edges.Blob.ObjectPath)
+	}
+	return matchesKeyLayout(layout, ObjectPathOf(f))
+}
+
+// KeysMigrationReport lists files which object keys are rewritten by configured layout,
+// in dry run nothing is changed and report lists files which would be migrated
+type KeysMigrationReport struct {
+	Applied bool
+	// Migrated are uids of files which content is moved to keys of layout, deduplicated files are listed
+	// with every file sharing their blob
+	Migrated []string
+	// Failed are uids of files which keys are left as they were because of errors
+	Failed []string
+}
+
+// MigrateObjectKeys rewrites keys of active and deleted files which do not follow configured layout, content of
+// deduplicated files is moved with their blob. Object is copied to the new key before file or blob refers to it
+// and the old object is removed when nothing refers to it, so files always have content, objects left after
+// failures are found by reconciliation. Downloads started before file is migrated may fail.
+func (s *StorageUsecase) MigrateObjectKeys(ctx context.Context, apply bool) (*KeysMigrationReport, error) {
+	report := &KeysMigrationReport{
+		Applied:  apply,
+		Migrated: []string{},
+		Failed:   []string{},
+	}
+	layout := s.storage.GetKeys().GetLayout()
+
+	// versions rolled back without blob share object, they must share it after migration too
+	moved := map[string]string{}
+	// blobs are shared by deduplicated files, each of them is moved once
+	movedBlobs := map[int]bool{}
+	for _, status := range []fileStatus.Status{fileStatus.StatusActive, fileStatus.StatusDeleted} {
+		for offset := 0; ; offset += keysMigrationPageSize {
+			files, err := s.fileRepo.FindByStatus(ctx, status, keysMigrationPageSize, offset)
+			if err != nil {
+				return report, err
+			}
+			for _, f := range files {
+				if followsKeyLayout(layout, f) {
+					continue
+				}
+				if apply {
+					if f.Edges.Blob != nil {
+						err = s.migrateBlobKey(ctx, f, movedBlobs)
+					} else {
+						err = s.migrateObjectKey(ctx, f, moved)
+					}
+					if err != nil {
+						s.logger.WithContext(ctx).Errorf(
+							`failed to migrate object key [%s] of file [%s]: %v`, ObjectPathOf(f), f.UID, err,
+						)
+						report.Failed = append(report.Failed, f.UID.String())
+						s.metric.Increment(metricPrefix + `.keys.failed`)
+						continue
+					}
+				}
+				report.Migrated = append(report.Migrated, f.UID.String())
+				s.metric.Increment(metricPrefix + `.keys.migrated`)
+			}
+			if len(files) < keysMigrationPageSize {
+				break
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// migrateObjectKey moves object of file to the new key, moved maps old keys of objects owned by files to new ones
+func (s *StorageUsecase) migrateObjectKey(ctx context.Context, f *ent.File, moved map[string]string) error {
+	objectPath, ok := moved[f.ObjectPath]
+	if !ok {
+		var err error
+		objectPath, err = s.newObjectPath(ctx, f.UserID, f.Filename)
+		if err != nil {
+			return err
+		}
+		if _, err = s.minioClient.CopyObject(ctx, f.ObjectPath, objectPath); err != nil {
+			return err
+		}
+		moved[f.ObjectPath] = objectPath
+	}
+
+	if err := s.fileRepo.UpdateObjectPath(ctx, f.UID.String(), f.ObjectPath, objectPath); err != nil {
+		return err
+	}
+
+	owned, err := s.fileRepo.HasObjectOwner(ctx, f.ObjectPath)
+	if err != nil || owned {
+		return err
+	}
+	return s.minioClient.Remove(ctx, f.ObjectPath)
+}
+
+// migrateBlobKey moves content object of blob of deduplicated file, moved keeps ids of blobs moved already,
+// because files loaded before their blob is moved still refer to the old object
+func (s *StorageUsecase) migrateBlobKey(ctx context.Context, f *ent.File, moved map[int]bool) error {
+	blob := f.Edges.Blob
+	if moved[blob.ID] {
+		return nil
+	}
+
+	objectPath := s.newBlobObjectPath(blob.Sha256, f.Filename)
+	if _, err := s.minioClient.CopyObject(ctx, blob.ObjectPath, objectPath); err != nil {
+		return err
+	}
+	if err := s.blobRepo.UpdateObjectPath(ctx, blob.ID, blob.ObjectPath, objectPath); err != nil {
+		// blob is released concurrently, so the copy is not needed
+		s.removeObjectQuietly(ctx, objectPath)
+		return err
+	}
+	moved[blob.ID] = true
+
+	return s.minioClient.Remove(ctx, blob.ObjectPath)
+}
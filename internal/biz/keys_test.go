@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/conf"
+)
+
+func TestMakeLayoutKey(t *testing.T) {
+	const id = `123e4567-e89b-12d3-a456-426614174000`
+	now := time.Date(2023, 2, 7, 23, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		layout   conf.Storage_Keys_Layout
+		filename string
+		expected string
+	}{
+		{
+			name:     "uuid",
+			layout:   conf.Storage_Keys_uuid,
+			filename: `Накладная №1.PDF`,
+			expected: id + `.pdf`,
+		},
+		{
+			name:     "date",
+			layout:   conf.Storage_Keys_date,
+			filename: `photo.jpg`,
+			expected: `2023/02/07/` + id + `.jpg`,
+		},
+		{
+			name:     "hash",
+			layout:   conf.Storage_Keys_hash,
+			filename: `README`,
+			expected: `98/6c/` + id,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			key := makeLayoutKey(testCase.layout, testCase.filename, id, now)
+			require.Equal(t, testCase.expected, key)
+			require.True(t, matchesKeyLayout(testCase.layout, key))
+			for _, other := range []conf.Storage_Keys_Layout{conf.Storage_Keys_slug, conf.Storage_Keys_uuid, conf.Storage_Keys_date, conf.Storage_Keys_hash} {
+				if other != testCase.layout {
+					require.False(t, matchesKeyLayout(other, key), other.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"io"
+
+	v1 "storage/api/storage/v1"
+	"storage/internal/conf"
+)
+
+const (
+	// defaultUploadMaxSize is the maximal size of uploaded file when it is not configured
+	defaultUploadMaxSize = 100 << 20
+
+	// defaultUploadMaxPartSize is the maximal size of s3 multipart part, which limits parts and tus chunks
+	defaultUploadMaxPartSize = 5 << 30
+)
+
+// uploadMaxSize returns maximal size of file for uploader role, the less of configured and role limits is taken,
+// integrations have nil role and are limited by integrationsRole of policy, see uploadLimits
+func (s *StorageUsecase) uploadMaxSize(role *conf.Policy_Role) int64 {
+	maxSize := s.storage.GetUpload().GetMaxSize()
+	if maxSize <= 0 {
+		maxSize = defaultUploadMaxSize
+	}
+	if roleMaxSize := s.uploadLimits(role).GetMaxSize(); roleMaxSize > 0 && roleMaxSize < maxSize {
+		maxSize = roleMaxSize
+	}
+	return maxSize
+}
+
+// uploadMaxPartSize returns maximal size of body of request uploading multipart part or tus chunk
+func (s *StorageUsecase) uploadMaxPartSize() int64 {
+	maxPartSize := s.storage.GetUpload().GetMaxPartSize()
+	if maxPartSize <= 0 || maxPartSize > defaultUploadMaxPartSize {
+		maxPartSize = defaultUploadMaxPartSize
+	}
+	return maxPartSize
+}
+
+// checkUploadSize rejects content larger than limit, unknown size is not checked, such content is read by limitedReader
+func checkUploadSize(size, limit int64) error {
+	if size > limit {
+		return v1.ErrorPayloadTooLarge(`size of content must not exceed %d bytes`, limit)
+	}
+	return nil
+}
+
+// limitedReader streams content until it exceeds limit, then it fails like http.MaxBytesReader does,
+// so content of unknown length never takes more space than allowed
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+	limit     int64
+	exceeded  bool
+}
+
+func newLimitedReader(reader io.Reader, limit int64) *limitedReader {
+	return &limitedReader{
+		reader:    reader,
+		remaining: limit,
+		limit:     limit,
+	}
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.exceeded {
+		return 0, l.err()
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	// one byte more than remaining is read to find out whether content exceeds limit
+	if int64(len(p))-1 > l.remaining {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.reader.Read(p)
+	if int64(n) <= l.remaining {
+		l.remaining -= int64(n)
+		return n, err
+	}
+
+	n = int(l.remaining)
+	l.remaining = 0
+	l.exceeded = true
+	return n, l.err()
+}
+
+// Exceeded reports that content was larger than limit, reading of such content is always failed
+func (l *limitedReader) Exceeded() bool {
+	return l.exceeded
+}
+
+func (l *limitedReader) err() error {
+	return checkUploadSize(l.limit+1, l.limit)
+}
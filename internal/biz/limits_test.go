@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "storage/api/storage/v1"
+)
+
+func TestLimitedReader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		limit    int64
+		exceeded bool
+	}{
+		{
+			name:    "empty",
+			content: ``,
+			limit:   0,
+		},
+		{
+			name:    "less_than_limit",
+			content: `waybill`,
+			limit:   16,
+		},
+		{
+			name:    "equal_to_limit",
+			content: `waybill`,
+			limit:   7,
+		},
+		{
+			name:     "exceeds_limit",
+			content:  `waybill`,
+			limit:    6,
+			exceeded: true,
+		},
+		{
+			name:     "zero_limit",
+			content:  `w`,
+			limit:    0,
+			exceeded: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			limited := newLimitedReader(strings.NewReader(testCase.content), testCase.limit)
+			content, err := io.ReadAll(limited)
+			require.Equal(t, testCase.exceeded, limited.Exceeded())
+			if testCase.exceeded {
+				require.True(t, v1.IsPayloadTooLarge(err))
+				require.Len(t, content, int(testCase.limit))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.content, string(content))
+		})
+	}
+}
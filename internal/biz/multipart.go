@@ -0,0 +1,371 @@
+package biz
+
+import (
+	"context"
+	"io"
+
+	"github.com/AlekSi/pointer"
+	"github.com/minio/minio-go/v7"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+	"storage/ent/multipart"
+	"storage/internal/conf"
+)
+
+const (
+	multipartMinPartNumber = 1
+	multipartMaxPartNumber = 10000
+
+	// multipartMinPartSize is the minimal size of s3 multipart part, only the last part may be smaller
+	multipartMinPartSize = 5 << 20
+)
+
+// MultipartUpload is a resumable upload with already stored parts
+type MultipartUpload struct {
+	Multipart *ent.Multipart
+	Parts     []*ent.MultipartPart
+}
+
+type UploadPart struct {
+	Reader     io.Reader
+	Size       int64
+	PartNumber int
+}
+
+// MultipartInitiate starts multipart upload of file in s3 storage, the file itself will be created on completion
+func (s *StorageUsecase) MultipartInitiate(ctx context.Context, filename, visibility string) (*MultipartUpload, error) {
+	return s.initiateMultipart(ctx, filename, visibility, nil)
+}
+
+// initiateMultipart starts multipart upload, uploadLength is set only when total size is known beforehand
+func (s *StorageUsecase) initiateMultipart(
+	ctx context.Context,
+	filename string,
+	visibility string,
+	uploadLength *int,
+) (*MultipartUpload, error) {
+	fileVisibility, err := parseVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+	userID, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := contentTypeByFilename(filename)
+	size := int64(-1)
+	if uploadLength != nil {
+		size = int64(*uploadLength)
+	}
+	if err = s.checkUploadPolicy(role, filename, size); err != nil {
+		return nil, err
+	}
+	// file is created on completion, so quota is checked again with the total size there
+	declaredSize := size
+	if declaredSize < 0 {
+		declaredSize = 0
+	}
+	if err = s.checkQuota(ctx, userID, role, declaredSize, 1); err != nil {
+		return nil, err
+	}
+
+	objectPath, err := s.newObjectPath(ctx, userID, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadID, err := s.minioClient.NewMultipartUpload(ctx, objectPath, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.multipartRepo.Create(ctx, &ent.Multipart{
+		UserID:       userID,
+		Filename:     filename,
+		ObjectPath:   objectPath,
+		MimeType:     contentType,
+		UploadID:     uploadID,
+		UploadLength: uploadLength,
+		Visibility:   multipart.Visibility(fileVisibility),
+	})
+	if err != nil {
+		_ = s.minioClient.AbortMultipartUpload(ctx, objectPath, uploadID)
+		return nil, err
+	}
+
+	return &MultipartUpload{
+		Multipart: created,
+		Parts:     []*ent.MultipartPart{},
+	}, nil
+}
+
+// MultipartStatus returns multipart upload with stored parts, so client can resume upload after disconnect
+func (s *StorageUsecase) MultipartStatus(ctx context.Context, uid string) (*MultipartUpload, error) {
+	upload, err := s.ownMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.multipartRepo.FindParts(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultipartUpload{
+		Multipart: upload,
+		Parts:     parts,
+	}, nil
+}
+
+// MultipartUploadPart stores part of multipart upload, part with the same number is replaced
+func (s *StorageUsecase) MultipartUploadPart(ctx context.Context, uid string, part *UploadPart) (*ent.MultipartPart, error) {
+	if part == nil {
+		return nil, v1.ErrorValidationFailed(`part is empty`)
+	}
+	if part.PartNumber < multipartMinPartNumber || part.PartNumber > multipartMaxPartNumber {
+		return nil, v1.ErrorValidationFailed(
+			`part number must be between %d and %d`,
+			multipartMinPartNumber,
+			multipartMaxPartNumber,
+		)
+	}
+	if part.Size <= 0 {
+		return nil, v1.ErrorValidationFailed(`part must have known content length`)
+	}
+	if err := checkUploadSize(part.Size, s.uploadMaxPartSize()); err != nil {
+		return nil, err
+	}
+
+	upload, err := s.activeMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	_, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkPartsSize(ctx, upload, role, part); err != nil {
+		return nil, err
+	}
+
+	reader, detected := part.Reader, ``
+	if part.PartNumber == multipartMinPartNumber {
+		if detected, reader, err = s.sniffFirstPart(upload, role, reader); err != nil {
+			return nil, err
+		}
+	}
+
+	uploaded, err := s.minioClient.UploadPart(ctx, upload.ObjectPath, upload.UploadID, part.PartNumber, reader, part.Size)
+	if err != nil {
+		return nil, err
+	}
+	if part.PartNumber == multipartMinPartNumber {
+		if err = s.saveDetectedMimeType(ctx, upload, detected); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.multipartRepo.SavePart(ctx, &ent.MultipartPart{
+		MultipartID: upload.ID,
+		PartNumber:  part.PartNumber,
+		Etag:        uploaded.ETag,
+		Size:        int(uploaded.Size),
+	})
+}
+
+// checkPartsSize rejects part which makes file larger than uploader may upload, so it is not stored until completion
+func (s *StorageUsecase) checkPartsSize(
+	ctx context.Context,
+	upload *ent.Multipart,
+	role *conf.Policy_Role,
+	part *UploadPart,
+) error {
+	parts, err := s.multipartRepo.FindParts(ctx, upload.ID)
+	if err != nil {
+		return err
+	}
+
+	size := part.Size
+	for _, stored := range parts {
+		// part with the same number is replaced
+		if stored.PartNumber != part.PartNumber {
+			size += int64(stored.Size)
+		}
+	}
+	return s.checkUploadPolicy(role, upload.Filename, size)
+}
+
+// sniffFirstPart detects type of file by the first part while it is streamed, so mismatching content is rejected
+// before it is stored, returned reader streams the whole part
+func (s *StorageUsecase) sniffFirstPart(
+	upload *ent.Multipart,
+	role *conf.Policy_Role,
+	reader io.Reader,
+) (string, io.Reader, error) {
+	detected, content, err := sniffContentType(reader)
+	if err != nil {
+		return ``, nil, err
+	}
+	if err = s.checkDetectedType(role, upload.MimeType, detected); err != nil {
+		return ``, nil, err
+	}
+	return detected, content, nil
+}
+
+// saveDetectedMimeType saves type detected by stored first part, which may be replaced by another one
+func (s *StorageUsecase) saveDetectedMimeType(ctx context.Context, upload *ent.Multipart, detected string) error {
+	if detected == upload.DetectedMimeType {
+		return nil
+	}
+	if err := s.multipartRepo.SetDetectedMimeType(ctx, upload.ID, detected); err != nil {
+		return err
+	}
+	upload.DetectedMimeType = detected
+	return nil
+}
+
+// MultipartComplete assembles stored parts into object and creates file of it
+func (s *StorageUsecase) MultipartComplete(ctx context.Context, uid string) (*ent.File, error) {
+	upload, err := s.activeMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeMultipart(ctx, upload)
+}
+
+func (s *StorageUsecase) completeMultipart(ctx context.Context, upload *ent.Multipart) (*ent.File, error) {
+	parts, err := s.multipartRepo.FindParts(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, v1.ErrorValidationFailed(`multipart upload [%s] has no parts`, upload.UID)
+	}
+	if err = checkPartSizes(upload, parts); err != nil {
+		return nil, err
+	}
+
+	if err = s.checkObjectPathIsFree(ctx, upload.ObjectPath); err != nil {
+		return nil, err
+	}
+
+	size := 0
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		size += part.Size
+		completeParts = append(completeParts, minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.Etag,
+		})
+	}
+
+	// upload is completed by its owner, so limits of current user are applied
+	_, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkUploadPolicy(role, upload.Filename, int64(size)); err != nil {
+		return nil, err
+	}
+	if err = s.checkQuota(ctx, upload.UserID, role, int64(size), 1); err != nil {
+		return nil, err
+	}
+
+	uploadInfo, err := s.minioClient.CompleteMultipartUpload(ctx, upload.ObjectPath, upload.UploadID, completeParts)
+	if err != nil {
+		return nil, err
+	}
+
+	// content is assembled by s3 and is never read by service, so checksums are not known and file is not deduplicated
+	saved, err := s.fileRepo.Create(ctx, &ent.File{
+		UserID:           upload.UserID,
+		Filename:         upload.Filename,
+		ObjectPath:       upload.ObjectPath,
+		LogicalPath:      makeObjectPath(upload.UserID, upload.Filename),
+		Size:             size,
+		MimeType:         upload.MimeType,
+		DetectedMimeType: upload.DetectedMimeType,
+		Etag:             uploadInfo.ETag,
+		LastModified:     pointer.ToTime(lastModifiedOrNow(uploadInfo.LastModified)),
+		Status:           fileStatus.StatusActive,
+		Visibility:       fileStatus.Visibility(upload.Visibility),
+		ScanStatus:       s.storedScanStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.multipartRepo.Complete(ctx, upload.ID, saved.UID); err != nil {
+		return saved, err
+	}
+	s.scanStoredFile(ctx, saved)
+
+	return saved, nil
+}
+
+// checkPartSizes rejects completion with small parts before s3 does, so client knows which part to upload again,
+// parts go in order of their numbers
+func checkPartSizes(upload *ent.Multipart, parts []*ent.MultipartPart) error {
+	for _, part := range parts[:len(parts)-1] {
+		if part.Size < multipartMinPartSize {
+			return v1.ErrorValidationFailed(
+				`part %d of multipart upload [%s] has %d bytes, every part except the last one must be at least %d bytes`,
+				part.PartNumber,
+				upload.UID,
+				part.Size,
+				multipartMinPartSize,
+			)
+		}
+	}
+	return nil
+}
+
+// MultipartAbort cancels multipart upload and removes its stored parts
+func (s *StorageUsecase) MultipartAbort(ctx context.Context, uid string) error {
+	upload, err := s.activeMultipart(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	err = s.minioClient.AbortMultipartUpload(ctx, upload.ObjectPath, upload.UploadID)
+	if err != nil && minio.ToErrorResponse(err).Code != `NoSuchUpload` {
+		return err
+	}
+
+	return s.multipartRepo.Abort(ctx, upload.ID)
+}
+
+func (s *StorageUsecase) ownMultipart(ctx context.Context, uid string) (*ent.Multipart, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.multipartRepo.FindByUID(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, v1.ErrorNotFound(`multipart upload [%s] is not found`, uid)
+		}
+		return nil, err
+	}
+	if upload.UserID != userID {
+		return nil, v1.ErrorAccessDenied(`multipart upload [%s] belongs to another user`, uid)
+	}
+
+	return upload, nil
+}
+
+func (s *StorageUsecase) activeMultipart(ctx context.Context, uid string) (*ent.Multipart, error) {
+	upload, err := s.ownMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Status != multipart.StatusActive {
+		return nil, v1.ErrorValidationFailed(`multipart upload [%s] is already %s`, uid, upload.Status)
+	}
+	return upload, nil
+}
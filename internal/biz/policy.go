@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	"storage/internal/conf"
+)
+
+var (
+	// defaultAdminRole is used for admins which are not listed in policy
+	defaultAdminRole = &conf.Policy_Role{
+		Upload:         true,
+		List:           conf.Policy_Role_all,
+		DownloadOthers: true,
+		Delete:         conf.Policy_Role_all,
+		Restore:        conf.Policy_Role_all,
+	}
+	// defaultUserRole is used for other user types which are not listed in policy
+	defaultUserRole = &conf.Policy_Role{
+		Upload:  true,
+		List:    conf.Policy_Role_own,
+		Delete:  conf.Policy_Role_own,
+		Restore: conf.Policy_Role_own,
+	}
+)
+
+// role returns permissions of user by its type
+func (s *StorageUsecase) role(user *AuthenticatedUser) *conf.Policy_Role {
+	userType := ``
+	if authUser := user.AuthUser(); authUser != nil {
+		userType = authUser.Type
+	}
+	if role, ok := s.policy.GetRoles()[userType]; ok {
+		return role
+	}
+	if user.IsAdmin() {
+		return defaultAdminRole
+	}
+	return defaultUserRole
+}
+
+// uploader returns identifier and role of user who uploads file, role of integrations is nil, see uploadLimits
+func (s *StorageUsecase) uploader(ctx context.Context) (int, *conf.Policy_Role, error) {
+	if s.isIntegrations(ctx) {
+		return 0, nil, nil
+	}
+	user, err := s.user(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	role := s.role(user)
+	if !role.GetUpload() {
+		return 0, nil, v1.ErrorAccessDenied(`users of type [%s] may not upload files`, user.AuthUser().Type)
+	}
+	return int(user.ID()), role, nil
+}
+
+// uploadLimits returns role which limits uploaded files, integrations are limited by integrationsRole of policy only
+func (s *StorageUsecase) uploadLimits(role *conf.Policy_Role) *conf.Policy_Role {
+	if role == nil {
+		return s.policy.GetIntegrationsRole()
+	}
+	return role
+}
+
+// checkUploadPolicy checks file by limits of uploader role and configured maximal size, its type is declared
+// by extension of filename, size is not checked while it is unknown
+func (s *StorageUsecase) checkUploadPolicy(role *conf.Policy_Role, filename string, size int64) error {
+	if err := checkUploadSize(size, s.uploadMaxSize(role)); err != nil {
+		s.metric.Increment(metricPrefix + `.upload.too_large`)
+		return err
+	}
+
+	limits := s.uploadLimits(role)
+	extension := extensionOf(filename)
+	if !extensionAllowed(limits.GetExtensions(), extension) || extensionMatches(limits.GetDeniedExtensions(), extension) {
+		return v1.ErrorAccessDenied(`files with extension [%s] may not be uploaded`, extension)
+	}
+	contentType := contentTypeByFilename(filename)
+	if !mimeTypeAllowed(limits.GetMimeTypes(), contentType) || mimeTypeMatches(limits.GetDeniedMimeTypes(), contentType) {
+		return v1.ErrorAccessDenied(`files of type [%s] may not be uploaded`, contentType)
+	}
+	return nil
+}
+
+// checkDetectedType checks type detected by content against declared one and denied types of uploader role,
+// content of unknown type is not checked
+func (s *StorageUsecase) checkDetectedType(role *conf.Policy_Role, contentType, detected string) error {
+	if detected == `` {
+		return nil
+	}
+	if mimeTypeMatches(s.uploadLimits(role).GetDeniedMimeTypes(), detected) {
+		return v1.ErrorAccessDenied(`files of type [%s] may not be uploaded`, detected)
+	}
+	if !contentMatchesType(contentType, detected) {
+		s.metric.Increment(metricPrefix + `.upload.type_mismatch`)
+		return v1.ErrorValidationFailed(`content of file has type [%s], but its extension declares [%s]`, detected, contentType)
+	}
+	return nil
+}
+
+// mimeTypeAllowed matches type against allowed ones, which may be wildcards like image/*, empty list allows any type
+func mimeTypeAllowed(allowed []string, contentType string) bool {
+	return len(allowed) == 0 || mimeTypeMatches(allowed, contentType)
+}
+
+// mimeTypeMatches matches type against patterns, which may be wildcards like image/*
+func mimeTypeMatches(patterns []string, contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, `;`)
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == mediaType || pattern == `*/*` {
+			return true
+		}
+		if strings.HasSuffix(pattern, `/*`) && strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, `*`)) {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionAllowed matches extension against allowed ones, empty list allows any extension
+func extensionAllowed(allowed []string, extension string) bool {
+	return len(allowed) == 0 || extensionMatches(allowed, extension)
+}
+
+// extensionMatches matches extension against listed ones, which may be written with or without leading dot
+func extensionMatches(extensions []string, extension string) bool {
+	for _, listed := range extensions {
+		if strings.ToLower(strings.TrimPrefix(listed, `.`)) == extension {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionOf returns lowercase extension of filename without leading dot
+func extensionOf(filename string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), `.`))
+}
+
+// checkFilePermission allows action on file by scope of user role, integrations own files uploaded by them
+func (s *StorageUsecase) checkFilePermission(
+	ctx context.Context,
+	f *ent.File,
+	action string,
+	scopeOf func(*conf.Policy_Role) conf.Policy_Role_Scope,
+) error {
+	if s.isIntegrations(ctx) {
+		if f.UserID == 0 {
+			return nil
+		}
+		return v1.ErrorAccessDenied(`file [%s] belongs to user`, f.UID)
+	}
+	user, err := s.user(ctx)
+	if err != nil {
+		return err
+	}
+	switch scopeOf(s.role(user)) {
+	case conf.Policy_Role_all:
+		return nil
+	case conf.Policy_Role_own:
+		if int(user.ID()) == f.UserID {
+			return nil
+		}
+		return v1.ErrorAccessDenied(`file [%s] belongs to another user`, f.UID)
+	default:
+		return v1.ErrorAccessDenied(`users of type [%s] may not %s files`, user.AuthUser().Type, action)
+	}
+}
+
+func scopeOfList(role *conf.Policy_Role) conf.Policy_Role_Scope {
+	return role.GetList()
+}
+
+func scopeOfDelete(role *conf.Policy_Role) conf.Policy_Role_Scope {
+	return role.GetDelete()
+}
+
+func scopeOfRestore(role *conf.Policy_Role) conf.Policy_Role_Scope {
+	return role.GetRestore()
+}
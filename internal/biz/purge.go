@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+
+	"storage/ent"
+	"storage/internal/data"
+)
+
+const (
+	defaultPurgeRetention = 30 * 24 * time.Hour
+	defaultPurgeBatchSize = 100
+)
+
+// Purge removes content of files which are in trash longer than retention period and marks them purged,
+// files are taken in batches until expired files are over, count of purged files is returned
+func (s *StorageUsecase) Purge(ctx context.Context) (int, error) {
+	deletedBefore := time.Now().Add(-s.purgeRetention())
+	batchSize := s.purgeBatchSize()
+
+	purged, purgedBytes := 0, 0
+	for {
+		// purged and restored files leave trash, so the next batch is always the first one
+		files, err := s.fileRepo.FindDeletedBefore(ctx, deletedBefore, batchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, f := range files {
+			ok, err := s.purgeFile(ctx, f)
+			if err != nil {
+				return purged, err
+			}
+			if ok {
+				purged++
+				purgedBytes += f.Size
+				s.metric.Increment(metricPrefix + `.purge.files`)
+			}
+		}
+
+		if len(files) < batchSize {
+			s.metric.Gauge(metricPrefix+`.purge.bytes`, purgedBytes)
+			return purged, nil
+		}
+	}
+}
+
+// purgeFile marks file purged before its content is removed, so file which is restored concurrently
+// is never left without content, content which fails to be removed is found later by reconciliation
+func (s *StorageUsecase) purgeFile(ctx context.Context, f *ent.File) (bool, error) {
+	err := s.fileRepo.Purge(ctx, f.UID.String())
+	if errors.Is(err, data.ErrStatusTransition) {
+		return false, nil // restored in the meantime
+	}
+	if err != nil {
+		return false, err
+	}
+	s.removeRenditions(ctx, f)
+
+	if f.Edges.Blob != nil {
+		if err = s.releaseBlob(ctx, f.Edges.Blob); err != nil {
+			s.metric.Increment(metricPrefix + `.purge.errors`)
+			s.logger.WithContext(ctx).Errorf(`failed to release blob [%s] of purged file [%s]: %v`, f.Edges.Blob.Sha256, f.UID, err)
+		}
+		return true, nil
+	}
+
+	owned, err := s.fileRepo.HasObjectOwner(ctx, f.ObjectPath)
+	if err == nil && !owned {
+		err = s.minioClient.Remove(ctx, f.ObjectPath)
+	}
+	if err != nil {
+		s.metric.Increment(metricPrefix + `.purge.errors`)
+		s.logger.WithContext(ctx).Errorf(`failed to remove object [%s] of purged file [%s]: %v`, f.ObjectPath, f.UID, err)
+	}
+	return true, nil
+}
+
+// removeRenditions removes cached renditions of file which content is removed, errors are only logged
+// because renditions of removed files are never served
+func (s *StorageUsecase) removeRenditions(ctx context.Context, f *ent.File) {
+	if err := s.removeObjects(ctx, renditionsPrefixOf(f)); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to remove renditions of file [%s]: %v`, f.UID, err)
+	}
+}
+
+// removeObjects removes all objects with prefix, objects are listed before removal, so walking is not disturbed,
+// the last error is returned after all objects are tried
+func (s *StorageUsecase) removeObjects(ctx context.Context, prefix string) error {
+	var objectPaths []string
+	err := s.minioClient.WalkObjects(ctx, prefix, func(object minio.ObjectInfo) error {
+		objectPaths = append(objectPaths, object.Key)
+		return nil
+	})
+	for _, objectPath := range objectPaths {
+		if removeErr := s.minioClient.Remove(ctx, objectPath); removeErr != nil {
+			err = removeErr
+		}
+	}
+	return err
+}
+
+func (s *StorageUsecase) purgeRetention() time.Duration {
+	if retention := s.storage.GetPurge().GetRetention(); retention != nil && retention.AsDuration() > 0 {
+		return retention.AsDuration()
+	}
+	return defaultPurgeRetention
+}
+
+func (s *StorageUsecase) purgeBatchSize() int {
+	if batchSize := s.storage.GetPurge().GetBatchSize(); batchSize > 0 {
+		return int(batchSize)
+	}
+	return defaultPurgeBatchSize
+}
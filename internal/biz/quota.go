@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+
+	v1 "storage/api/storage/v1"
+	"storage/internal/conf"
+)
+
+// Usage is space taken by files of user with its quota, zero limits of quota mean no limit
+type Usage struct {
+	Bytes int64
+	Files int
+	Quota *conf.Policy_Quota
+}
+
+// Usage counts files of current user, integrations share one usage, all versions and files in trash are counted
+func (s *StorageUsecase) Usage(ctx context.Context) (*Usage, error) {
+	userID := 0
+	var role *conf.Policy_Role
+	if !s.isIntegrations(ctx) {
+		user, err := s.user(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID = int(user.ID())
+		role = s.role(user)
+	}
+
+	bytes, files, err := s.fileRepo.Usage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{
+		Bytes: bytes,
+		Files: files,
+		Quota: s.quotaOf(userID, role),
+	}, nil
+}
+
+// quotaOf returns quota of user, quota set for user overrides quota of its type, role of integrations is nil
+func (s *StorageUsecase) quotaOf(userID int, role *conf.Policy_Role) *conf.Policy_Quota {
+	if role == nil {
+		return s.policy.GetIntegrations()
+	}
+	if quota, ok := s.policy.GetUsers()[int64(userID)]; ok {
+		return quota
+	}
+	return role.GetQuota()
+}
+
+// checkQuota rejects upload which adds size bytes and count of files to usage of user over its quota,
+// it is checked before content is stored, so rejected uploads never reach s3 storage
+func (s *StorageUsecase) checkQuota(ctx context.Context, userID int, role *conf.Policy_Role, size int64, files int) error {
+	quota := s.quotaOf(userID, role)
+	if quota.GetBytes() <= 0 && quota.GetFiles() <= 0 {
+		return nil
+	}
+
+	usedBytes, usedFiles, err := s.fileRepo.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if quota.GetFiles() > 0 && int64(usedFiles+files) > quota.GetFiles() {
+		s.metric.Increment(metricPrefix + `.quota.exceeded`)
+		return v1.ErrorQuotaExceeded(`quota of %d files is exceeded`, quota.GetFiles())
+	}
+	if quota.GetBytes() > 0 && usedBytes+size > quota.GetBytes() {
+		s.metric.Increment(metricPrefix + `.quota.exceeded`)
+		return v1.ErrorQuotaExceeded(
+			`quota of %d bytes is exceeded, %d bytes are already used`,
+			quota.GetBytes(),
+			usedBytes,
+		)
+	}
+	return nil
+}
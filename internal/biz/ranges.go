@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+var (
+	errInvalidRange = errors.New(`invalid range`)
+	errNoOverlap    = errors.New(`range does not overlap content`)
+)
+
+// byteRange is a part of content requested by Range header
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+func (r byteRange) contentRange(size int64) string {
+	return fmt.Sprintf(`bytes %d-%d/%d`, r.start, r.start+r.length-1, size)
+}
+
+func (r byteRange) mimeHeader(contentType string, size int64) textproto.MIMEHeader {
+	return textproto.MIMEHeader{
+		`Content-Range`: {r.contentRange(size)},
+		`Content-Type`:  {contentType},
+	}
+}
+
+// parseRange parses Range header like "bytes=0-99,200-,-50" against content of given size,
+// ranges which start beyond the content are skipped, errNoOverlap is returned when nothing is left
+func parseRange(header string, size int64) ([]byteRange, error) {
+	const prefix = `bytes=`
+	if !strings.HasPrefix(header, prefix) {
+		return nil, errInvalidRange
+	}
+
+	var ranges []byteRange
+	noOverlap := false
+	for _, spec := range strings.Split(header[len(prefix):], `,`) {
+		spec = textproto.TrimString(spec)
+		if spec == "" {
+			continue
+		}
+		startValue, endValue, ok := strings.Cut(spec, `-`)
+		if !ok {
+			return nil, errInvalidRange
+		}
+		startValue, endValue = textproto.TrimString(startValue), textproto.TrimString(endValue)
+
+		var r byteRange
+		if startValue == "" {
+			// suffix range "-N" means the last N bytes
+			length, err := strconv.ParseInt(endValue, 10, 64)
+			if err != nil || length < 0 {
+				return nil, errInvalidRange
+			}
+			if length == 0 {
+				noOverlap = true
+				continue
+			}
+			if length > size {
+				length = size
+			}
+			r.start = size - length
+			r.length = length
+		} else {
+			start, err := strconv.ParseInt(startValue, 10, 64)
+			if err != nil || start < 0 {
+				return nil, errInvalidRange
+			}
+			if start >= size {
+				noOverlap = true
+				continue
+			}
+			r.start = start
+			if endValue == "" {
+				r.length = size - start
+			} else {
+				end, err := strconv.ParseInt(endValue, 10, 64)
+				if err != nil || start > end {
+					return nil, errInvalidRange
+				}
+				if end >= size {
+					end = size - 1
+				}
+				r.length = end - start + 1
+			}
+		}
+		ranges = append(ranges, r)
+	}
+
+	if noOverlap && len(ranges) == 0 {
+		return nil, errNoOverlap
+	}
+	return ranges, nil
+}
+
+// rangesMIMESize counts size of multipart/byteranges body with given boundary without writing content of ranges
+func rangesMIMESize(ranges []byteRange, contentType string, size int64, boundary string) int64 {
+	var total int64
+	counter := &countingWriter{}
+	writer := multipart.NewWriter(counter)
+	_ = writer.SetBoundary(boundary)
+	for _, r := range ranges {
+		_, _ = writer.CreatePart(r.mimeHeader(contentType, size))
+		total += r.length
+	}
+	_ = writer.Close()
+	return total + counter.count
+}
+
+func sumRangesSize(ranges []byteRange) int64 {
+	var total int64
+	for _, r := range ranges {
+		total += r.length
+	}
+	return total
+}
+
+type countingWriter struct {
+	count int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.count += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange(t *testing.T) {
+	const size = 100
+
+	testCases := []struct {
+		name           string
+		header         string
+		expectedRanges []byteRange
+		expectedError  error
+	}{
+		{
+			name:           "single",
+			header:         "bytes=0-9",
+			expectedRanges: []byteRange{{start: 0, length: 10}},
+		},
+		{
+			name:           "open_end",
+			header:         "bytes=90-",
+			expectedRanges: []byteRange{{start: 90, length: 10}},
+		},
+		{
+			name:           "suffix",
+			header:         "bytes=-5",
+			expectedRanges: []byteRange{{start: 95, length: 5}},
+		},
+		{
+			name:           "suffix_longer_than_content",
+			header:         "bytes=-500",
+			expectedRanges: []byteRange{{start: 0, length: size}},
+		},
+		{
+			name:           "end_beyond_content",
+			header:         "bytes=50-500",
+			expectedRanges: []byteRange{{start: 50, length: 50}},
+		},
+		{
+			name:           "multiple_with_spaces",
+			header:         "bytes=0-0, 10-19 ,-1",
+			expectedRanges: []byteRange{{start: 0, length: 1}, {start: 10, length: 10}, {start: 99, length: 1}},
+		},
+		{
+			name:           "unsatisfiable_ranges_are_skipped",
+			header:         "bytes=200-300,0-4",
+			expectedRanges: []byteRange{{start: 0, length: 5}},
+		},
+		{
+			name:          "no_overlap",
+			header:        "bytes=100-",
+			expectedError: errNoOverlap,
+		},
+		{
+			name:          "wrong_unit",
+			header:        "items=0-9",
+			expectedError: errInvalidRange,
+		},
+		{
+			name:          "reversed",
+			header:        "bytes=9-0",
+			expectedError: errInvalidRange,
+		},
+		{
+			name:          "garbage",
+			header:        "bytes=abc",
+			expectedError: errInvalidRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actualRanges, err := parseRange(testCase.header, size)
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedRanges, actualRanges)
+			}
+		})
+	}
+}
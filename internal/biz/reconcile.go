@@ -0,0 +1,329 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+	"storage/ent/multipart"
+	"storage/internal/data"
+)
+
+const (
+	defaultReconcilePendingTimeout    = 24 * time.Hour
+	defaultReconcileOrphanGracePeriod = 24 * time.Hour
+
+	reconcilePageSize = 1000
+)
+
+// ReconcileReport describes differences between files and objects of s3 storage found by reconciliation,
+// in dry run nothing is changed and report lists what would be done
+type ReconcileReport struct {
+	Applied bool
+	// FailedUploads are uids of pending files which upload has not finished in time
+	FailedUploads []string
+	// OrphanedObjects are paths of objects older than grace period which no file or blob refers to
+	OrphanedObjects []string
+	// RemovedObjects are paths of orphaned objects removed from storage
+	RemovedObjects []string
+	// MissingObjects are uids of active and deleted files which objects do not exist
+	MissingObjects []string
+}
+
+// ReconcileState is a state of reconciliation requested by admin
+type ReconcileState struct {
+	Running    bool
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	// Err is a reason of failure of the last run
+	Err error
+	// Report is a report of the last successful run, it is kept until the next one is finished
+	Report *ReconcileReport
+}
+
+// onDemandReconcile guards the only reconciliation requested by admins which runs in background
+type onDemandReconcile struct {
+	mutex sync.Mutex
+	state ReconcileState
+}
+
+// ReconcileOnDemand starts reconciliation requested by admin in background, because it walks the whole bucket,
+// which takes longer than request may last, its progress and report are returned by ReconcileStatus
+func (s *StorageUsecase) ReconcileOnDemand(ctx context.Context, apply bool) (*ReconcileState, error) {
+	if err := s.checkReconcilePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	s.reconcile.mutex.Lock()
+	defer s.reconcile.mutex.Unlock()
+	if s.reconcile.state.Running {
+		return nil, v1.ErrorConflict(`reconciliation is already running since %s`, s.reconcile.state.StartedAt.Format(time.RFC3339))
+	}
+	startedAt := time.Now()
+	s.reconcile.state.Running = true
+	s.reconcile.state.StartedAt = &startedAt
+
+	// reconciliation goes on after request is finished
+	go s.reconcileInBackground(detachedContext{ctx}, apply)
+
+	state := s.reconcile.state
+	return &state, nil
+}
+
+// ReconcileStatus returns state of reconciliation requested by admins
+func (s *StorageUsecase) ReconcileStatus(ctx context.Context) (*ReconcileState, error) {
+	if err := s.checkReconcilePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	s.reconcile.mutex.Lock()
+	defer s.reconcile.mutex.Unlock()
+	state := s.reconcile.state
+	return &state, nil
+}
+
+func (s *StorageUsecase) reconcileInBackground(ctx context.Context, apply bool) {
+	report, err := s.Reconcile(ctx, apply)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to reconcile storage on demand: %v`, err)
+	}
+
+	s.reconcile.mutex.Lock()
+	defer s.reconcile.mutex.Unlock()
+	finishedAt := time.Now()
+	s.reconcile.state.Running = false
+	s.reconcile.state.FinishedAt = &finishedAt
+	s.reconcile.state.Err = err
+	if err == nil {
+		s.reconcile.state.Report = report
+	}
+}
+
+func (s *StorageUsecase) checkReconcilePermission(ctx context.Context) error {
+	user, err := s.user(ctx)
+	if err != nil {
+		return err
+	}
+	if !user.IsAdmin() {
+		return v1.ErrorAccessDenied(`only admins may reconcile storage`)
+	}
+	return nil
+}
+
+// Reconcile compares files with objects of s3 storage: fails pending uploads which are stuck after crash,
+// finds objects left without files and removes them if it is configured, reports files which objects are lost
+func (s *StorageUsecase) Reconcile(ctx context.Context, apply bool) (*ReconcileReport, error) {
+	report := &ReconcileReport{
+		Applied:         apply,
+		FailedUploads:   []string{},
+		OrphanedObjects: []string{},
+		RemovedObjects:  []string{},
+		MissingObjects:  []string{},
+	}
+	startedAt := time.Now()
+
+	// objects are listed before references are loaded: every object has its file or blob created earlier,
+	// so object uploaded during reconciliation is never taken for orphaned one
+	objects := map[string]time.Time{}
+	err := s.minioClient.WalkObjects(ctx, ``, func(object minio.ObjectInfo) error {
+		objects[object.Key] = object.LastModified
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.reconcilePendingFiles(ctx, report, startedAt); err != nil {
+		return nil, err
+	}
+	if err = s.reconcileOrphanedObjects(ctx, report, objects, startedAt); err != nil {
+		return nil, err
+	}
+	if err = s.reconcileMissingObjects(ctx, report, objects, startedAt); err != nil {
+		return nil, err
+	}
+
+	s.metric.Gauge(metricPrefix+`.reconcile.failedUploads`, len(report.FailedUploads))
+	s.metric.Gauge(metricPrefix+`.reconcile.orphanedObjects`, len(report.OrphanedObjects))
+	s.metric.Gauge(metricPrefix+`.reconcile.removedObjects`, len(report.RemovedObjects))
+	s.metric.Gauge(metricPrefix+`.reconcile.missingObjects`, len(report.MissingObjects))
+
+	return report, nil
+}
+
+// reconcilePendingFiles fails files which are pending longer than upload may last
+func (s *StorageUsecase) reconcilePendingFiles(ctx context.Context, report *ReconcileReport, startedAt time.Time) error {
+	createdBefore := startedAt.Add(-s.reconcilePendingTimeout())
+
+	var stuck []*ent.File
+	for offset := 0; ; offset += reconcilePageSize {
+		files, err := s.fileRepo.FindByStatus(ctx, fileStatus.StatusPending, reconcilePageSize, offset)
+		if err != nil {
+			return err
+		}
+		younger := false
+		for _, f := range files {
+			if !f.CreatedAt.Before(createdBefore) {
+				younger = true // files go from the oldest, so the rest are younger too
+				break
+			}
+			stuck = append(stuck, f)
+		}
+		if younger || len(files) < reconcilePageSize {
+			break
+		}
+	}
+
+	for _, f := range stuck {
+		if report.Applied {
+			err := s.fileRepo.Fail(ctx, f.UID.String())
+			if errors.Is(err, data.ErrStatusTransition) {
+				continue // upload is finished in the meantime
+			}
+			if err != nil {
+				return err
+			}
+		}
+		report.FailedUploads = append(report.FailedUploads, f.UID.String())
+	}
+	return nil
+}
+
+// reconcileOrphanedObjects finds objects which are not referenced by files or blobs, young objects are skipped
+// because they may belong to uploads which are not finished yet, cached renditions are removed by purge instead,
+// bytes of paused uploads are kept until their uploads are finished or failed
+func (s *StorageUsecase) reconcileOrphanedObjects(
+	ctx context.Context,
+	report *ReconcileReport,
+	objects map[string]time.Time,
+	startedAt time.Time,
+) error {
+	referenced := map[string]bool{}
+	filePaths, err := s.fileRepo.FindObjectPaths(ctx)
+	if err != nil {
+		return err
+	}
+	blobPaths, err := s.blobRepo.FindObjectPaths(ctx)
+	if err != nil {
+		return err
+	}
+	for _, objectPath := range append(filePaths, blobPaths...) {
+		referenced[objectPath] = true
+	}
+
+	failed := map[string]bool{}
+	for _, uid := range report.FailedUploads {
+		failed[uid] = true
+	}
+
+	modifiedBefore := startedAt.Add(-s.reconcileOrphanGracePeriod())
+	for objectPath, lastModified := range objects {
+		if referenced[objectPath] || strings.HasPrefix(objectPath, renditionsPrefix) || !lastModified.Before(modifiedBefore) {
+			continue
+		}
+		inProgress, err := s.uploadInProgress(ctx, objectPath, failed)
+		if err != nil {
+			return err
+		}
+		if inProgress {
+			continue
+		}
+		report.OrphanedObjects = append(report.OrphanedObjects, objectPath)
+	}
+	sort.Strings(report.OrphanedObjects)
+
+	if !report.Applied || !s.storage.GetReconcile().GetRemoveOrphans() {
+		return nil
+	}
+	for _, objectPath := range report.OrphanedObjects {
+		if err = s.minioClient.Remove(ctx, objectPath); err != nil {
+			s.logger.WithContext(ctx).Errorf(`failed to remove orphaned object [%s]: %v`, objectPath, err)
+			continue
+		}
+		report.RemovedObjects = append(report.RemovedObjects, objectPath)
+	}
+	return nil
+}
+
+// uploadInProgress reports that object holds received bytes of active tus upload or staged content of pending
+// direct upload, files which are failed by the same reconciliation are not pending anymore
+func (s *StorageUsecase) uploadInProgress(ctx context.Context, objectPath string, failed map[string]bool) (bool, error) {
+	switch {
+	case strings.HasPrefix(objectPath, tusPendingPrefix):
+		uid, _, _ := strings.Cut(strings.TrimPrefix(objectPath, tusPendingPrefix), `/`)
+		if _, err := uuid.Parse(uid); err != nil {
+			return false, nil
+		}
+		upload, err := s.multipartRepo.FindByUID(ctx, uid)
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return upload.Status == multipart.StatusActive, nil
+
+	case strings.HasPrefix(objectPath, directStagingPrefix):
+		uid := strings.TrimPrefix(objectPath, directStagingPrefix)
+		if _, err := uuid.Parse(uid); err != nil || failed[uid] {
+			return false, nil
+		}
+		_, err := s.fileRepo.FindPendingByUID(ctx, uid)
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	return false, nil
+}
+
+// reconcileMissingObjects finds files which objects do not exist, they can only be reported,
+// files changed after objects were listed are skipped
+func (s *StorageUsecase) reconcileMissingObjects(
+	ctx context.Context,
+	report *ReconcileReport,
+	objects map[string]time.Time,
+	startedAt time.Time,
+) error {
+	for _, status := range []fileStatus.Status{fileStatus.StatusActive, fileStatus.StatusDeleted} {
+		for offset := 0; ; offset += reconcilePageSize {
+			files, err := s.fileRepo.FindByStatus(ctx, status, reconcilePageSize, offset)
+			if err != nil {
+				return err
+			}
+			for _, f := range files {
+				if _, ok := objects[ObjectPathOf(f)]; ok || f.UpdatedAt.After(startedAt) {
+					continue
+				}
+				report.MissingObjects = append(report.MissingObjects, f.UID.String())
+			}
+			if len(files) < reconcilePageSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (s *StorageUsecase) reconcilePendingTimeout() time.Duration {
+	if timeout := s.storage.GetReconcile().GetPendingTimeout(); timeout != nil && timeout.AsDuration() > 0 {
+		return timeout.AsDuration()
+	}
+	return defaultReconcilePendingTimeout
+}
+
+func (s *StorageUsecase) reconcileOrphanGracePeriod() time.Duration {
+	if period := s.storage.GetReconcile().GetOrphanGracePeriod(); period != nil && period.AsDuration() > 0 {
+		return period.AsDuration()
+	}
+	return defaultReconcileOrphanGracePeriod
+}
@@ -0,0 +1,304 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif" // decoder of gif images, renditions are made of their first frame
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	"storage/internal/clients/minio"
+	"storage/internal/conf"
+	"storage/internal/pkg/imaging"
+)
+
+const (
+	// renditionsPrefix is a prefix of cached renditions keys, they are not referenced by files
+	renditionsPrefix = `renditions/`
+
+	defaultRenditionMaxDimension    = 2048
+	defaultRenditionMaxSourceSize   = 32 << 20
+	defaultRenditionMaxSourcePixels = 50_000_000
+	defaultRenditionQuality         = 85
+	defaultRenditionConcurrency     = 2
+)
+
+// renderableTypes are types of images which renditions can be made of
+var renderableTypes = map[string]bool{
+	`image/jpeg`: true,
+	`image/png`:  true,
+	`image/gif`:  true,
+}
+
+// RenditionRequest is a resized image of file to download, zero width or height is derived from aspect ratio,
+// empty fit and format are chosen by default
+type RenditionRequest struct {
+	UID string
+	// Version is a number of file version, zero means the latest version
+	Version     int
+	Width       int
+	Height      int
+	Fit         string
+	Format      string
+	IfNoneMatch string
+}
+
+type rendition struct {
+	width  int
+	height int
+	fit    imaging.Fit
+	format imaging.Format
+}
+
+// name identifies rendition of file, so it is a part of key and etag
+func (r *rendition) name() string {
+	return fmt.Sprintf(`%dx%d-%s.%s`, r.width, r.height, r.fit, r.format)
+}
+
+// DownloadRendition writes image of file resized by request, renditions are cached in s3 storage,
+// so every rendition is made once
+func (s *StorageUsecase) DownloadRendition(
+	ctx context.Context,
+	request *RenditionRequest,
+	writer gin.ResponseWriter,
+) error {
+	f, err := s.downloadableFile(ctx, request.UID, request.Version)
+	if err != nil {
+		return err
+	}
+	if err = checkScanStatus(f); err != nil {
+		return err
+	}
+	sourceType := renditionSourceType(f)
+	if !renderableTypes[sourceType] {
+		return v1.ErrorValidationFailed(`renditions of file [%s] of type [%s] are not supported`, f.UID, sourceType)
+	}
+	r, err := s.parseRendition(request, sourceType)
+	if err != nil {
+		return err
+	}
+	if err = s.ensureObjectInfo(ctx, f); err != nil {
+		return err
+	}
+
+	etag := quoteETag(f.Etag + `-` + r.name())
+	writer.Header().Set(`Cache-Control`, `private, no-cache`)
+	writer.Header().Set(`ETag`, etag)
+	if request.IfNoneMatch != "" && etagListMatches(request.IfNoneMatch, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	writer.Header().Set(`Content-Type`, r.format.ContentType())
+	writer.Header().Set(`Content-Disposition`, `inline`)
+
+	objectPath := renditionObjectPath(f, r)
+	info, err := s.minioClient.StatObject(ctx, objectPath)
+	if err == nil {
+		s.metric.Increment(metricPrefix + `.renditions.cached`)
+		writer.Header().Set(`Content-Length`, strconv.FormatInt(info.Size, 10))
+		return s.minioClient.DownloadToWriter(ctx, writer, objectPath)
+	}
+	if !minio.IsNotFound(err) {
+		return err
+	}
+
+	content, err := s.render(ctx, f, r)
+	if err != nil {
+		return err
+	}
+	_, err = s.minioClient.UploadFromReader(
+		ctx,
+		bytes.NewReader(content),
+		int64(len(content)),
+		r.format.ContentType(),
+		objectPath,
+	)
+	if err != nil {
+		// rendition is served anyway and is made again next time
+		s.logger.WithContext(ctx).Errorf(`failed to cache rendition [%s] of file [%s]: %v`, objectPath, f.UID, err)
+	}
+
+	writer.Header().Set(`Content-Length`, strconv.Itoa(len(content)))
+	_, err = writer.Write(content)
+	return err
+}
+
+// parseRendition validates requested rendition by limits of config, dimensions which are not listed in sizes
+// of config are refused, so clients can not fill cache with renditions of every size
+func (s *StorageUsecase) parseRendition(request *RenditionRequest, sourceType string) (*rendition, error) {
+	r := &rendition{
+		width:  request.Width,
+		height: request.Height,
+		fit:    imaging.Fit(request.Fit),
+		format: imaging.Format(request.Format),
+	}
+	if r.width <= 0 && r.height <= 0 {
+		return nil, v1.ErrorValidationFailed(`width or height of rendition must be positive`)
+	}
+	for _, dimension := range []int{r.width, r.height} {
+		if err := s.checkRenditionDimension(dimension); err != nil {
+			return nil, err
+		}
+	}
+
+	switch r.fit {
+	case "":
+		r.fit = imaging.FitContain
+	case imaging.FitContain, imaging.FitCover:
+	default:
+		return nil, v1.ErrorValidationFailed(`unknown fit of rendition [%s]`, request.Fit)
+	}
+	if r.width <= 0 || r.height <= 0 {
+		r.fit = imaging.FitContain // image fits box of one dimension in the only way
+	}
+
+	switch r.format {
+	case "":
+		r.format = imaging.FormatJPEG
+		if sourceType != `image/jpeg` {
+			r.format = imaging.FormatPNG // keeps transparency
+		}
+	case imaging.FormatJPEG, imaging.FormatPNG:
+	default:
+		return nil, v1.ErrorValidationFailed(`unknown format of rendition [%s]`, request.Format)
+	}
+
+	return r, nil
+}
+
+func (s *StorageUsecase) checkRenditionDimension(dimension int) error {
+	if dimension < 0 {
+		return v1.ErrorValidationFailed(`dimension of rendition must not be negative`)
+	}
+	if dimension == 0 {
+		return nil
+	}
+	if maxDimension := s.renditionMaxDimension(); dimension > maxDimension {
+		return v1.ErrorValidationFailed(`dimension of rendition %d exceeds limit %d`, dimension, maxDimension)
+	}
+	sizes := s.storage.GetRenditions().GetSizes()
+	if len(sizes) == 0 {
+		return nil
+	}
+	for _, size := range sizes {
+		if int(size) == dimension {
+			return nil
+		}
+	}
+	return v1.ErrorValidationFailed(`dimension of rendition %d is not allowed, allowed ones are %v`, dimension, sizes)
+}
+
+// render decodes image of file, orients, resizes and encodes it, count of concurrent renders is limited
+// because decoded images take a lot of memory
+func (s *StorageUsecase) render(ctx context.Context, f *ent.File, r *rendition) ([]byte, error) {
+	if maxSize := s.renditionMaxSourceSize(); int64(f.Size) > maxSize {
+		return nil, v1.ErrorValidationFailed(
+			`file [%s] of %d bytes is too large for renditions, limit is %d bytes`,
+			f.UID,
+			f.Size,
+			maxSize,
+		)
+	}
+
+	select {
+	case s.renders <- struct{}{}:
+		defer func() {
+			<-s.renders
+		}()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	source := &bytes.Buffer{}
+	if err := s.minioClient.DownloadToWriter(ctx, source, ObjectPathOf(f)); err != nil {
+		return nil, err
+	}
+
+	// dimensions are checked before image is decoded, so small file can not take memory of huge image
+	config, _, err := image.DecodeConfig(bytes.NewReader(source.Bytes()))
+	if err != nil {
+		return nil, v1.ErrorValidationFailed(`image of file [%s] can not be decoded: %s`, f.UID, err)
+	}
+	if maxPixels := s.renditionMaxSourcePixels(); int64(config.Width)*int64(config.Height) > maxPixels {
+		return nil, v1.ErrorValidationFailed(
+			`image of file [%s] of %dx%d pixels is too large for renditions, limit is %d pixels`,
+			f.UID,
+			config.Width,
+			config.Height,
+			maxPixels,
+		)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(source.Bytes()))
+	if err != nil {
+		return nil, v1.ErrorValidationFailed(`image of file [%s] can not be decoded: %s`, f.UID, err)
+	}
+
+	oriented := imaging.Orient(decoded, imaging.Orientation(source.Bytes()))
+	resized := imaging.Resize(oriented, r.width, r.height, r.fit)
+	content := &bytes.Buffer{}
+	if err = imaging.Encode(content, resized, r.format, s.renditionQuality()); err != nil {
+		return nil, err
+	}
+	s.metric.Increment(metricPrefix + `.renditions.rendered`)
+
+	return content.Bytes(), nil
+}
+
+// renditionSourceType is a type of image which rendition is made of, type detected by content is more reliable
+func renditionSourceType(f *ent.File) string {
+	if f.DetectedMimeType != "" {
+		return mediaTypeOf(f.DetectedMimeType)
+	}
+	return mediaTypeOf(f.MimeType)
+}
+
+// renditionObjectPath makes key of cached rendition, every version of file has own uid and content
+func renditionObjectPath(f *ent.File, r *rendition) string {
+	return renditionsPrefixOf(f) + r.name()
+}
+
+func renditionsPrefixOf(f *ent.File) string {
+	return renditionsPrefix + f.UID.String() + `/`
+}
+
+func (s *StorageUsecase) renditionMaxDimension() int {
+	if maxDimension := s.storage.GetRenditions().GetMaxDimension(); maxDimension > 0 {
+		return int(maxDimension)
+	}
+	return defaultRenditionMaxDimension
+}
+
+func (s *StorageUsecase) renditionMaxSourceSize() int64 {
+	if maxSize := s.storage.GetRenditions().GetMaxSourceSize(); maxSize > 0 {
+		return maxSize
+	}
+	return defaultRenditionMaxSourceSize
+}
+
+func (s *StorageUsecase) renditionMaxSourcePixels() int64 {
+	if maxPixels := s.storage.GetRenditions().GetMaxSourcePixels(); maxPixels > 0 {
+		return maxPixels
+	}
+	return defaultRenditionMaxSourcePixels
+}
+
+func (s *StorageUsecase) renditionQuality() int {
+	if quality := s.storage.GetRenditions().GetQuality(); quality > 0 && quality <= 100 {
+		return int(quality)
+	}
+	return defaultRenditionQuality
+}
+
+// renditionConcurrency is read once on start, because it sizes semaphore of renders
+func renditionConcurrency(storage *conf.Storage) int {
+	if concurrency := storage.GetRenditions().GetConcurrency(); concurrency > 0 {
+		return int(concurrency)
+	}
+	return defaultRenditionConcurrency
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"time"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+)
+
+const (
+	defaultScanBatchSize = 100
+)
+
+// storedScanStatus is a scan status of file which content is stored, content is quarantined until it is scanned,
+// scanning is disabled if there is no scanner
+func (s *StorageUsecase) storedScanStatus() fileStatus.ScanStatus {
+	if s.scanner == nil {
+		return fileStatus.ScanStatusSkipped
+	}
+	return fileStatus.ScanStatusQuarantined
+}
+
+// scanStoredFile scans content of file right after it is stored, failed scan does not fail upload,
+// file stays quarantined and is scanned again by ScanQuarantined
+func (s *StorageUsecase) scanStoredFile(ctx context.Context, f *ent.File) {
+	if err := s.scanFile(ctx, f); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to scan file [%s], it stays quarantined: %v`, f.UID.String(), err)
+	}
+}
+
+// scanFile streams stored content of quarantined file to scanner and saves its verdict,
+// error of scan is saved as result of quarantined file too
+func (s *StorageUsecase) scanFile(ctx context.Context, f *ent.File) error {
+	if s.scanner == nil || f.ScanStatus != fileStatus.ScanStatusQuarantined {
+		return nil
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		_ = writer.CloseWithError(s.minioClient.DownloadToWriter(ctx, writer, ObjectPathOf(f)))
+	}()
+	verdict, err := s.scanner.Scan(ctx, reader)
+	// scanner may stop reading before the end of content, download must not be blocked then
+	_ = reader.Close()
+
+	status, result := fileStatus.ScanStatusClean, ``
+	switch {
+	case err != nil:
+		status, result = fileStatus.ScanStatusQuarantined, err.Error()
+		s.metric.Increment(metricPrefix + `.scan.errors`)
+	case verdict.Infected:
+		status, result = fileStatus.ScanStatusInfected, verdict.Signature
+		s.metric.Increment(metricPrefix + `.scan.infected`)
+		s.logger.WithContext(ctx).Warnf(`file [%s] is infected by [%s]`, f.UID.String(), verdict.Signature)
+	default:
+		s.metric.Increment(metricPrefix + `.scan.clean`)
+	}
+
+	scannedAt := time.Now()
+	if saveErr := s.fileRepo.SaveScanResult(ctx, f.UID.String(), status, result, scannedAt); saveErr != nil {
+		return saveErr
+	}
+	f.ScanStatus = status
+	f.ScanResult = result
+	f.ScannedAt = &scannedAt
+
+	return err
+}
+
+// ScanQuarantined scans again one batch of files which scans are failed before,
+// count of files which are scanned clean or infected is returned
+func (s *StorageUsecase) ScanQuarantined(ctx context.Context) (int, error) {
+	if s.scanner == nil {
+		return 0, nil
+	}
+
+	files, err := s.fileRepo.FindQuarantined(ctx, s.scanBatchSize())
+	if err != nil {
+		return 0, err
+	}
+
+	scanned := 0
+	for _, f := range files {
+		if err = s.scanFile(ctx, f); err != nil {
+			if ctx.Err() != nil {
+				return scanned, ctx.Err()
+			}
+			s.logger.WithContext(ctx).Errorf(`failed to scan quarantined file [%s]: %v`, f.UID.String(), err)
+			continue
+		}
+		scanned++
+	}
+
+	return scanned, nil
+}
+
+// checkScanStatus refuses download of content which is not known to be free of malware
+func checkScanStatus(f *ent.File) error {
+	switch f.ScanStatus {
+	case fileStatus.ScanStatusQuarantined:
+		return v1.ErrorQuarantined(`file [%s] is quarantined until it is scanned by antivirus`, f.UID.String())
+	case fileStatus.ScanStatusInfected:
+		return v1.ErrorAccessDenied(`file [%s] is infected by [%s] and can not be downloaded`, f.UID.String(), f.ScanResult)
+	}
+	return nil
+}
+
+func (s *StorageUsecase) scanBatchSize() int {
+	if batchSize := s.storage.GetScan().GetBatchSize(); batchSize > 0 {
+		return int(batchSize)
+	}
+	return defaultScanBatchSize
+}
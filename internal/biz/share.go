@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+	"storage/internal/conf"
+)
+
+const (
+	shareTokenBytes = 32
+
+	// shareLinkPathPrefix is a prefix of public download route of share links
+	shareLinkPathPrefix = `/s/`
+)
+
+// ShareLinkLimits restrict availability of share link, link without limits is available until revoked
+type ShareLinkLimits struct {
+	ExpiresAt    *time.Time
+	Password     string
+	MaxDownloads *int
+}
+
+// ShareLinkURL returns path of public download of file by link
+func ShareLinkURL(link *ent.ShareLink) string {
+	return shareLinkPathPrefix + link.Token
+}
+
+// CreateShareLink makes link for downloading the version of file without authorization, files available
+// to their owners only can not be shared
+func (s *StorageUsecase) CreateShareLink(ctx context.Context, uid string, limits *ShareLinkLimits) (*ent.ShareLink, error) {
+	if limits == nil {
+		limits = &ShareLinkLimits{}
+	}
+	if limits.ExpiresAt != nil && !limits.ExpiresAt.After(time.Now()) {
+		return nil, v1.ErrorValidationFailed(`expiration time of link must be in the future`)
+	}
+	if limits.MaxDownloads != nil && *limits.MaxDownloads <= 0 {
+		return nil, v1.ErrorValidationFailed(`max downloads of link must be positive`)
+	}
+
+	f, err := s.sharedFileByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if f.Visibility == fileStatus.VisibilityOwner {
+		return nil, v1.ErrorAccessDenied(`file [%s] is available to its owner only and can not be shared`, uid)
+	}
+
+	passwordHash := ``
+	if limits.Password != `` {
+		hash, err := bcrypt.GenerateFromPassword([]byte(limits.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, v1.ErrorValidationFailed(`password of link is invalid: %s`, err)
+		}
+		passwordHash = string(hash)
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.shareLinkRepo.Create(ctx, &ent.ShareLink{
+		Token:        token,
+		FileID:       f.ID,
+		UserID:       f.UserID,
+		PasswordHash: passwordHash,
+		ExpiresAt:    limits.ExpiresAt,
+		MaxDownloads: limits.MaxDownloads,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.File = f
+
+	s.metric.Increment(metricPrefix + `.shares.created`)
+
+	return created, nil
+}
+
+// ShareLinks lists all links of the version of file including revoked and expired ones
+func (s *StorageUsecase) ShareLinks(ctx context.Context, uid string) ([]*ent.ShareLink, error) {
+	f, err := s.sharedFileByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.shareLinkRepo.FindByFileID(ctx, f.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		link.Edges.File = f
+	}
+
+	return links, nil
+}
+
+// RevokeShareLink makes link unavailable, revocation of already revoked link changes nothing
+func (s *StorageUsecase) RevokeShareLink(ctx context.Context, uid string) error {
+	link, err := s.shareLinkRepo.FindByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return v1.ErrorNotFound(`share link [%s] is not found`, uid)
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.checkFilePermission(ctx, link.Edges.File, `share`, scopeOfShare); err != nil {
+		return err
+	}
+
+	revoked, err := s.shareLinkRepo.Revoke(ctx, uid)
+	if revoked {
+		s.metric.Increment(metricPrefix + `.shares.revoked`)
+	}
+	return err
+}
+
+// ShareDownload writes content of file shared by link like Download does, only successful download of whole file
+// is counted, partial and conditional requests are not
+func (s *StorageUsecase) ShareDownload(
+	ctx context.Context,
+	token string,
+	password string,
+	request *DownloadRequest,
+	writer gin.ResponseWriter,
+) error {
+	link, err := s.availableShareLink(ctx, token, password)
+	if err != nil {
+		return err
+	}
+
+	// download is reserved before streaming, so concurrent downloads never exceed the limit
+	counted, err := s.shareLinkRepo.CountDownload(ctx, link.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !counted {
+		// link is exhausted or revoked by concurrent request
+		return v1.ErrorGone(`share link is not available anymore`)
+	}
+
+	request.file = link.Edges.File
+	err = s.Download(ctx, request, writer)
+	if err != nil || writer.Status() != http.StatusOK {
+		// request is cancelled when client disconnects, but reserved download must be returned anyway
+		if uncountErr := s.shareLinkRepo.UncountDownload(detachedContext{ctx}, link.ID); uncountErr != nil {
+			s.logger.WithContext(ctx).Errorf(`failed to uncount download of share link [%d]: %v`, link.ID, uncountErr)
+		}
+		return err
+	}
+	s.metric.Increment(metricPrefix + `.shares.downloads`)
+
+	return nil
+}
+
+// ShareDownloadHead writes the same headers as ShareDownload does, such requests are not counted
+func (s *StorageUsecase) ShareDownloadHead(
+	ctx context.Context,
+	token string,
+	password string,
+	request *DownloadRequest,
+	writer gin.ResponseWriter,
+) error {
+	link, err := s.availableShareLink(ctx, token, password)
+	if err != nil {
+		return err
+	}
+
+	request.file = link.Edges.File
+	return s.DownloadHead(ctx, request, writer)
+}
+
+// availableShareLink finds link which may be used for download and checks its password
+func (s *StorageUsecase) availableShareLink(ctx context.Context, token, password string) (*ent.ShareLink, error) {
+	link, err := s.shareLinkRepo.FindByToken(ctx, token)
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`share link is not found`)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case link.RevokedAt != nil:
+		return nil, v1.ErrorGone(`share link is revoked`)
+	case link.ExpiresAt != nil && !link.ExpiresAt.After(time.Now()):
+		return nil, v1.ErrorGone(`share link is expired`)
+	case link.MaxDownloads != nil && link.Downloads >= *link.MaxDownloads:
+		return nil, v1.ErrorGone(`share link is exhausted`)
+	case link.Edges.File == nil || link.Edges.File.Status != fileStatus.StatusActive:
+		return nil, v1.ErrorGone(`shared file is deleted`)
+	}
+
+	if link.PasswordHash != `` {
+		if password == `` {
+			return nil, v1.ErrorUnauthorized(`password of share link is required`)
+		}
+		if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+			return nil, v1.ErrorAccessDenied(`password of share link is wrong`)
+		}
+	}
+
+	return link, nil
+}
+
+// sharedFileByUID finds the version of file which links are managed by current user
+func (s *StorageUsecase) sharedFileByUID(ctx context.Context, uid string) (*ent.File, error) {
+	f, err := s.fileRepo.FindByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`file [%s] is not found`, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkFilePermission(ctx, f, `share`, scopeOfShare); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// newShareToken makes unguessable token of link
+func newShareToken() (string, error) {
+	token := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return ``, err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// scopeOfShare allows owners only to manage links of their files whatever their roles are
+func scopeOfShare(_ *conf.Policy_Role) conf.Policy_Role_Scope {
+	return conf.Policy_Role_own
+}
@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	// sniffLen is the count of first bytes of content which type is detected by
+	sniffLen = 512
+
+	// genericTextContentType is detected for text of unknown format
+	genericTextContentType = `text/plain`
+)
+
+// mimeTypeAliases are names of the same types, which differ in detection and in extensions of filenames
+var mimeTypeAliases = map[string]string{
+	`application/x-gzip`:           `application/gzip`,
+	`application/x-zip-compressed`: `application/zip`,
+	`application/x-rar-compressed`: `application/vnd.rar`,
+	`audio/wave`:                   `audio/wav`,
+	`audio/x-wav`:                  `audio/wav`,
+	`audio/x-aiff`:                 `audio/aiff`,
+	`video/x-msvideo`:              `video/avi`,
+	`image/vnd.microsoft.icon`:     `image/x-icon`,
+}
+
+// sniffContentType detects type of content by its first bytes without reading the rest of it,
+// returned reader streams the whole content including sniffed bytes, type of empty content is unknown
+func sniffContentType(reader io.Reader) (string, io.Reader, error) {
+	buffered := bufio.NewReaderSize(reader, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return ``, nil, err
+	}
+	return detectContentType(head), buffered, nil
+}
+
+// detectContentType returns type of content without parameters like charset, empty one for empty content
+func detectContentType(head []byte) string {
+	if len(head) == 0 {
+		return ``
+	}
+	return mediaTypeOf(http.DetectContentType(head))
+}
+
+// contentMatchesType reports whether detected type of content does not contradict type declared by extension,
+// detection recognizes a limited set of formats, so generic text and binary content matches any type
+func contentMatchesType(declared, detected string) bool {
+	declaredType := mediaTypeOf(declared)
+	detectedType := mediaTypeOf(detected)
+	switch {
+	case detectedType == declaredType:
+		return true
+	case isMarkupType(detectedType):
+		// markup may be rendered by browsers as page, so it is never accepted under other types
+		return isMarkupType(declaredType)
+	case detectedType == defaultContentType || detectedType == genericTextContentType:
+		return true
+	case declaredType == defaultContentType:
+		return true
+	case detectedType == `application/zip`:
+		return isZipContainerType(declaredType)
+	case detectedType == `application/ogg`:
+		return declaredType == `audio/ogg` || declaredType == `video/ogg`
+	}
+	return false
+}
+
+// isMarkupType reports whether type is html or xml document
+func isMarkupType(mediaType string) bool {
+	switch mediaType {
+	case `text/html`, `application/xhtml+xml`, `text/xml`, `application/xml`:
+		return true
+	}
+	return strings.HasSuffix(mediaType, `+xml`)
+}
+
+// isZipContainerType reports whether files of type are zip archives, like office documents or java archives
+func isZipContainerType(mediaType string) bool {
+	switch mediaType {
+	case `application/java-archive`, `application/vnd.android.package-archive`:
+		return true
+	}
+	return strings.HasSuffix(mediaType, `+zip`) ||
+		strings.HasPrefix(mediaType, `application/vnd.openxmlformats-officedocument.`) ||
+		strings.HasPrefix(mediaType, `application/vnd.oasis.opendocument.`)
+}
+
+// mediaTypeOf returns lowercase type without parameters with aliases resolved
+func mediaTypeOf(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(contentType, `;`)
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	}
+	if alias, ok := mimeTypeAliases[mediaType]; ok {
+		return alias
+	}
+	return mediaType
+}
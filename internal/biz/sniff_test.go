@@ -0,0 +1,55 @@
+package biz
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSniffContentType(t *testing.T) {
+	content := `%PDF-1.4 ` + strings.Repeat(`waybill `, 100)
+	detected, reader, err := sniffContentType(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, `application/pdf`, detected)
+
+	streamed, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, content, string(streamed))
+
+	detected, _, err = sniffContentType(strings.NewReader(``))
+	require.NoError(t, err)
+	require.Empty(t, detected)
+}
+
+func TestContentMatchesType(t *testing.T) {
+	testCases := []struct {
+		declared string
+		detected string
+		expected bool
+	}{
+		{declared: `application/pdf`, detected: `application/pdf`, expected: true},
+		{declared: `text/html; charset=utf-8`, detected: `text/html`, expected: true},
+		{declared: `application/pdf`, detected: `text/plain`, expected: true},
+		{declared: `video/quicktime`, detected: `application/octet-stream`, expected: true},
+		{declared: `application/octet-stream`, detected: `image/png`, expected: true},
+		{declared: `image/svg+xml`, detected: `text/xml`, expected: true},
+		{declared: `application/gzip`, detected: `application/x-gzip`, expected: true},
+		{declared: `audio/x-wav`, detected: `audio/wave`, expected: true},
+		{declared: `application/vnd.openxmlformats-officedocument.wordprocessingml.document`, detected: `application/zip`, expected: true},
+		{declared: `application/epub+zip`, detected: `application/zip`, expected: true},
+		{declared: `audio/ogg`, detected: `application/ogg`, expected: true},
+		{declared: `application/pdf`, detected: `text/html`, expected: false},
+		{declared: `application/octet-stream`, detected: `text/html`, expected: false},
+		{declared: `text/plain`, detected: `text/xml`, expected: false},
+		{declared: `image/jpeg`, detected: `image/png`, expected: false},
+		{declared: `application/pdf`, detected: `application/zip`, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.declared+`_`+testCase.detected, func(t *testing.T) {
+			require.Equal(t, testCase.expected, contentMatchesType(testCase.declared, testCase.detected))
+		})
+	}
+}
@@ -2,14 +2,19 @@ package biz
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/AlekSi/pointer"
 	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/gosimple/slug"
@@ -18,44 +23,68 @@ import (
 
 	v1 "storage/api/storage/v1"
 	"storage/ent"
+	fileStatus "storage/ent/file"
 	"storage/internal/clients/auth"
+	"storage/internal/clients/clamd"
 	"storage/internal/clients/minio"
 	"storage/internal/conf"
+	"storage/internal/data"
 )
 
 const (
 	metricPrefix = `biz.storage`
 
 	defaultContentType = `application/octet-stream`
+
+	defaultDownloadURLExpiry = 15 * time.Minute
 )
 
 type StorageUsecase struct {
-	authClient                  auth.Client
-	minioClient                 minio.Client
-	fileRepo                    fileRepository
-	auth                        *conf.Auth
-	metric                      metrics.Metrics
-	logger                      *log.Helper
-	useAuthorizationForDownload bool
+	authClient    auth.Client
+	minioClient   minio.Client
+	scanner       clamd.Client
+	fileRepo      fileRepository
+	multipartRepo multipartRepository
+	blobRepo      blobRepository
+	shareLinkRepo shareLinkRepository
+	auth          *conf.Auth
+	storage       *conf.Storage
+	policy        *conf.Policy
+	metric        metrics.Metrics
+	logger        *log.Helper
+	renders       chan struct{}
+	reconcile     onDemandReconcile
 }
 
 func NewStorageUsecase(
 	authClient auth.Client,
 	minioClient minio.Client,
+	scanner clamd.Client,
 	fileRepo fileRepository,
+	multipartRepo multipartRepository,
+	blobRepo blobRepository,
+	shareLinkRepo shareLinkRepository,
 	auth *conf.Auth,
+	storage *conf.Storage,
+	policy *conf.Policy,
 	metric metrics.Metrics,
 	logs log.Logger,
 ) *StorageUsecase {
 	loggerHelper := logger.NewHelper(logs, "ts", log.DefaultTimestamp, "scope", metricPrefix)
 	return &StorageUsecase{
-		authClient:                  authClient,
-		minioClient:                 minioClient,
-		fileRepo:                    fileRepo,
-		auth:                        auth,
-		metric:                      metric,
-		logger:                      loggerHelper,
-		useAuthorizationForDownload: false,
+		authClient:    authClient,
+		minioClient:   minioClient,
+		scanner:       scanner,
+		fileRepo:      fileRepo,
+		multipartRepo: multipartRepo,
+		blobRepo:      blobRepo,
+		shareLinkRepo: shareLinkRepo,
+		auth:          auth,
+		storage:       storage,
+		policy:        policy,
+		metric:        metric,
+		logger:        loggerHelper,
+		renders:       make(chan struct{}, renditionConcurrency(storage)),
 	}
 }
 
@@ -63,6 +92,11 @@ type UploadFile struct {
 	Reader   io.Reader
 	Size     int64
 	Filename string
+	// Visibility is one of file visibilities, empty one means public file
+	Visibility string
+	// Digest and ChecksumSHA256 are optional checksums of content declared by client
+	Digest         string
+	ChecksumSHA256 string
 }
 
 func slugFromFilename(filename string) string {
@@ -78,93 +112,469 @@ func (s *StorageUsecase) Upload(ctx context.Context, file *UploadFile) (*ent.Fil
 	if file == nil {
 		return nil, fmt.Errorf(`file is empty`)
 	}
-	var err error
-	var user *AuthenticatedUser
-	if !s.isIntegrations(ctx) {
-		if user, err = s.user(ctx); err != nil {
-			return nil, err
-		}
+	userID, role, err := s.uploader(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	userID := 0 // for integrations
-	if user != nil {
-		userID = int(user.ID())
+	expected, err := parseExpectedChecksums(file.Digest, file.ChecksumSHA256)
+	if err != nil {
+		return nil, err
 	}
-
-	contentType := mime.TypeByExtension(filepath.Ext(file.Filename))
-	if contentType == "" {
-		contentType = defaultContentType
+	visibility, err := parseVisibility(file.Visibility)
+	if err != nil {
+		return nil, err
 	}
 
-	objectPath := makeObjectPath(userID, file.Filename)
-	found, err := s.fileRepo.FindByObjectPath(ctx, objectPath)
-	if err != nil && ent.IsNotFound(err) {
-		err = nil
+	contentType := contentTypeByFilename(file.Filename)
+	if err = s.checkUploadPolicy(role, file.Filename, file.Size); err != nil {
+		return nil, err
+	}
+	declaredSize := file.Size
+	if declaredSize < 0 {
+		declaredSize = 0
 	}
+	if err = s.checkQuota(ctx, userID, role, declaredSize, 1); err != nil {
+		return nil, err
+	}
+
+	limited := newLimitedReader(file.Reader, s.uploadMaxSize(role))
+	detected, content, err := sniffContentType(limited)
 	if err != nil {
 		return nil, err
 	}
-	if found != nil {
-		return nil, v1.ErrorValidationFailed(`file with object path [%s] is already exists`, objectPath)
+	if err = s.checkDetectedType(role, contentType, detected); err != nil {
+		return nil, err
+	}
+
+	logicalPath := makeObjectPath(userID, file.Filename)
+	objectPath, err := s.newObjectPath(ctx, userID, file.Filename)
+	if err != nil {
+		return nil, err
 	}
 
 	saved, err := s.fileRepo.Create(ctx, &ent.File{
-		UserID:     userID,
-		Filename:   file.Filename,
-		ObjectPath: objectPath,
-		Size:       int(file.Size),
-		MimeType:   contentType,
-		DeletedAt:  pointer.ToTime(time.Now()), // For restore after success upload
+		UserID:           userID,
+		Filename:         file.Filename,
+		ObjectPath:       objectPath,
+		LogicalPath:      logicalPath,
+		Size:             int(declaredSize),
+		MimeType:         contentType,
+		DetectedMimeType: detected,
+		Status:           fileStatus.StatusPending,
+		Visibility:       visibility,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = s.minioClient.UploadFromReader(ctx, file.Reader, file.Size, contentType, objectPath)
+	// keys of layouts other than slug are unique, so uploaded object becomes content of new blob as it is,
+	// under slug layout content with declared checksum goes right to path of new blob, so it is not copied after upload
+	uploadedPath := objectPath
+	uniqueKey := s.storage.GetKeys().GetLayout() != conf.Storage_Keys_slug
+	if expected.sha256 != nil && !uniqueKey {
+		uploadedPath = s.newBlobObjectPath(hex.EncodeToString(expected.sha256), file.Filename)
+		uniqueKey = true
+	}
+
+	checksums := newChecksumReader(content)
+	uploadInfo, err := s.minioClient.UploadFromReader(ctx, checksums, file.Size, contentType, uploadedPath)
+	if limited.Exceeded() {
+		// failed upload never replaces object, so there is nothing to remove
+		s.failFile(ctx, saved)
+		s.metric.Increment(metricPrefix + `.upload.too_large`)
+		return nil, limited.err()
+	}
+	if err != nil {
+		s.failFile(ctx, saved)
+		return saved, err
+	}
 
+	// size of content sent in chunks is known only after upload, its pending file is already counted in usage
+	err = s.checkUploadPolicy(role, file.Filename, uploadInfo.Size)
+	if err == nil && file.Size < 0 {
+		err = s.checkQuota(ctx, userID, role, uploadInfo.Size-int64(saved.Size), 0)
+	}
+	if err == nil {
+		err = expected.verify(checksums.SHA256(), checksums.MD5())
+	}
 	if err == nil {
-		err = s.fileRepo.Restore(ctx, saved.UID.String())
+		err = checksums.verifyETag(uploadInfo.ETag)
+	}
+	if err != nil {
+		s.failFile(ctx, saved)
+		if removeErr := s.minioClient.Remove(ctx, uploadedPath); removeErr != nil {
+			return nil, removeErr
+		}
+		return nil, err
+	}
+
+	saved.Sha256 = hex.EncodeToString(checksums.SHA256())
+	saved.Md5 = hex.EncodeToString(checksums.MD5())
+
+	blob, err := s.storeBlob(ctx, saved, uploadedPath, uniqueKey, uploadInfo.Size, uploadInfo.ETag)
+	if err != nil {
+		s.failFile(ctx, saved)
+		return saved, err
+	}
+	saved.BlobID = &blob.ID
+	saved.Edges.Blob = blob
+
+	err = s.activateFile(ctx, saved, uploadInfo.Size, blob.Etag, uploadInfo.LastModified)
+	if err != nil {
+		s.releaseBlobQuietly(ctx, blob)
+		s.failFile(ctx, saved)
+		return saved, err
+	}
+	s.scanStoredFile(ctx, saved)
+
+	return saved, nil
+}
+
+// failFile marks pending file as failed, so it is not taken for deleted or stuck one,
+// error is only logged because the reason of failure is more important for caller
+func (s *StorageUsecase) failFile(ctx context.Context, f *ent.File) {
+	if err := s.fileRepo.Fail(ctx, f.UID.String()); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to mark file [%s] as failed: %v`, f.UID.String(), err)
+		return
+	}
+	f.Status = fileStatus.StatusFailed
+}
+
+// activateFile makes pending file available with actual size and validators of its uploaded object,
+// content is quarantined until it is scanned if scanner is enabled
+func (s *StorageUsecase) activateFile(
+	ctx context.Context,
+	f *ent.File,
+	size int64,
+	etag string,
+	lastModified time.Time,
+) error {
+	lastModified = lastModifiedOrNow(lastModified)
+	f.Size = int(size)
+	f.Etag = etag
+	f.LastModified = &lastModified
+	f.ScanStatus = s.storedScanStatus()
+	if err := s.fileRepo.Activate(ctx, f); err != nil {
+		return statusTransitionError(err)
+	}
+	f.Status = fileStatus.StatusActive
+	return nil
+}
+
+// statusTransitionError makes conflict of file status readable for clients
+func statusTransitionError(err error) error {
+	if errors.Is(err, data.ErrStatusTransition) {
+		return v1.ErrorConflict(`%s`, err)
+	}
+	return err
+}
+
+// lastModifiedOrNow is needed because s3 does not return modification time on upload
+func lastModifiedOrNow(lastModified time.Time) time.Time {
+	if lastModified.IsZero() {
+		return time.Now().UTC()
+	}
+	return lastModified.UTC()
+}
+
+// currentUserID returns identifier of authenticated user or zero for integrations
+func (s *StorageUsecase) currentUserID(ctx context.Context) (int, error) {
+	if s.isIntegrations(ctx) {
+		return 0, nil
+	}
+	user, err := s.user(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int(user.ID()), nil
+}
+
+func contentTypeByFilename(filename string) string {
+	contentType := mime.TypeByExtension(filepath.Ext(filename))
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	return contentType
+}
+
+func (s *StorageUsecase) checkObjectPathIsFree(ctx context.Context, objectPath string) error {
+	owned, err := s.fileRepo.HasObjectOwner(ctx, objectPath)
+	if err != nil {
+		return err
+	}
+	if owned {
+		return v1.ErrorValidationFailed(`file with object path [%s] is already exists`, objectPath)
+	}
+	return nil
+}
+
+// DownloadRequest is a file to download with values of range and conditional headers of request
+type DownloadRequest struct {
+	UID string
+	// Version is a number of file version, zero means the latest version
+	Version         int
+	Range           string
+	IfRange         string
+	IfNoneMatch     string
+	IfModifiedSince string
+
+	// file is a shared file which is found and checked by its link
+	file *ent.File
+}
+
+func (s *StorageUsecase) Download(ctx context.Context, request *DownloadRequest, writer gin.ResponseWriter) error {
+	f, answered, err := s.downloadHeaders(ctx, request, writer)
+	if err != nil || answered {
+		return err
+	}
+	if request.Range != "" && ifRangeMatches(f, request.IfRange) {
+		return s.downloadRanges(ctx, f, request.Range, writer)
+	}
+	writer.Header().Set(`Content-Type`, f.MimeType)
+	writer.Header().Set(`Content-Length`, strconv.Itoa(f.Size))
+	return s.minioClient.DownloadToWriter(ctx, writer, ObjectPathOf(f))
+}
+
+// DownloadHead writes the same headers as Download does, but without content of file
+func (s *StorageUsecase) DownloadHead(ctx context.Context, request *DownloadRequest, writer gin.ResponseWriter) error {
+	f, answered, err := s.downloadHeaders(ctx, request, writer)
+	if err != nil || answered {
+		return err
+	}
+	writer.Header().Set(`Content-Type`, f.MimeType)
+	writer.Header().Set(`Content-Length`, strconv.Itoa(f.Size))
+	writer.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// downloadHeaders writes validators of file and answers 304 to conditional request of not modified file,
+// nothing else must be written to answered request
+func (s *StorageUsecase) downloadHeaders(
+	ctx context.Context,
+	request *DownloadRequest,
+	writer gin.ResponseWriter,
+) (f *ent.File, answered bool, err error) {
+	f = request.file
+	if f == nil {
+		f, err = s.downloadableFile(ctx, request.UID, request.Version)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if err = checkScanStatus(f); err != nil {
+		return nil, false, err
+	}
+	if err = s.ensureObjectInfo(ctx, f); err != nil {
+		return nil, false, err
 	}
 
-	return saved, err
+	writer.Header().Set(`Cache-Control`, `private, no-cache`)
+	if f.Etag != "" {
+		writer.Header().Set(`ETag`, quoteETag(f.Etag))
+	}
+	if f.LastModified != nil {
+		writer.Header().Set(`Last-Modified`, f.LastModified.UTC().Format(http.TimeFormat))
+	}
+	if notModified(f, request.IfNoneMatch, request.IfModifiedSince) {
+		writer.WriteHeader(http.StatusNotModified)
+		return f, true, nil
+	}
+
+	writer.Header().Set(`Accept-Ranges`, `bytes`)
+	writer.Header().Set(`Content-Disposition`, contentDisposition(f))
+	return f, false, nil
 }
 
-func (s *StorageUsecase) Download(ctx context.Context, uid string, writer gin.ResponseWriter) error {
-	if s.useAuthorizationForDownload && !s.isIntegrations(ctx) {
-		if _, err := s.user(ctx); err != nil {
+// ensureObjectInfo fills validators of files uploaded before they were stored from object in s3 storage
+func (s *StorageUsecase) ensureObjectInfo(ctx context.Context, f *ent.File) error {
+	if f.Etag != "" && f.LastModified != nil {
+		return nil
+	}
+	info, err := s.minioClient.StatObject(ctx, ObjectPathOf(f))
+	if minio.IsNotFound(err) {
+		return v1.ErrorNotFound(`object of file [%s] is not found in storage`, f.UID.String())
+	}
+	if err != nil {
+		return err
+	}
+	lastModified := lastModifiedOrNow(info.LastModified)
+	err = s.fileRepo.UpdateObjectInfo(ctx, f.UID.String(), int(info.Size), info.ETag, lastModified)
+	if err != nil {
+		return err
+	}
+	f.Size = int(info.Size)
+	f.Etag = info.ETag
+	f.LastModified = &lastModified
+	return nil
+}
+
+// downloadRanges writes requested ranges of file, size of content is taken from file,
+// because downloadHeaders has filled it from object already
+func (s *StorageUsecase) downloadRanges(
+	ctx context.Context,
+	f *ent.File,
+	rangeHeader string,
+	writer gin.ResponseWriter,
+) error {
+	size := int64(f.Size)
+
+	ranges, err := parseRange(rangeHeader, size)
+	if err != nil {
+		writer.Header().Set(`Content-Range`, fmt.Sprintf(`bytes */%d`, size))
+		return v1.ErrorRangeNotSatisfiable(`range [%s] is not satisfiable: %s`, rangeHeader, err)
+	}
+	if len(ranges) == 0 || sumRangesSize(ranges) > size {
+		// ranges cover more than the whole content, so it is cheaper to send it at once
+		writer.Header().Set(`Content-Type`, f.MimeType)
+		writer.Header().Set(`Content-Length`, strconv.Itoa(f.Size))
+		return s.minioClient.DownloadToWriter(ctx, writer, ObjectPathOf(f))
+	}
+
+	if len(ranges) == 1 {
+		r := ranges[0]
+		writer.Header().Set(`Content-Type`, f.MimeType)
+		writer.Header().Set(`Content-Range`, r.contentRange(size))
+		writer.Header().Set(`Content-Length`, strconv.FormatInt(r.length, 10))
+		writer.WriteHeader(http.StatusPartialContent)
+		return s.minioClient.DownloadRangeToWriter(ctx, writer, ObjectPathOf(f), r.start, r.length)
+	}
+
+	body := multipart.NewWriter(writer)
+	writer.Header().Set(`Content-Type`, `multipart/byteranges; boundary=`+body.Boundary())
+	writer.Header().Set(
+		`Content-Length`,
+		strconv.FormatInt(rangesMIMESize(ranges, f.MimeType, size, body.Boundary()), 10),
+	)
+	writer.WriteHeader(http.StatusPartialContent)
+	for _, r := range ranges {
+		part, err := body.CreatePart(r.mimeHeader(f.MimeType, size))
+		if err != nil {
+			return err
+		}
+		if err = s.minioClient.DownloadRangeToWriter(ctx, part, ObjectPathOf(f), r.start, r.length); err != nil {
 			return err
 		}
 	}
+	return body.Close()
+}
+
+// DownloadURL returns presigned url of file object if download must be redirected to s3 storage,
+// empty url means that file must be proxied by Download. Empty mode means mode from config.
+func (s *StorageUsecase) DownloadURL(ctx context.Context, uid string, version int, mode string) (string, error) {
+	redirect, err := s.isRedirectDownload(mode)
+	if err != nil || !redirect {
+		return "", err
+	}
+
+	f, err := s.downloadableFile(ctx, uid, version)
+	if err != nil {
+		return "", err
+	}
+	if err = checkScanStatus(f); err != nil {
+		return "", err
+	}
 
+	params := url.Values{}
+	params.Set(`response-content-type`, f.MimeType)
+	params.Set(`response-content-disposition`, contentDisposition(f))
+
+	presigned, err := s.minioClient.PresignedGetURL(ctx, ObjectPathOf(f), s.downloadURLExpiry(), params)
+	if errors.Is(err, minio.ErrPresignNotSupported) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return presigned.String(), nil
+}
+
+// downloadableFile finds the latest version of file by uid of any its version or the requested version,
+// access is checked by visibility of the found version
+func (s *StorageUsecase) downloadableFile(ctx context.Context, uid string, version int) (*ent.File, error) {
 	f, err := s.fileRepo.FindByUID(ctx, uid)
 	if err != nil {
-		return err
+		if ent.IsNotFound(err) {
+			return nil, v1.ErrorNotFound(`file [%s] is not found`, uid)
+		}
+		return nil, err
 	}
-	writer.Header().Set(`Content-Type`, f.MimeType)
-	disposition := "inline"
-	if !strings.HasPrefix(f.MimeType, "image/") {
-		disposition = fmt.Sprintf(`attachment; filename="%s"`, f.Filename)
+	found, err := s.fileVersion(ctx, f, version)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkDownloadAccess(ctx, found); err != nil {
+		return nil, err
 	}
-	writer.Header().Set(`Content-Disposition`, disposition)
-	return s.minioClient.DownloadToWriter(ctx, writer, f.ObjectPath)
+	return found, nil
 }
 
-func (s *StorageUsecase) FilesList(ctx context.Context) ([]*ent.File, error) {
-	var err error
-	var user *AuthenticatedUser
-	if !s.isIntegrations(ctx) {
-		if user, err = s.user(ctx); err != nil {
+func (s *StorageUsecase) isRedirectDownload(mode string) (bool, error) {
+	if mode == "" {
+		return s.storage.GetDownload().GetMode() == conf.Storage_Download_redirect, nil
+	}
+	value, ok := conf.Storage_Download_Mode_value[mode]
+	if !ok {
+		return false, v1.ErrorValidationFailed(`unknown download mode [%s]`, mode)
+	}
+	return conf.Storage_Download_Mode(value) == conf.Storage_Download_redirect, nil
+}
+
+func (s *StorageUsecase) downloadURLExpiry() time.Duration {
+	if expiry := s.storage.GetDownload().GetUrlExpiry(); expiry != nil && expiry.AsDuration() > 0 {
+		return expiry.AsDuration()
+	}
+	return defaultDownloadURLExpiry
+}
+
+// contentDisposition shows images in browser and makes other files downloadable with original filename
+func contentDisposition(f *ent.File) string {
+	if strings.HasPrefix(f.MimeType, "image/") {
+		return "inline"
+	}
+	return fmt.Sprintf(`attachment; filename="%s"`, f.Filename)
+}
+
+// FilesList lists active files of current user, users which role lists all files may list files of all users
+// in any status
+func (s *StorageUsecase) FilesList(ctx context.Context, status string, all bool) ([]*ent.File, error) {
+	limit := 100
+	offset := 0
+
+	if status == "" {
+		status = fileStatus.StatusActive.String()
+	}
+	if err := fileStatus.StatusValidator(fileStatus.Status(status)); err != nil {
+		return nil, v1.ErrorValidationFailed(`unknown file status [%s]`, status)
+	}
+
+	if all || status != fileStatus.StatusActive.String() {
+		user, err := s.user(ctx)
+		if err != nil {
 			return nil, err
 		}
+		if s.role(user).GetList() != conf.Policy_Role_all {
+			return nil, v1.ErrorAccessDenied(`users of type [%s] may not list files of all users`, user.AuthUser().Type)
+		}
+		if status == fileStatus.StatusActive.String() {
+			return s.fileRepo.FindActive(ctx, limit, offset)
+		}
+		return s.fileRepo.FindByStatus(ctx, fileStatus.Status(status), limit, offset)
 	}
 
-	userID := 0 // for integrations
-	if user != nil {
+	userID := 0
+	if !s.isIntegrations(ctx) {
+		user, err := s.user(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s.role(user).GetList() == conf.Policy_Role_none {
+			return nil, v1.ErrorAccessDenied(`users of type [%s] may not list files`, user.AuthUser().Type)
+		}
 		userID = int(user.ID())
 	}
 
-	limit := 100
-	offset := 0
 	files, err := s.fileRepo.FindByUserID(ctx, userID, limit, offset)
 
 	return files, err
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"context"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+)
+
+// DeleteFile moves file to trash, its content is kept until purge, so file may be restored
+func (s *StorageUsecase) DeleteFile(ctx context.Context, uid string) error {
+	f, err := s.fileRepo.FindByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return v1.ErrorNotFound(`file [%s] is not found`, uid)
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.checkFilePermission(ctx, f, `delete`, scopeOfDelete); err != nil {
+		return err
+	}
+
+	if err = s.fileRepo.Delete(ctx, uid); err != nil {
+		return statusTransitionError(err)
+	}
+	return nil
+}
+
+// RestoreFile makes file from trash active again, restored version is the latest one only if it has the greatest number
+func (s *StorageUsecase) RestoreFile(ctx context.Context, uid string) (*ent.File, error) {
+	f, err := s.fileRepo.FindDeletedByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`deleted file [%s] is not found`, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkFilePermission(ctx, f, `restore`, scopeOfRestore); err != nil {
+		return nil, err
+	}
+
+	if err = s.fileRepo.Restore(ctx, uid); err != nil {
+		return nil, statusTransitionError(err)
+	}
+	f.Status = fileStatus.StatusActive
+	f.DeletedAt = nil
+
+	return f, nil
+}
+
+// TrashList lists deleted files of current user, the last deleted files go first
+func (s *StorageUsecase) TrashList(ctx context.Context) ([]*ent.File, error) {
+	limit := 100
+	offset := 0
+
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := s.fileRepo.FindDeletedByUserID(ctx, userID, limit, offset)
+
+	return files, err
+}
@@ -0,0 +1,388 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	"storage/ent/multipart"
+	"storage/internal/clients/minio"
+)
+
+const (
+	// tusPartSize is a size of parts which chunks of tus upload are regrouped into, chunks may be of any size,
+	// bytes which are not enough for a part wait for the next chunk in pending object
+	tusPartSize = multipartMinPartSize
+
+	// tusPendingPrefix is a prefix of pending objects, they are not referenced by files
+	tusPendingPrefix = `tus/`
+
+	// tusLockTimeout is how long request appending chunk keeps tus upload locked without storing a part,
+	// lock of request which is lost with its instance is taken over after it
+	tusLockTimeout = 5 * time.Minute
+)
+
+// TusUpload is a multipart upload driven by tus protocol, where chunks are appended one after another
+type TusUpload struct {
+	Multipart *ent.Multipart
+	Offset    int64
+	Length    int64
+
+	// lock is identifier of request which appends chunk, upload which is just created needs no lock
+	lock uuid.UUID
+}
+
+// Completed reports that all bytes are received and file is created
+func (u *TusUpload) Completed() bool {
+	return u.Multipart.Status == multipart.StatusCompleted && u.Multipart.FileUID != nil
+}
+
+// TusCreate starts tus upload of file with known total length, file of zero length is created at once
+func (s *StorageUsecase) TusCreate(ctx context.Context, filename, visibility string, length int64) (*TusUpload, error) {
+	if length < 0 {
+		return nil, v1.ErrorValidationFailed(`upload length must not be negative`)
+	}
+	uploadLength := int(length)
+
+	upload, err := s.initiateMultipart(ctx, filename, visibility, &uploadLength)
+	if err != nil {
+		return nil, err
+	}
+
+	tus := &TusUpload{
+		Multipart: upload.Multipart,
+		Offset:    0,
+		Length:    length,
+	}
+	if length == 0 {
+		return s.tusAppend(ctx, tus, nil, bytes.NewReader(nil), 0)
+	}
+
+	return tus, nil
+}
+
+// TusOffset returns tus upload with count of already stored bytes, so client can resume upload from there
+func (s *StorageUsecase) TusOffset(ctx context.Context, uid string) (*TusUpload, error) {
+	upload, err := s.tusMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	tus, _, err := s.tusState(ctx, upload)
+	return tus, err
+}
+
+// TusPatch appends chunk starting from offset to tus upload and creates file after the last chunk,
+// received bytes are kept even if chunk is interrupted, so client resumes from offset returned by TusOffset
+func (s *StorageUsecase) TusPatch(
+	ctx context.Context,
+	uid string,
+	offset int64,
+	reader io.Reader,
+	size int64,
+) (*TusUpload, error) {
+	upload, err := s.tusMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// state is read under lock, so concurrent chunks never pass offset check both
+	lock, err := s.lockTus(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	defer s.unlockTus(ctx, upload, lock)
+
+	tus, parts, err := s.tusState(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	tus.lock = lock
+	if tus.Multipart.Status != multipart.StatusActive {
+		return nil, v1.ErrorValidationFailed(`tus upload [%s] is already %s`, uid, tus.Multipart.Status)
+	}
+	if offset != tus.Offset {
+		return nil, v1.ErrorConflict(`upload offset %d does not match current offset %d`, offset, tus.Offset)
+	}
+	if size < 0 {
+		return nil, v1.ErrorValidationFailed(`chunk must have known content length`)
+	}
+	if err = checkUploadSize(size, s.uploadMaxPartSize()); err != nil {
+		return nil, err
+	}
+	if offset+size > tus.Length {
+		return nil, v1.ErrorValidationFailed(`chunk exceeds upload length %d`, tus.Length)
+	}
+
+	return s.tusAppend(ctx, tus, parts, reader, size)
+}
+
+// TusTerminate cancels tus upload and removes its stored chunks
+func (s *StorageUsecase) TusTerminate(ctx context.Context, uid string) error {
+	upload, err := s.tusMultipart(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if err = s.MultipartAbort(ctx, uid); err != nil {
+		return err
+	}
+	s.removeTusPending(ctx, upload)
+	return nil
+}
+
+// tusAppend regroups pending bytes and chunk into parts of multipart upload, bytes which are not enough for a part
+// are stored as pending object, upload is completed when all bytes are received
+func (s *StorageUsecase) tusAppend(
+	ctx context.Context,
+	tus *TusUpload,
+	parts []*ent.MultipartPart,
+	reader io.Reader,
+	size int64,
+) (*TusUpload, error) {
+	upload := tus.Multipart
+	partNumber := len(parts) + 1
+	stored := partsSize(parts)
+
+	source, pendingPart := io.LimitReader(reader, size), 0
+	if pending := tus.Offset - stored; pending > 0 {
+		content := &bytes.Buffer{}
+		if err := s.minioClient.DownloadToWriter(ctx, content, tusPendingPath(upload, partNumber)); err != nil {
+			return nil, err
+		}
+		source, pendingPart = io.MultiReader(content, source), partNumber
+	}
+
+	for {
+		want := tus.Length - stored
+		if want > tusPartSize {
+			want = tusPartSize
+		}
+		buffer := make([]byte, want)
+		n, readErr := io.ReadFull(source, buffer)
+		if int64(n) < want {
+			// chunk is over or interrupted, received bytes wait for the next chunk
+			tus.Offset = stored + int64(n)
+			if err := s.saveTusPending(ctx, upload, partNumber, buffer[:n]); err != nil {
+				return nil, err
+			}
+			if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+				return nil, readErr
+			}
+			return tus, nil
+		}
+
+		if err := s.tusUploadPart(ctx, upload, partNumber, buffer); err != nil {
+			return nil, err
+		}
+		if err := s.renewTusLock(ctx, tus); err != nil {
+			return nil, err
+		}
+		if partNumber == pendingPart {
+			s.removeTusPendingPart(ctx, upload, partNumber)
+		}
+		stored += want
+		tus.Offset = stored
+		if stored == tus.Length {
+			break
+		}
+		partNumber++
+	}
+
+	file, err := s.completeMultipart(ctx, upload)
+	if err != nil {
+		// the last part is forgotten, so client sees that upload is not finished and sends it again later,
+		// which repeats completion
+		if deleteErr := s.multipartRepo.DeletePart(ctx, upload.ID, partNumber); deleteErr != nil {
+			s.logger.WithContext(ctx).Errorf(`failed to forget the last part of tus upload [%s]: %v`, upload.UID, deleteErr)
+		}
+		return nil, err
+	}
+	upload.Status = multipart.StatusCompleted
+	upload.FileUID = &file.UID
+
+	return tus, nil
+}
+
+// tusUploadPart stores part of tus upload, type of file is checked by the first part
+func (s *StorageUsecase) tusUploadPart(ctx context.Context, upload *ent.Multipart, partNumber int, content []byte) error {
+	if partNumber > multipartMaxPartNumber {
+		return v1.ErrorValidationFailed(`tus upload can not have more than %d parts`, multipartMaxPartNumber)
+	}
+	_, role, err := s.uploader(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, detected := io.Reader(bytes.NewReader(content)), ``
+	if partNumber == multipartMinPartNumber {
+		if detected, reader, err = s.sniffFirstPart(upload, role, reader); err != nil {
+			return err
+		}
+	}
+
+	uploaded, err := s.minioClient.UploadPart(ctx, upload.ObjectPath, upload.UploadID, partNumber, reader, int64(len(content)))
+	if err != nil {
+		return err
+	}
+	if partNumber == multipartMinPartNumber {
+		if err = s.saveDetectedMimeType(ctx, upload, detected); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.multipartRepo.SavePart(ctx, &ent.MultipartPart{
+		MultipartID: upload.ID,
+		PartNumber:  partNumber,
+		Etag:        uploaded.ETag,
+		Size:        int(uploaded.Size),
+	})
+	return err
+}
+
+// lockTus locks tus upload for request which appends chunk, request which does not get lock is answered
+// with conflict like request with stale offset, client asks for offset and tries again
+func (s *StorageUsecase) lockTus(ctx context.Context, upload *ent.Multipart) (uuid.UUID, error) {
+	lock := uuid.New()
+	now := time.Now()
+	locked, err := s.multipartRepo.Lock(ctx, upload.ID, lock, now, now.Add(tusLockTimeout))
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !locked {
+		return uuid.Nil, v1.ErrorConflict(`tus upload [%s] is appended by another request`, upload.UID)
+	}
+	return lock, nil
+}
+
+// renewTusLock prolongs lock after every stored part, so long chunk keeps upload locked until it is received
+func (s *StorageUsecase) renewTusLock(ctx context.Context, tus *TusUpload) error {
+	if tus.lock == uuid.Nil {
+		return nil
+	}
+	now := time.Now()
+	locked, err := s.multipartRepo.Lock(ctx, tus.Multipart.ID, tus.lock, now, now.Add(tusLockTimeout))
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return v1.ErrorConflict(`lock of tus upload [%s] is taken over by another request`, tus.Multipart.UID)
+	}
+	return nil
+}
+
+// unlockTus releases lock even if request is interrupted already, error is only logged,
+// because lock expires anyway
+func (s *StorageUsecase) unlockTus(ctx context.Context, upload *ent.Multipart, lock uuid.UUID) {
+	if err := s.multipartRepo.Unlock(detachedContext{ctx}, upload.ID, lock); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to unlock tus upload [%s]: %v`, upload.UID, err)
+	}
+}
+
+// tusState counts bytes of tus upload stored in parts and in pending object of the next part
+func (s *StorageUsecase) tusState(ctx context.Context, upload *ent.Multipart) (*TusUpload, []*ent.MultipartPart, error) {
+	parts, err := s.multipartRepo.FindParts(ctx, upload.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tus := &TusUpload{
+		Multipart: upload,
+		Offset:    partsSize(parts),
+		Length:    int64(*upload.UploadLength),
+	}
+	if upload.Status != multipart.StatusActive {
+		return tus, parts, nil
+	}
+
+	info, err := s.minioClient.StatObject(ctx, tusPendingPath(upload, len(parts)+1))
+	if minio.IsNotFound(err) {
+		return tus, parts, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	tus.Offset += info.Size
+
+	return tus, parts, nil
+}
+
+// saveTusPending stores bytes which are not enough for a part, request may be interrupted already,
+// so they are stored regardless of its context
+func (s *StorageUsecase) saveTusPending(ctx context.Context, upload *ent.Multipart, partNumber int, content []byte) error {
+	pendingPath := tusPendingPath(upload, partNumber)
+	if len(content) == 0 {
+		return s.minioClient.Remove(detachedContext{ctx}, pendingPath)
+	}
+	_, err := s.minioClient.UploadFromReader(
+		detachedContext{ctx},
+		bytes.NewReader(content),
+		int64(len(content)),
+		defaultContentType,
+		pendingPath,
+	)
+	return err
+}
+
+// removeTusPendingPart removes pending object of stored part, stale pending object is never read,
+// because offset is counted by pending object of the next part
+func (s *StorageUsecase) removeTusPendingPart(ctx context.Context, upload *ent.Multipart, partNumber int) {
+	if err := s.minioClient.Remove(ctx, tusPendingPath(upload, partNumber)); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to remove pending object of tus upload [%s]: %v`, upload.UID, err)
+	}
+}
+
+// removeTusPending removes pending objects of terminated tus upload, errors are only logged
+func (s *StorageUsecase) removeTusPending(ctx context.Context, upload *ent.Multipart) {
+	if err := s.removeObjects(ctx, tusPendingPrefix+upload.UID.String()+`/`); err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to remove pending objects of tus upload [%s]: %v`, upload.UID, err)
+	}
+}
+
+// tusPendingPath makes key of pending object which bytes are stored before the part of number
+func tusPendingPath(upload *ent.Multipart, partNumber int) string {
+	return fmt.Sprintf(`%s%s/%05d`, tusPendingPrefix, upload.UID, partNumber)
+}
+
+// detachedContext keeps values of context, but it is never cancelled
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// tusMultipart finds own multipart upload created by tus protocol, terminated uploads are not found
+func (s *StorageUsecase) tusMultipart(ctx context.Context, uid string) (*ent.Multipart, error) {
+	upload, err := s.ownMultipart(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if upload.UploadLength == nil || upload.Status == multipart.StatusAborted {
+		return nil, v1.ErrorNotFound(`tus upload [%s] is not found`, uid)
+	}
+	return upload, nil
+}
+
+func partsSize(parts []*ent.MultipartPart) int64 {
+	var size int64
+	for _, part := range parts {
+		size += int64(part.Size)
+	}
+	return size
+}
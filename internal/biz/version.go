@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+)
+
+// objectPathForVersion chooses object path for new version of file: the first version is stored by logical path
+// as files were stored before versioning, next versions get unique paths near it, so versions never overwrite
+// objects of each other
+func (s *StorageUsecase) objectPathForVersion(ctx context.Context, logicalPath string) (string, error) {
+	owned, err := s.fileRepo.HasObjectOwner(ctx, logicalPath)
+	if err != nil {
+		return "", err
+	}
+	if !owned {
+		return logicalPath, nil
+	}
+	ext := path.Ext(logicalPath)
+	return fmt.Sprintf(`%s.%s%s`, strings.TrimSuffix(logicalPath, ext), uuid.NewString(), ext), nil
+}
+
+// logicalPathOf returns path shared by versions of file, files are not versioned until backfill
+func logicalPathOf(f *ent.File) string {
+	if f.LogicalPath != "" {
+		return f.LogicalPath
+	}
+	return f.ObjectPath
+}
+
+// fileVersion finds requested version of file or its latest version when version is zero
+func (s *StorageUsecase) fileVersion(ctx context.Context, f *ent.File, version int) (*ent.File, error) {
+	var (
+		found *ent.File
+		err   error
+	)
+	if version > 0 {
+		found, err = s.fileRepo.FindVersion(ctx, logicalPathOf(f), version)
+	} else {
+		found, err = s.fileRepo.FindLatestVersion(ctx, logicalPathOf(f))
+	}
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`version %d of file [%s] is not found`, version, f.UID)
+	}
+	return found, err
+}
+
+// FileVersions lists active and deleted versions of file, the latest versions go first
+func (s *StorageUsecase) FileVersions(ctx context.Context, uid string) ([]*ent.File, error) {
+	f, err := s.fileRepo.FindByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`file [%s] is not found`, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkFilePermission(ctx, f, `list`, scopeOfList); err != nil {
+		return nil, err
+	}
+
+	return s.fileRepo.FindVersions(ctx, logicalPathOf(f))
+}
+
+// RollbackFile makes the new latest version of file with content of the requested version, so history is kept,
+// versions share content instead of copying it
+func (s *StorageUsecase) RollbackFile(ctx context.Context, uid string, version int) (*ent.File, error) {
+	f, err := s.fileRepo.FindByUID(ctx, uid)
+	if ent.IsNotFound(err) {
+		return nil, v1.ErrorNotFound(`file [%s] is not found`, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkFilePermission(ctx, f, `restore`, scopeOfRestore); err != nil {
+		return nil, err
+	}
+	if version <= 0 {
+		return nil, v1.ErrorValidationFailed(`version must be positive`)
+	}
+	target, err := s.fileVersion(ctx, f, version)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := &ent.File{
+		UserID:           target.UserID,
+		Filename:         target.Filename,
+		ObjectPath:       target.ObjectPath,
+		LogicalPath:      logicalPathOf(target),
+		Size:             target.Size,
+		MimeType:         target.MimeType,
+		DetectedMimeType: target.DetectedMimeType,
+		Etag:             target.Etag,
+		LastModified:     target.LastModified,
+		Sha256:           target.Sha256,
+		Md5:              target.Md5,
+		Status:           fileStatus.StatusActive,
+		Visibility:       target.Visibility,
+		ScanStatus:       target.ScanStatus,
+		ScanResult:       target.ScanResult,
+		ScannedAt:        target.ScannedAt,
+	}
+
+	blob := target.Edges.Blob
+	if blob != nil {
+		acquired, err := s.blobRepo.Reference(ctx, blob.Sha256)
+		if ent.IsNotFound(err) {
+			// content of target version is removed concurrently
+			return nil, v1.ErrorConflict(`content of version %d of file [%s] is removed`, version, f.UID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rollback.BlobID = &acquired.ID
+		rollback.Edges.Blob = acquired
+	}
+
+	saved, err := s.fileRepo.Create(ctx, rollback)
+	if err != nil {
+		if blob != nil {
+			s.releaseBlobQuietly(ctx, rollback.Edges.Blob)
+		}
+		return nil, err
+	}
+	saved.Edges.Blob = rollback.Edges.Blob
+
+	return saved, nil
+}
@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	fileStatus "storage/ent/file"
+)
+
+// parseVisibility validates visibility requested for uploading file, files are public unless it is set
+func parseVisibility(value string) (fileStatus.Visibility, error) {
+	if value == "" {
+		return fileStatus.DefaultVisibility, nil
+	}
+	visibility := fileStatus.Visibility(value)
+	if err := fileStatus.VisibilityValidator(visibility); err != nil {
+		return "", v1.ErrorValidationFailed(`unknown visibility [%s]`, value)
+	}
+	return visibility, nil
+}
+
+// checkDownloadAccess allows download of file by its visibility: public files are available to anyone,
+// authenticated ones to any user, owner and shared files to their owner and users which role downloads files
+// of others, integrations are trusted
+func (s *StorageUsecase) checkDownloadAccess(ctx context.Context, f *ent.File) error {
+	if f.Visibility == fileStatus.VisibilityPublic || s.isIntegrations(ctx) {
+		return nil
+	}
+	user, err := s.user(ctx)
+	if err != nil {
+		return err
+	}
+	if f.Visibility == fileStatus.VisibilityAuthenticated {
+		return nil
+	}
+	if int(user.ID()) != f.UserID && !s.role(user).GetDownloadOthers() {
+		return v1.ErrorAccessDenied(`file [%s] is available to its owner only`, f.UID)
+	}
+	return nil
+}
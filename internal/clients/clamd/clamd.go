@@ -0,0 +1,199 @@
+package clamd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/phlx-ru/hatchet/watcher"
+)
+
+const (
+	metricPrefix = `clients.clamd`
+
+	defaultNetwork = `tcp`
+	defaultTimeout = 30 * time.Second
+
+	// chunkSize is a size of chunks which content is streamed by, clamd limits it by StreamMaxLength only
+	chunkSize = 64 << 10
+
+	commandInstream = "zINSTREAM\x00"
+
+	replyClean     = `stream: OK`
+	suffixInfected = ` FOUND`
+	suffixError    = ` ERROR`
+)
+
+// ErrScanFailed is returned when clamd could not scan content, for example when it exceeds stream limit of clamd
+var ErrScanFailed = errors.New(`scan is failed`)
+
+type Client interface {
+	Scan(ctx context.Context, reader io.Reader) (*Result, error)
+}
+
+// Result is a verdict of clamd, signature is a name of malware found in infected content
+type Result struct {
+	Infected  bool
+	Signature string
+}
+
+// Clamd scans content by INSTREAM command of clamd daemon over tcp or unix socket
+type Clamd struct {
+	network string
+	address string
+	timeout time.Duration
+	dialer  *net.Dialer
+	metric  metrics.Metrics
+	logger  *log.Helper
+	watcher *watcher.Watcher
+}
+
+// New makes client of clamd listening on address of network, tcp or unix; timeout limits each read and write,
+// so the verdict on the whole streamed content must be given within it too
+func New(
+	network string,
+	address string,
+	timeout time.Duration,
+	metric metrics.Metrics,
+	logs log.Logger,
+) (*Clamd, error) {
+	if network == "" {
+		network = defaultNetwork
+	}
+	if network != `tcp` && network != `unix` {
+		return nil, fmt.Errorf(`unsupported clamd network [%s], expected tcp or unix`, network)
+	}
+	if address == "" {
+		return nil, fmt.Errorf(`clamd address is empty`)
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	loggerHelper := logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, metricPrefix)
+	return &Clamd{
+		network: network,
+		address: address,
+		timeout: timeout,
+		dialer:  &net.Dialer{Timeout: timeout},
+		metric:  metric,
+		logger:  loggerHelper,
+		watcher: watcher.New(metricPrefix, loggerHelper, metric),
+	}, nil
+}
+
+// Scan streams content to clamd and returns its verdict, content is read until EOF
+func (c *Clamd) Scan(ctx context.Context, reader io.Reader) (*Result, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`Scan`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	conn, err := c.dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// canceled scan must not wait for deadlines of connection
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	if err = c.stream(conn, reader); err != nil {
+		// clamd drops connection of stream which exceeds its limit and tells the reason in reply
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == `write` {
+			if reply, replyErr := c.reply(conn); replyErr == nil {
+				var result *Result
+				result, err = parseReply(reply)
+				return result, err
+			}
+		}
+		return nil, err
+	}
+
+	reply, err := c.reply(conn)
+	if err != nil {
+		return nil, err
+	}
+	result, err := parseReply(reply)
+
+	return result, err
+}
+
+// stream sends content by chunks prefixed with their length in network byte order, zero length chunk ends it
+func (c *Clamd) stream(conn net.Conn, reader io.Reader) error {
+	if err := c.write(conn, []byte(commandInstream)); err != nil {
+		return err
+	}
+	chunk := make([]byte, 4+chunkSize)
+	for {
+		n, readErr := reader.Read(chunk[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(chunk[:4], uint32(n))
+			if err := c.write(conn, chunk[:4+n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return fmt.Errorf(`failed to read scanned content: %w`, readErr)
+		}
+	}
+	return c.write(conn, make([]byte, 4))
+}
+
+func (c *Clamd) write(conn net.Conn, content []byte) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+	_, err := conn.Write(content)
+	return err
+}
+
+// reply reads null terminated reply of clamd to command prefixed with z
+func (c *Clamd) reply(conn net.Conn) (string, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return ``, err
+	}
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !(errors.Is(err, io.EOF) && reply != ``) {
+		return ``, err
+	}
+	return strings.TrimSpace(strings.TrimSuffix(reply, "\x00")), nil
+}
+
+// parseReply reads verdict from replies like `stream: OK`, `stream: Eicar-Signature FOUND`
+// or `INSTREAM size limit exceeded. ERROR`
+func parseReply(reply string) (*Result, error) {
+	switch {
+	case reply == replyClean:
+		return &Result{}, nil
+	case strings.HasSuffix(reply, suffixInfected):
+		signature := strings.TrimSuffix(reply, suffixInfected)
+		signature = strings.TrimPrefix(signature, `stream: `)
+		return &Result{Infected: true, Signature: signature}, nil
+	case strings.HasSuffix(reply, suffixError):
+		return nil, fmt.Errorf(`%w: %s`, ErrScanFailed, strings.TrimSuffix(reply, suffixError))
+	}
+	return nil, fmt.Errorf(`%w: unexpected reply [%s]`, ErrScanFailed, reply)
+}
@@ -0,0 +1,155 @@
+package clamd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+const eicarMarker = `EICAR-STANDARD-ANTIVIRUS-TEST-FILE`
+
+// fakeClamd answers INSTREAM commands like clamd, content with EICAR marker is infected
+type fakeClamd struct {
+	listener  net.Listener
+	maxLength int
+	received  chan []byte
+}
+
+func newFakeClamd(t *testing.T, network, address string) *fakeClamd {
+	t.Helper()
+	listener, err := net.Listen(network, address)
+	require.NoError(t, err)
+	fake := &fakeClamd{listener: listener, received: make(chan []byte, 16)}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go fake.serve()
+	return fake
+}
+
+func (f *fakeClamd) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeClamd) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	reader := bufio.NewReader(conn)
+	command, err := reader.ReadString(0)
+	if err != nil {
+		return
+	}
+	if command != commandInstream {
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+	content := &bytes.Buffer{}
+	for {
+		var length uint32
+		if err = binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return
+		}
+		if length == 0 {
+			break
+		}
+		if f.maxLength > 0 && content.Len()+int(length) > f.maxLength {
+			_, _ = conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+			return
+		}
+		if _, err = io.CopyN(content, reader, int64(length)); err != nil {
+			return
+		}
+	}
+	f.received <- content.Bytes()
+	if strings.Contains(content.String(), eicarMarker) {
+		_, _ = conn.Write([]byte("stream: Eicar-Signature FOUND\x00"))
+		return
+	}
+	_, _ = conn.Write([]byte("stream: OK\x00"))
+}
+
+func newClamd(t *testing.T, network, address string) *Clamd {
+	t.Helper()
+	metric, err := metrics.New(`localhost:8125`, `clamd-test`, true)
+	require.NoError(t, err)
+	client, err := New(network, address, time.Second, metric, log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+	return client
+}
+
+func TestScan(t *testing.T) {
+	fake := newFakeClamd(t, `tcp`, `127.0.0.1:0`)
+	client := newClamd(t, `tcp`, fake.listener.Addr().String())
+	ctx := context.Background()
+
+	// content larger than chunk is streamed by several chunks
+	content := strings.Repeat(`waybill `, chunkSize/4)
+	result, err := client.Scan(ctx, strings.NewReader(content))
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+	require.Equal(t, content, string(<-fake.received))
+
+	result, err = client.Scan(ctx, strings.NewReader(`X5O!P%@AP[4\PZX54(P^)7CC)7}$`+eicarMarker+`!$H+H*`))
+	require.NoError(t, err)
+	require.True(t, result.Infected)
+	require.Equal(t, `Eicar-Signature`, result.Signature)
+
+	result, err = client.Scan(ctx, strings.NewReader(``))
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+}
+
+func TestScanUnixSocket(t *testing.T) {
+	fake := newFakeClamd(t, `unix`, filepath.Join(t.TempDir(), `clamd.sock`))
+	client := newClamd(t, `unix`, fake.listener.Addr().String())
+
+	result, err := client.Scan(context.Background(), strings.NewReader(eicarMarker))
+	require.NoError(t, err)
+	require.True(t, result.Infected)
+}
+
+func TestScanFailed(t *testing.T) {
+	fake := newFakeClamd(t, `tcp`, `127.0.0.1:0`)
+	fake.maxLength = 16
+	client := newClamd(t, `tcp`, fake.listener.Addr().String())
+
+	_, err := client.Scan(context.Background(), strings.NewReader(`content which exceeds stream limit`))
+	require.ErrorIs(t, err, ErrScanFailed)
+
+	_, err = New(`udp`, `127.0.0.1:3310`, 0, nil, log.NewStdLogger(io.Discard))
+	require.Error(t, err)
+}
+
+func TestParseReply(t *testing.T) {
+	result, err := parseReply(`stream: OK`)
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+
+	result, err = parseReply(`stream: Win.Test.EICAR_HDB-1 FOUND`)
+	require.NoError(t, err)
+	require.Equal(t, &Result{Infected: true, Signature: `Win.Test.EICAR_HDB-1`}, result)
+
+	_, err = parseReply(`Can't allocate memory ERROR`)
+	require.ErrorIs(t, err, ErrScanFailed)
+
+	_, err = parseReply(`UNKNOWN COMMAND`)
+	require.ErrorIs(t, err, ErrScanFailed)
+}
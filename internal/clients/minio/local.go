@@ -0,0 +1,633 @@
+package minio
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // md5 is used for etag like s3 does
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/phlx-ru/hatchet/logger"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/phlx-ru/hatchet/watcher"
+)
+
+const (
+	localMetricPrefix = `clients.local`
+
+	localDirPerm      = 0o755
+	localFilePerm     = 0o644
+	localMultipartDir = `.multipart`
+	localETagDir      = `.etags`
+	localTempPrefix   = `.upload-`
+)
+
+// Local is a disk-backed implementation of Client, all objects are stored as files under the root path,
+// etags of objects are stored in files of the same paths under the etags directory
+type Local struct {
+	root    string
+	metric  metrics.Metrics
+	logger  *log.Helper
+	watcher *watcher.Watcher
+}
+
+func NewLocal(
+	root string,
+	metric metrics.Metrics,
+	logs log.Logger,
+) (*Local, error) {
+	if root == "" {
+		return nil, errors.New(`local storage path is empty`)
+	}
+	absolute, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(absolute, localDirPerm); err != nil {
+		return nil, err
+	}
+	loggerHelper := logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, localMetricPrefix)
+
+	return &Local{
+		root:    absolute,
+		metric:  metric,
+		logger:  loggerHelper,
+		watcher: watcher.New(localMetricPrefix, loggerHelper, metric),
+	}, nil
+}
+
+func (l *Local) Upload(ctx context.Context, filePath string, objectPath string) (minio.UploadInfo, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`Upload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	uploadInfo, err := l.write(file, objectPath)
+
+	return uploadInfo, err
+}
+
+func (l *Local) Download(ctx context.Context, filePath string, objectPath string) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`Download`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = l.read(file, objectPath)
+
+	return err
+}
+
+func (l *Local) Remove(ctx context.Context, objectPath string) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`Remove`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	fullPath, err := l.fullPath(objectPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		err = nil // RemoveObject of s3 does not fail on nonexistent objects too
+	}
+	if err == nil {
+		err = l.removeETag(objectPath)
+	}
+
+	return err
+}
+
+func (l *Local) UploadFromReader(
+	ctx context.Context,
+	reader io.Reader,
+	_ int64,
+	_ string,
+	objectPath string,
+) (minio.UploadInfo, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`UploadFromReader`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	uploadInfo, err := l.write(reader, objectPath)
+
+	return uploadInfo, err
+}
+
+func (l *Local) DownloadToWriter(ctx context.Context, writer io.Writer, objectPath string) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`DownloadToWriter`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = l.read(writer, objectPath)
+
+	return err
+}
+
+// DownloadRangeToWriter writes length bytes of object starting from offset
+func (l *Local) DownloadRangeToWriter(
+	ctx context.Context,
+	writer io.Writer,
+	objectPath string,
+	offset, length int64,
+) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`DownloadRangeToWriter`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	fullPath, err := l.fullPath(objectPath)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		err = errNoSuchKey(objectPath)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = io.Copy(writer, io.NewSectionReader(file, offset, length))
+
+	return err
+}
+
+func (l *Local) NewMultipartUpload(ctx context.Context, objectPath string, _ string) (string, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`NewMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	if _, err = l.fullPath(objectPath); err != nil {
+		return "", err
+	}
+
+	uploadID := uuid.NewString()
+	err = os.MkdirAll(filepath.Join(l.root, localMultipartDir, uploadID), localDirPerm)
+
+	return uploadID, err
+}
+
+func (l *Local) UploadPart(
+	ctx context.Context,
+	_ string,
+	uploadID string,
+	partNumber int,
+	reader io.Reader,
+	_ int64,
+) (minio.ObjectPart, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`UploadPart`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	partPath, err := l.partPath(uploadID, partNumber)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+
+	file, err := os.OpenFile(partPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, localFilePerm)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	hash := md5.New() //nolint:gosec
+	size, err := io.Copy(io.MultiWriter(file, hash), reader)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+
+	return minio.ObjectPart{
+		PartNumber:   partNumber,
+		ETag:         hex.EncodeToString(hash.Sum(nil)),
+		Size:         size,
+		LastModified: time.Now(),
+	}, nil
+}
+
+func (l *Local) CompleteMultipartUpload(
+	ctx context.Context,
+	objectPath string,
+	uploadID string,
+	parts []minio.CompletePart,
+) (minio.UploadInfo, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`CompleteMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		var partPath string
+		if partPath, err = l.partPath(uploadID, part.PartNumber); err != nil {
+			return minio.UploadInfo{}, err
+		}
+		var file *os.File
+		if file, err = os.Open(partPath); err != nil {
+			return minio.UploadInfo{}, err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		readers = append(readers, file)
+	}
+
+	// etag is md5 of the whole content unlike s3 one, so it is the same as etag of single part upload
+	uploadInfo, err := l.write(io.MultiReader(readers...), objectPath)
+	if err != nil {
+		return uploadInfo, err
+	}
+
+	err = os.RemoveAll(filepath.Join(l.root, localMultipartDir, uploadID))
+
+	return uploadInfo, err
+}
+
+func (l *Local) AbortMultipartUpload(ctx context.Context, _ string, uploadID string) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`AbortMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	if _, err = uuid.Parse(uploadID); err != nil {
+		return errNoSuchUpload(uploadID)
+	}
+
+	err = os.RemoveAll(filepath.Join(l.root, localMultipartDir, uploadID))
+
+	return err
+}
+
+// PresignedGetURL is not supported, objects on disk are reachable only through the service itself
+func (l *Local) PresignedGetURL(_ context.Context, _ string, _ time.Duration, _ url.Values) (*url.URL, error) {
+	return nil, ErrPresignNotSupported
+}
+
+// PresignedPutURL is not supported, objects on disk are reachable only through the service itself
+func (l *Local) PresignedPutURL(_ context.Context, _ string, _ time.Duration) (*url.URL, error) {
+	return nil, ErrPresignNotSupported
+}
+
+// PresignedPostPolicy is not supported, objects on disk are reachable only through the service itself
+func (l *Local) PresignedPostPolicy(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ int64,
+	_ time.Duration,
+) (*url.URL, map[string]string, error) {
+	return nil, nil, ErrPresignNotSupported
+}
+
+// StatObject describes object file by its stored etag, content type is not stored on disk, so it is guessed
+// by extension
+func (l *Local) StatObject(ctx context.Context, objectPath string) (minio.ObjectInfo, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`StatObject`).WithIgnoredErrorsChecks([]func(error) bool{
+		IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	fullPath, err := l.fullPath(objectPath)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	file, err := os.Open(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		err = errNoSuchKey(objectPath)
+	}
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	etag, err := l.etag(file, objectPath)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(objectPath))
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	return minio.ObjectInfo{
+		Key:          objectPath,
+		ETag:         etag,
+		Size:         stat.Size(),
+		ContentType:  contentType,
+		LastModified: stat.ModTime(),
+	}, nil
+}
+
+func (l *Local) CopyObject(ctx context.Context, srcObjectPath, dstObjectPath string) (minio.UploadInfo, error) {
+	var err error
+	defer l.watcher.OnPreparedMethod(`CopyObject`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	srcPath, err := l.fullPath(srcObjectPath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	src, err := os.Open(srcPath)
+	if errors.Is(err, os.ErrNotExist) {
+		err = errNoSuchKey(srcObjectPath)
+	}
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	uploadInfo, err := l.write(src, dstObjectPath)
+
+	return uploadInfo, err
+}
+
+// WalkObjects walks object files in lexical order, parts of multipart uploads, etags and unfinished writes
+// are skipped
+func (l *Local) WalkObjects(ctx context.Context, prefix string, fn func(minio.ObjectInfo) error) error {
+	var err error
+	defer l.watcher.OnPreparedMethod(`WalkObjects`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	multipartDir := filepath.Join(l.root, localMultipartDir)
+	etagDir := filepath.Join(l.root, localETagDir)
+	err = filepath.WalkDir(l.root, func(fullPath string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if fullPath == multipartDir || fullPath == etagDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(entry.Name(), localTempPrefix) {
+			return nil
+		}
+		relative, err := filepath.Rel(l.root, fullPath)
+		if err != nil {
+			return err
+		}
+		objectPath := filepath.ToSlash(relative)
+		if !strings.HasPrefix(objectPath, prefix) {
+			return nil
+		}
+		stat, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			return nil // removed while walking
+		}
+		if err != nil {
+			return err
+		}
+		return fn(minio.ObjectInfo{
+			Key:          objectPath,
+			Size:         stat.Size(),
+			LastModified: stat.ModTime(),
+		})
+	})
+
+	return err
+}
+
+// partPath makes path of part file for existing multipart upload
+func (l *Local) partPath(uploadID string, partNumber int) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", errNoSuchUpload(uploadID)
+	}
+	dir := filepath.Join(l.root, localMultipartDir, uploadID)
+	if _, err := os.Stat(dir); err != nil {
+		return "", errNoSuchUpload(uploadID)
+	}
+	return filepath.Join(dir, fmt.Sprintf(`%05d`, partNumber)), nil
+}
+
+// write stores content into temporary file near destination and renames it, so existing object is never half-written
+func (l *Local) write(reader io.Reader, objectPath string) (minio.UploadInfo, error) {
+	uploadInfo := minio.UploadInfo{}
+	fullPath, err := l.fullPath(objectPath)
+	if err != nil {
+		return uploadInfo, err
+	}
+
+	dir := filepath.Dir(fullPath)
+	if err = os.MkdirAll(dir, localDirPerm); err != nil {
+		return uploadInfo, err
+	}
+
+	temp, err := os.CreateTemp(dir, localTempPrefix+`*`)
+	if err != nil {
+		return uploadInfo, err
+	}
+	defer func() {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name()) // no-op after successful rename
+	}()
+
+	hash := md5.New() //nolint:gosec
+	size, err := io.Copy(io.MultiWriter(temp, hash), reader)
+	if err != nil {
+		return uploadInfo, err
+	}
+	if err = temp.Chmod(localFilePerm); err != nil {
+		return uploadInfo, err
+	}
+	if err = temp.Close(); err != nil {
+		return uploadInfo, err
+	}
+	// etag of replaced object must not outlive it, missing etag is calculated again by StatObject
+	if err = l.removeETag(objectPath); err != nil {
+		return uploadInfo, err
+	}
+	if err = os.Rename(temp.Name(), fullPath); err != nil {
+		return uploadInfo, err
+	}
+	etag := hex.EncodeToString(hash.Sum(nil))
+	if err = l.storeETag(objectPath, etag); err != nil {
+		return uploadInfo, err
+	}
+
+	stat, err := os.Stat(fullPath)
+	if err != nil {
+		return uploadInfo, err
+	}
+
+	return minio.UploadInfo{
+		Key:          objectPath,
+		ETag:         etag,
+		Size:         size,
+		LastModified: stat.ModTime(),
+	}, nil
+}
+
+// etag returns stored etag of object, etag of object stored before etags were kept is calculated from content
+// like for single part uploads of s3 and is stored for the next calls
+func (l *Local) etag(file io.Reader, objectPath string) (string, error) {
+	etag, err := os.ReadFile(l.etagPath(objectPath))
+	if err == nil {
+		return string(etag), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	hash := md5.New() //nolint:gosec
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	calculated := hex.EncodeToString(hash.Sum(nil))
+
+	return calculated, l.storeETag(objectPath, calculated)
+}
+
+// storeETag writes etag into temporary file and renames it, so etag is never read half-written
+func (l *Local) storeETag(objectPath, etag string) error {
+	etagPath := l.etagPath(objectPath)
+	dir := filepath.Dir(etagPath)
+	if err := os.MkdirAll(dir, localDirPerm); err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(dir, localTempPrefix+`*`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name()) // no-op after successful rename
+	}()
+
+	if _, err = temp.WriteString(etag); err != nil {
+		return err
+	}
+	if err = temp.Chmod(localFilePerm); err != nil {
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), etagPath)
+}
+
+func (l *Local) removeETag(objectPath string) error {
+	err := os.Remove(l.etagPath(objectPath))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// etagPath makes path of etag file for valid object path, which is checked by fullPath already
+func (l *Local) etagPath(objectPath string) string {
+	return filepath.Join(l.root, localETagDir, filepath.FromSlash(objectPath))
+}
+
+func (l *Local) read(writer io.Writer, objectPath string) error {
+	fullPath, err := l.fullPath(objectPath)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(fullPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return errNoSuchKey(objectPath)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = io.Copy(writer, file)
+
+	return err
+}
+
+// errNoSuchKey makes same error as s3 returns for nonexistent object
+func errNoSuchKey(objectPath string) error {
+	return minio.ErrorResponse{
+		Code:       `NoSuchKey`,
+		Message:    fmt.Sprintf(`object [%s] does not exist`, objectPath),
+		Key:        objectPath,
+		StatusCode: http.StatusNotFound,
+	}
+}
+
+// errNoSuchUpload makes same error as s3 returns for nonexistent multipart upload
+func errNoSuchUpload(uploadID string) error {
+	return minio.ErrorResponse{
+		Code:       `NoSuchUpload`,
+		Message:    fmt.Sprintf(`multipart upload [%s] does not exist`, uploadID),
+		StatusCode: http.StatusNotFound,
+	}
+}
+
+// fullPath makes absolute path of object file and guarantees that it stays inside the root
+func (l *Local) fullPath(objectPath string) (string, error) {
+	if err := s3utils.CheckValidObjectName(objectPath); err != nil {
+		return "", err
+	}
+	fullPath := filepath.Join(l.root, filepath.FromSlash(objectPath))
+	if !strings.HasPrefix(fullPath, l.root+string(filepath.Separator)) {
+		return "", fmt.Errorf(`object path [%s] is outside of local storage`, objectPath)
+	}
+	return fullPath, nil
+}
@@ -0,0 +1,139 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // md5 is used for etag like s3 does
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocal(t *testing.T) *Local {
+	t.Helper()
+	client, err := NewLocal(t.TempDir(), newTestMetrics(t), log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+	return client
+}
+
+func testETag(content string) string {
+	sum := md5.Sum([]byte(content)) //nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+func TestLocalPutGetStatRemove(t *testing.T) {
+	ctx := context.Background()
+	client := newTestLocal(t)
+
+	uploadInfo, err := client.UploadFromReader(ctx, strings.NewReader(testContent), int64(len(testContent)), `application/pdf`, testObjectPath)
+	require.NoError(t, err)
+	require.Equal(t, testETag(testContent), uploadInfo.ETag)
+	require.Equal(t, int64(len(testContent)), uploadInfo.Size)
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, client.DownloadToWriter(ctx, buffer, testObjectPath))
+	require.Equal(t, testContent, buffer.String())
+
+	buffer.Reset()
+	require.NoError(t, client.DownloadRangeToWriter(ctx, buffer, testObjectPath, 1, 3))
+	require.Equal(t, testContent[1:4], buffer.String())
+
+	info, err := client.StatObject(ctx, testObjectPath)
+	require.NoError(t, err)
+	require.Equal(t, testObjectPath, info.Key)
+	require.Equal(t, uploadInfo.ETag, info.ETag)
+	require.Equal(t, int64(len(testContent)), info.Size)
+	require.Equal(t, `application/pdf`, info.ContentType)
+
+	_, err = client.CopyObject(ctx, testObjectPath, `7/copy.pdf`)
+	require.NoError(t, err)
+	copied, err := client.StatObject(ctx, `7/copy.pdf`)
+	require.NoError(t, err)
+	require.Equal(t, info.ETag, copied.ETag)
+
+	require.NoError(t, client.Remove(ctx, testObjectPath))
+	require.NoError(t, client.Remove(ctx, testObjectPath), `removal of nonexistent object does not fail`)
+	_, err = os.Stat(filepath.Join(client.root, filepath.FromSlash(testObjectPath)))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLocalNotFound(t *testing.T) {
+	ctx := context.Background()
+	client := newTestLocal(t)
+
+	_, err := client.StatObject(ctx, testObjectPath)
+	require.True(t, IsNotFound(err))
+	require.True(t, IsNotFound(client.DownloadToWriter(ctx, io.Discard, testObjectPath)))
+	require.True(t, IsNotFound(client.DownloadRangeToWriter(ctx, io.Discard, testObjectPath, 0, 1)))
+	require.True(t, IsNotFound(client.Download(ctx, filepath.Join(t.TempDir(), `waybill.pdf`), testObjectPath)))
+	_, err = client.CopyObject(ctx, testObjectPath, `7/copy.pdf`)
+	require.True(t, IsNotFound(err))
+
+	_, err = client.StatObject(ctx, `../outside.pdf`)
+	require.Error(t, err)
+	require.False(t, IsNotFound(err))
+}
+
+func TestLocalMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	client := newTestLocal(t)
+
+	uploadID, err := client.NewMultipartUpload(ctx, testObjectPath, `application/pdf`)
+	require.NoError(t, err)
+	parts := []minio.CompletePart{}
+	for i, chunk := range []string{testContent[:5], testContent[5:]} {
+		part, err := client.UploadPart(ctx, testObjectPath, uploadID, i+1, strings.NewReader(chunk), int64(len(chunk)))
+		require.NoError(t, err)
+		parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	uploadInfo, err := client.CompleteMultipartUpload(ctx, testObjectPath, uploadID, parts)
+	require.NoError(t, err)
+
+	info, err := client.StatObject(ctx, testObjectPath)
+	require.NoError(t, err)
+	require.Equal(t, uploadInfo.ETag, info.ETag)
+	require.Equal(t, testETag(testContent), info.ETag)
+
+	// parts of completed upload are removed and are never listed as objects
+	var objects []string
+	require.NoError(t, client.WalkObjects(ctx, ``, func(object minio.ObjectInfo) error {
+		objects = append(objects, object.Key)
+		return nil
+	}))
+	require.Equal(t, []string{testObjectPath}, objects)
+	require.Error(t, client.AbortMultipartUpload(ctx, testObjectPath, uploadID+`-unknown`))
+}
+
+func TestLocalStoredETag(t *testing.T) {
+	ctx := context.Background()
+	client := newTestLocal(t)
+
+	_, err := client.UploadFromReader(ctx, strings.NewReader(testContent), int64(len(testContent)), `application/pdf`, testObjectPath)
+	require.NoError(t, err)
+
+	// etag is stored on write, so content is not read again to describe object
+	fullPath := filepath.Join(client.root, filepath.FromSlash(testObjectPath))
+	require.NoError(t, os.WriteFile(fullPath, []byte(`changed behind the client`), localFilePerm))
+	info, err := client.StatObject(ctx, testObjectPath)
+	require.NoError(t, err)
+	require.Equal(t, testETag(testContent), info.ETag)
+
+	// objects stored before etags were kept get etag calculated once
+	require.NoError(t, os.RemoveAll(filepath.Join(client.root, localETagDir)))
+	info, err = client.StatObject(ctx, testObjectPath)
+	require.NoError(t, err)
+	require.Equal(t, testETag(`changed behind the client`), info.ETag)
+	_, err = os.Stat(client.etagPath(testObjectPath))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Remove(ctx, testObjectPath))
+	_, err = os.Stat(client.etagPath(testObjectPath))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,375 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // md5 is used for etag like s3 does
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+)
+
+const (
+	defaultContentType = `application/octet-stream`
+	memoryURLScheme    = `memory`
+)
+
+// Memory is an in-memory implementation of Client, useful for tests and debugging without any object store
+type Memory struct {
+	mutex   sync.RWMutex
+	objects map[string]*memoryObject
+	uploads map[string]*memoryUpload
+}
+
+type memoryUpload struct {
+	objectPath  string
+	contentType string
+	parts       map[int][]byte
+}
+
+type memoryObject struct {
+	content      []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		objects: map[string]*memoryObject{},
+		uploads: map[string]*memoryUpload{},
+	}
+}
+
+func (m *Memory) Upload(_ context.Context, filePath string, objectPath string) (minio.UploadInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	return m.put(content, defaultContentType, objectPath)
+}
+
+func (m *Memory) Download(_ context.Context, filePath string, objectPath string) error {
+	object, err := m.get(objectPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, object.content, localFilePerm)
+}
+
+func (m *Memory) Remove(_ context.Context, objectPath string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.objects, objectPath)
+	return nil
+}
+
+func (m *Memory) UploadFromReader(
+	_ context.Context,
+	reader io.Reader,
+	_ int64,
+	contentType string,
+	objectPath string,
+) (minio.UploadInfo, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	return m.put(content, contentType, objectPath)
+}
+
+func (m *Memory) DownloadToWriter(_ context.Context, writer io.Writer, objectPath string) error {
+	object, err := m.get(objectPath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, bytes.NewReader(object.content))
+	return err
+}
+
+// DownloadRangeToWriter writes length bytes of object starting from offset
+func (m *Memory) DownloadRangeToWriter(
+	_ context.Context,
+	writer io.Writer,
+	objectPath string,
+	offset, length int64,
+) error {
+	object, err := m.get(objectPath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, io.NewSectionReader(bytes.NewReader(object.content), offset, length))
+	return err
+}
+
+func (m *Memory) NewMultipartUpload(_ context.Context, objectPath string, contentType string) (string, error) {
+	if err := s3utils.CheckValidObjectName(objectPath); err != nil {
+		return "", err
+	}
+	uploadID := uuid.NewString()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.uploads[uploadID] = &memoryUpload{
+		objectPath:  objectPath,
+		contentType: contentType,
+		parts:       map[int][]byte{},
+	}
+
+	return uploadID, nil
+}
+
+func (m *Memory) UploadPart(
+	_ context.Context,
+	_ string,
+	uploadID string,
+	partNumber int,
+	reader io.Reader,
+	_ int64,
+) (minio.ObjectPart, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	upload, ok := m.uploads[uploadID]
+	if !ok {
+		return minio.ObjectPart{}, errNoSuchUpload(uploadID)
+	}
+	upload.parts[partNumber] = content
+	sum := md5.Sum(content) //nolint:gosec
+
+	return minio.ObjectPart{
+		PartNumber:   partNumber,
+		ETag:         hex.EncodeToString(sum[:]),
+		Size:         int64(len(content)),
+		LastModified: time.Now(),
+	}, nil
+}
+
+func (m *Memory) CompleteMultipartUpload(
+	_ context.Context,
+	objectPath string,
+	uploadID string,
+	parts []minio.CompletePart,
+) (minio.UploadInfo, error) {
+	m.mutex.Lock()
+	upload, ok := m.uploads[uploadID]
+	delete(m.uploads, uploadID)
+	m.mutex.Unlock()
+	if !ok {
+		return minio.UploadInfo{}, errNoSuchUpload(uploadID)
+	}
+
+	var content []byte
+	for _, part := range parts {
+		partContent, exists := upload.parts[part.PartNumber]
+		if !exists {
+			return minio.UploadInfo{}, fmt.Errorf(`part [%d] of upload [%s] is not found`, part.PartNumber, uploadID)
+		}
+		content = append(content, partContent...)
+	}
+
+	uploadInfo, err := m.put(content, upload.contentType, objectPath)
+	if err != nil {
+		return uploadInfo, err
+	}
+	uploadInfo.ETag = multipartETag(parts)
+
+	m.mutex.Lock()
+	m.objects[objectPath].etag = uploadInfo.ETag
+	m.mutex.Unlock()
+
+	return uploadInfo, nil
+}
+
+func (m *Memory) AbortMultipartUpload(_ context.Context, _ string, uploadID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, ok := m.uploads[uploadID]; !ok {
+		return errNoSuchUpload(uploadID)
+	}
+	delete(m.uploads, uploadID)
+	return nil
+}
+
+// PresignedGetURL makes fake url of object with memory scheme, its query contains passed params and expiry,
+// object existence is not checked just like s3 does not check it on presigning
+func (m *Memory) PresignedGetURL(
+	_ context.Context,
+	objectPath string,
+	expires time.Duration,
+	params url.Values,
+) (*url.URL, error) {
+	if err := s3utils.CheckValidObjectName(objectPath); err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set(`X-Amz-Expires`, strconv.Itoa(int(expires.Seconds())))
+	return &url.URL{
+		Scheme:   memoryURLScheme,
+		Path:     `/` + objectPath,
+		RawQuery: query.Encode(),
+	}, nil
+}
+
+// PresignedPutURL makes fake url of object with memory scheme
+func (m *Memory) PresignedPutURL(ctx context.Context, objectPath string, expires time.Duration) (*url.URL, error) {
+	return m.PresignedGetURL(ctx, objectPath, expires, nil)
+}
+
+// PresignedPostPolicy makes fake url with memory scheme and form fields describing policy conditions
+func (m *Memory) PresignedPostPolicy(
+	_ context.Context,
+	objectPath string,
+	contentType string,
+	size int64,
+	expires time.Duration,
+) (*url.URL, map[string]string, error) {
+	if err := s3utils.CheckValidObjectName(objectPath); err != nil {
+		return nil, nil, err
+	}
+	fields := map[string]string{
+		`key`:                  objectPath,
+		`Content-Type`:         contentType,
+		`content-length-range`: fmt.Sprintf(`%d,%d`, size, size),
+		`X-Amz-Expires`:        strconv.Itoa(int(expires.Seconds())),
+	}
+	return &url.URL{Scheme: memoryURLScheme, Path: `/`}, fields, nil
+}
+
+func (m *Memory) StatObject(_ context.Context, objectPath string) (minio.ObjectInfo, error) {
+	object, err := m.get(objectPath)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	return minio.ObjectInfo{
+		Key:          objectPath,
+		ETag:         object.etag,
+		Size:         int64(len(object.content)),
+		ContentType:  object.contentType,
+		LastModified: object.lastModified,
+	}, nil
+}
+
+func (m *Memory) CopyObject(_ context.Context, srcObjectPath, dstObjectPath string) (minio.UploadInfo, error) {
+	object, err := m.get(srcObjectPath)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	return m.put(object.content, object.contentType, dstObjectPath)
+}
+
+// WalkObjects walks objects in lexical order of their paths
+func (m *Memory) WalkObjects(_ context.Context, prefix string, fn func(minio.ObjectInfo) error) error {
+	for _, objectPath := range m.Objects() {
+		if !strings.HasPrefix(objectPath, prefix) {
+			continue
+		}
+		object, err := m.get(objectPath)
+		if err != nil {
+			continue // removed while walking
+		}
+		err = fn(minio.ObjectInfo{
+			Key:          objectPath,
+			ETag:         object.etag,
+			Size:         int64(len(object.content)),
+			ContentType:  object.contentType,
+			LastModified: object.lastModified,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Age moves modification time of object to the past, so tests can check handling of old objects
+func (m *Memory) Age(objectPath string, age time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if object, ok := m.objects[objectPath]; ok {
+		object.lastModified = object.lastModified.Add(-age)
+	}
+}
+
+// Objects returns paths of all stored objects in lexical order
+func (m *Memory) Objects() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	paths := make([]string, 0, len(m.objects))
+	for objectPath := range m.objects {
+		paths = append(paths, objectPath)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Content returns stored content of object and flag of its existence
+func (m *Memory) Content(objectPath string) ([]byte, bool) {
+	object, err := m.get(objectPath)
+	if err != nil {
+		return nil, false
+	}
+	return object.content, true
+}
+
+func (m *Memory) put(content []byte, contentType, objectPath string) (minio.UploadInfo, error) {
+	if err := s3utils.CheckValidObjectName(objectPath); err != nil {
+		return minio.UploadInfo{}, err
+	}
+	sum := md5.Sum(content) //nolint:gosec
+	object := &memoryObject{
+		content:      content,
+		contentType:  contentType,
+		etag:         hex.EncodeToString(sum[:]),
+		lastModified: time.Now(),
+	}
+
+	m.mutex.Lock()
+	m.objects[objectPath] = object
+	m.mutex.Unlock()
+
+	return minio.UploadInfo{
+		Key:          objectPath,
+		ETag:         object.etag,
+		Size:         int64(len(content)),
+		LastModified: object.lastModified,
+	}, nil
+}
+
+func (m *Memory) get(objectPath string) (*memoryObject, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	object, ok := m.objects[objectPath]
+	if !ok {
+		return nil, errNoSuchKey(objectPath)
+	}
+	return object, nil
+}
+
+// multipartETag makes etag of completed multipart upload the same way as s3 does: md5 of parts md5 with parts count
+func multipartETag(parts []minio.CompletePart) string {
+	hash := md5.New() //nolint:gosec
+	for _, part := range parts {
+		sum, _ := hex.DecodeString(strings.Trim(part.ETag, `"`))
+		_, _ = hash.Write(sum)
+	}
+	return fmt.Sprintf(`%s-%d`, hex.EncodeToString(hash.Sum(nil)), len(parts))
+}
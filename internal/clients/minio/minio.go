@@ -2,7 +2,10 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"io"
+	"net/url"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/minio/minio-go/v7"
@@ -15,8 +18,15 @@ import (
 
 const (
 	metricPrefix = `clients.minio`
+
+	// unknownSizePartSize is a size of parts which content of unknown size is uploaded by, minio buffers a part
+	// in memory and would take parts of 512 MiB for such content otherwise
+	unknownSizePartSize = 16 << 20
 )
 
+// ErrPresignNotSupported is returned by backends which objects are not reachable by url, files must be proxied then
+var ErrPresignNotSupported = errors.New(`storage backend does not support presigned urls`)
+
 type Client interface {
 	Upload(ctx context.Context, filePath string, objectPath string) (minio.UploadInfo, error)
 	Download(ctx context.Context, filePath string, objectPath string) error
@@ -29,10 +39,45 @@ type Client interface {
 		objectPath string,
 	) (minio.UploadInfo, error)
 	DownloadToWriter(ctx context.Context, writer io.Writer, objectPath string) error
+	DownloadRangeToWriter(ctx context.Context, writer io.Writer, objectPath string, offset, length int64) error
+	NewMultipartUpload(ctx context.Context, objectPath string, contentType string) (string, error)
+	UploadPart(
+		ctx context.Context,
+		objectPath string,
+		uploadID string,
+		partNumber int,
+		reader io.Reader,
+		size int64,
+	) (minio.ObjectPart, error)
+	CompleteMultipartUpload(
+		ctx context.Context,
+		objectPath string,
+		uploadID string,
+		parts []minio.CompletePart,
+	) (minio.UploadInfo, error)
+	AbortMultipartUpload(ctx context.Context, objectPath string, uploadID string) error
+	PresignedGetURL(
+		ctx context.Context,
+		objectPath string,
+		expires time.Duration,
+		params url.Values,
+	) (*url.URL, error)
+	PresignedPutURL(ctx context.Context, objectPath string, expires time.Duration) (*url.URL, error)
+	PresignedPostPolicy(
+		ctx context.Context,
+		objectPath string,
+		contentType string,
+		size int64,
+		expires time.Duration,
+	) (*url.URL, map[string]string, error)
+	StatObject(ctx context.Context, objectPath string) (minio.ObjectInfo, error)
+	CopyObject(ctx context.Context, srcObjectPath, dstObjectPath string) (minio.UploadInfo, error)
+	WalkObjects(ctx context.Context, prefix string, fn func(minio.ObjectInfo) error) error
 }
 
 type Minio struct {
 	minio      *minio.Client
+	core       *minio.Core
 	bucketName string
 	metric     metrics.Metrics
 	logger     *log.Helper
@@ -60,6 +105,7 @@ func New(
 
 	return &Minio{
 		minio:      client,
+		core:       &minio.Core{Client: client},
 		bucketName: bucketName,
 		metric:     metric,
 		logger:     loggerHelper,
@@ -131,29 +177,26 @@ func (c *Minio) UploadFromReader(
 	})
 
 	uploadInfo := minio.UploadInfo{}
-	err = c.minio.RemoveObject(ctx, c.bucketName, objectPath, minio.RemoveObjectOptions{})
+	err = s3utils.CheckValidObjectName(objectPath)
 	if err != nil {
 		return uploadInfo, err
 	}
 
-	err = s3utils.CheckValidObjectName(objectPath)
-	if err != nil {
-		return uploadInfo, err
+	// Content-MD5 is not sent, because minio buffers content to compute it, md5 computed by caller while streaming
+	// is compared with etag instead
+	options := minio.PutObjectOptions{
+		ContentType:        contentType,
+		ContentEncoding:    "", // TODO
+		ContentDisposition: "", // TODO
+		ContentLanguage:    "", // TODO
+	}
+	if size < 0 {
+		options.PartSize = unknownSizePartSize
 	}
 
-	uploadInfo, err = c.minio.PutObject(
-		ctx,
-		c.bucketName,
-		objectPath,
-		reader,
-		size,
-		minio.PutObjectOptions{
-			ContentType:        contentType,
-			ContentEncoding:    "", // TODO
-			ContentDisposition: "", // TODO
-			ContentLanguage:    "", // TODO
-		},
-	)
+	// PUT replaces existing object only when upload succeeds and multipart uploads are aborted on failure,
+	// so the object is never removed beforehand to keep its previous content safe from failed uploads
+	uploadInfo, err = c.minio.PutObject(ctx, c.bucketName, objectPath, reader, size, options)
 
 	return uploadInfo, err
 }
@@ -181,3 +224,227 @@ func (c *Minio) DownloadToWriter(ctx context.Context, writer io.Writer, objectPa
 
 	return err
 }
+
+// DownloadRangeToWriter writes length bytes of object starting from offset
+func (c *Minio) DownloadRangeToWriter(
+	ctx context.Context,
+	writer io.Writer,
+	objectPath string,
+	offset, length int64,
+) error {
+	var err error
+	defer c.watcher.OnPreparedMethod(`DownloadRangeToWriter`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = s3utils.CheckValidObjectName(objectPath)
+	if err != nil {
+		return err
+	}
+
+	options := minio.GetObjectOptions{}
+	if err = options.SetRange(offset, offset+length-1); err != nil {
+		return err
+	}
+
+	object, err := c.minio.GetObject(ctx, c.bucketName, objectPath, options)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = object.Close()
+	}()
+
+	_, err = io.CopyN(writer, object, length)
+
+	return err
+}
+
+func (c *Minio) NewMultipartUpload(ctx context.Context, objectPath string, contentType string) (string, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`NewMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = s3utils.CheckValidObjectName(objectPath)
+	if err != nil {
+		return "", err
+	}
+
+	uploadID, err := c.core.NewMultipartUpload(ctx, c.bucketName, objectPath, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+
+	return uploadID, err
+}
+
+func (c *Minio) UploadPart(
+	ctx context.Context,
+	objectPath string,
+	uploadID string,
+	partNumber int,
+	reader io.Reader,
+	size int64,
+) (minio.ObjectPart, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`UploadPart`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	part, err := c.core.PutObjectPart(ctx, c.bucketName, objectPath, uploadID, partNumber, reader, size, "", "", nil)
+
+	return part, err
+}
+
+func (c *Minio) CompleteMultipartUpload(
+	ctx context.Context,
+	objectPath string,
+	uploadID string,
+	parts []minio.CompletePart,
+) (minio.UploadInfo, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`CompleteMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	etag, err := c.core.CompleteMultipartUpload(ctx, c.bucketName, objectPath, uploadID, parts, minio.PutObjectOptions{})
+
+	return minio.UploadInfo{
+		Bucket: c.bucketName,
+		Key:    objectPath,
+		ETag:   etag,
+	}, err
+}
+
+func (c *Minio) AbortMultipartUpload(ctx context.Context, objectPath string, uploadID string) error {
+	var err error
+	defer c.watcher.OnPreparedMethod(`AbortMultipartUpload`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = c.core.AbortMultipartUpload(ctx, c.bucketName, objectPath, uploadID)
+
+	return err
+}
+
+func (c *Minio) PresignedGetURL(
+	ctx context.Context,
+	objectPath string,
+	expires time.Duration,
+	params url.Values,
+) (*url.URL, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`PresignedGetURL`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	presigned, err := c.minio.PresignedGetObject(ctx, c.bucketName, objectPath, expires, params)
+
+	return presigned, err
+}
+
+func (c *Minio) PresignedPutURL(ctx context.Context, objectPath string, expires time.Duration) (*url.URL, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`PresignedPutURL`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	presigned, err := c.minio.PresignedPutObject(ctx, c.bucketName, objectPath, expires)
+
+	return presigned, err
+}
+
+// PresignedPostPolicy makes url and form fields for browser upload limited to exact size and content type
+func (c *Minio) PresignedPostPolicy(
+	ctx context.Context,
+	objectPath string,
+	contentType string,
+	size int64,
+	expires time.Duration,
+) (*url.URL, map[string]string, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`PresignedPostPolicy`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	policy := minio.NewPostPolicy()
+	if err = policy.SetBucket(c.bucketName); err != nil {
+		return nil, nil, err
+	}
+	if err = policy.SetKey(objectPath); err != nil {
+		return nil, nil, err
+	}
+	if err = policy.SetContentType(contentType); err != nil {
+		return nil, nil, err
+	}
+	if err = policy.SetContentLengthRange(size, size); err != nil {
+		return nil, nil, err
+	}
+	if err = policy.SetExpires(time.Now().UTC().Add(expires)); err != nil {
+		return nil, nil, err
+	}
+
+	presigned, fields, err := c.minio.PresignedPostPolicy(ctx, policy)
+
+	return presigned, fields, err
+}
+
+func (c *Minio) StatObject(ctx context.Context, objectPath string) (minio.ObjectInfo, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`StatObject`).WithIgnoredErrorsChecks([]func(error) bool{
+		IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	info, err := c.minio.StatObject(ctx, c.bucketName, objectPath, minio.StatObjectOptions{})
+
+	return info, err
+}
+
+// CopyObject makes copy of object on the side of s3 storage without transferring its content
+func (c *Minio) CopyObject(ctx context.Context, srcObjectPath, dstObjectPath string) (minio.UploadInfo, error) {
+	var err error
+	defer c.watcher.OnPreparedMethod(`CopyObject`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	uploadInfo, err := c.minio.CopyObject(
+		ctx,
+		minio.CopyDestOptions{Bucket: c.bucketName, Object: dstObjectPath},
+		minio.CopySrcOptions{Bucket: c.bucketName, Object: srcObjectPath},
+	)
+
+	return uploadInfo, err
+}
+
+// WalkObjects calls fn for every object with path starting with prefix, walking stops on the first error of fn
+func (c *Minio) WalkObjects(ctx context.Context, prefix string, fn func(minio.ObjectInfo) error) error {
+	var err error
+	defer c.watcher.OnPreparedMethod(`WalkObjects`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops listing in background when walking is interrupted
+
+	objects := c.minio.ListObjects(listCtx, c.bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+	for object := range objects {
+		if err = object.Err; err != nil {
+			return err
+		}
+		if err = fn(object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsNotFound reports that object does not exist in storage
+func IsNotFound(err error) bool {
+	return minio.ToErrorResponse(err).Code == `NoSuchKey`
+}
@@ -0,0 +1,195 @@
+package minio
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBucket     = `files`
+	testObjectPath = `7/waybill.pdf`
+	testContent    = `%PDF-1.4 waybill`
+)
+
+var errBrokenReader = errors.New(`connection reset by client`)
+
+// brokenReader fails after a part of content is read, like a client which drops connection during upload
+type brokenReader struct {
+	reader io.Reader
+}
+
+func newBrokenReader(content string) *brokenReader {
+	return &brokenReader{reader: strings.NewReader(content[:len(content)/2])}
+}
+
+func (r *brokenReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, errBrokenReader
+	}
+	return n, err
+}
+
+// fakeS3 keeps objects of bucket and fails uploads on demand
+type fakeS3 struct {
+	mutex     sync.Mutex
+	objects   map[string][]byte
+	failPuts  bool
+	deletions int
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, `/`+testBucket+`/`)
+	switch r.Method {
+	case http.MethodPut:
+		content, err := readPayload(r)
+		if err != nil || s.failPuts {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Upload is broken</Message></Error>`))
+			return
+		}
+		s.objects[key] = content
+		w.Header().Set(`ETag`, `"etag"`)
+	case http.MethodDelete:
+		s.deletions++
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet, http.MethodHead:
+		content, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>No such key</Message></Error>`))
+			return
+		}
+		w.Header().Set(`ETag`, `"etag"`)
+		w.Header().Set(`Last-Modified`, `Wed, 21 Oct 2015 07:28:00 GMT`)
+		_, _ = io.Copy(w, bytes.NewReader(content))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readPayload reads content of request which is sent in signed chunks over insecure connection
+func readPayload(r *http.Request) ([]byte, error) {
+	if r.Header.Get(`X-Amz-Content-Sha256`) != `STREAMING-AWS4-HMAC-SHA256-PAYLOAD` {
+		return io.ReadAll(r.Body)
+	}
+	reader := bufio.NewReader(r.Body)
+	content := &bytes.Buffer{}
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(header, `;`, 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.CopyN(content, reader, size+2); err != nil { // chunk ends with CRLF
+			return nil, err
+		}
+		content.Truncate(content.Len() - 2)
+		if size == 0 {
+			return content.Bytes(), nil
+		}
+	}
+}
+
+func newTestMetrics(t *testing.T) metrics.Metrics {
+	metric, err := metrics.New(`localhost:8125`, `storage-minio-test`, true)
+	require.NoError(t, err)
+	return metric
+}
+
+func TestMinioUploadFromReaderKeepsObjectOnFailure(t *testing.T) {
+	ctx := context.Background()
+	s3 := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(s3)
+	t.Cleanup(server.Close)
+
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := New(endpoint.Host, `us-east-1`, testBucket, `key`, `secret`, newTestMetrics(t), log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+
+	_, err = client.UploadFromReader(ctx, strings.NewReader(testContent), int64(len(testContent)), `application/pdf`, testObjectPath)
+	require.NoError(t, err)
+
+	_, err = client.UploadFromReader(ctx, newBrokenReader(`new content`), int64(len(`new content`)), `application/pdf`, testObjectPath)
+	require.Error(t, err)
+
+	s3.failPuts = true
+	_, err = client.UploadFromReader(ctx, strings.NewReader(`new content`), int64(len(`new content`)), `application/pdf`, testObjectPath)
+	require.Error(t, err)
+
+	require.Zero(t, s3.deletions)
+	buffer := &bytes.Buffer{}
+	require.NoError(t, client.DownloadToWriter(ctx, buffer, testObjectPath))
+	require.Equal(t, testContent, buffer.String())
+}
+
+func TestUploadFromReaderKeepsObjectOnFailure(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name   string
+		client func(t *testing.T) Client
+	}{
+		{
+			name: "local",
+			client: func(t *testing.T) Client {
+				client, err := NewLocal(t.TempDir(), newTestMetrics(t), log.NewStdLogger(io.Discard))
+				require.NoError(t, err)
+				return client
+			},
+		},
+		{
+			name: "memory",
+			client: func(_ *testing.T) Client {
+				return NewMemory()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := testCase.client(t)
+
+			_, err := client.UploadFromReader(ctx, strings.NewReader(testContent), int64(len(testContent)), `application/pdf`, testObjectPath)
+			require.NoError(t, err)
+
+			_, err = client.UploadFromReader(ctx, newBrokenReader(`new content`), int64(len(`new content`)), `application/pdf`, testObjectPath)
+			require.ErrorIs(t, err, errBrokenReader)
+
+			buffer := &bytes.Buffer{}
+			require.NoError(t, client.DownloadToWriter(ctx, buffer, testObjectPath))
+			require.Equal(t, testContent, buffer.String())
+
+			// partially written content is not left in storage
+			var objects []string
+			require.NoError(t, client.WalkObjects(ctx, ``, func(object minio.ObjectInfo) error {
+				objects = append(objects, object.Key)
+				return nil
+			}))
+			require.Equal(t, []string{testObjectPath}, objects)
+		})
+	}
+}
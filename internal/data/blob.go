@@ -0,0 +1,226 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/phlx-ru/hatchet/watcher"
+
+	"storage/ent"
+	"storage/ent/blob"
+	"storage/ent/predicate"
+)
+
+const (
+	blobMetricPrefix = `data.blob`
+
+	// blobAcquireAttempts limits retries when blob with the same content is created concurrently
+	blobAcquireAttempts = 3
+)
+
+type BlobRepo struct {
+	data    Database
+	metric  metrics.Metrics
+	logs    *log.Helper
+	watcher *watcher.Watcher
+}
+
+func NewBlobRepo(data Database, logs log.Logger, metric metrics.Metrics) *BlobRepo {
+	loggerHelper := logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, blobMetricPrefix)
+	return &BlobRepo{
+		data:    data,
+		metric:  metric,
+		logs:    loggerHelper,
+		watcher: watcher.New(blobMetricPrefix, loggerHelper, metric),
+	}
+}
+
+// Reference adds reference to blob with the checksum, blob which is released already is not referenced,
+// because its object may be removed at any moment
+func (b *BlobRepo) Reference(ctx context.Context, sha256 string) (referenced *ent.Blob, err error) {
+	defer b.watcher.OnPreparedMethod(`Reference`).WithFields(map[string]any{
+		"sha256": sha256,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := b.client(ctx).
+		Update().
+		Where(blobFilterBySHA256(sha256)).
+		Where(blobFilterReferenced()).
+		AddRefCount(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+
+	// blob has our reference, so it can not be deleted between queries
+	referenced, err = b.client(ctx).
+		Query().
+		Where(blobFilterBySHA256(sha256)).
+		Only(ctx)
+
+	return referenced, err
+}
+
+// Acquire adds reference to blob with the same checksum or makes given blob, which object is stored already,
+// the blob of checksum. Released blob which is not deleted yet takes object of given blob, its own object
+// is left to reconciliation. Acquired blob with another object path means that given object is not needed.
+func (b *BlobRepo) Acquire(ctx context.Context, blob *ent.Blob) (acquired *ent.Blob, err error) {
+	defer b.watcher.OnPreparedMethod(`Acquire`).WithFields(map[string]any{
+		"sha256": blob.Sha256,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	for attempt := 0; attempt < blobAcquireAttempts; attempt++ {
+		acquired, err = b.Reference(ctx, blob.Sha256)
+		if !ent.IsNotFound(err) {
+			return acquired, err
+		}
+
+		var updated int
+		updated, err = b.client(ctx).
+			Update().
+			Where(blobFilterBySHA256(blob.Sha256)).
+			Where(blobFilterUnreferenced()).
+			SetObjectPath(blob.ObjectPath).
+			SetSize(blob.Size).
+			SetEtag(blob.Etag).
+			SetRefCount(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if updated > 0 {
+			acquired, err = b.client(ctx).
+				Query().
+				Where(blobFilterBySHA256(blob.Sha256)).
+				Only(ctx)
+			return acquired, err
+		}
+
+		acquired, err = b.client(ctx).
+			Create().
+			SetSha256(blob.Sha256).
+			SetObjectPath(blob.ObjectPath).
+			SetSize(blob.Size).
+			SetEtag(blob.Etag).
+			SetRefCount(1).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			continue // same content is uploaded concurrently, so reference it
+		}
+		return acquired, err
+	}
+
+	return nil, err
+}
+
+// Release removes reference to blob and deletes blob without references, deleted flag means that
+// content object of blob must be removed too, blob which is acquired again in the meantime is not deleted
+func (b *BlobRepo) Release(ctx context.Context, id int) (deleted bool, err error) {
+	defer b.watcher.OnPreparedMethod(`Release`).WithFields(map[string]any{
+		"id": id,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = b.client(ctx).
+		UpdateOneID(id).
+		AddRefCount(-1).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// blob may be referenced again between queries, then it stays
+	count, err := b.client(ctx).
+		Delete().
+		Where(blobFilterByID(id)).
+		Where(blobFilterUnreferenced()).
+		Exec(ctx)
+
+	return count > 0, err
+}
+
+// UpdateObjectPath moves blob to another content object only while blob still refers to the old one,
+// so blob which is released or moved concurrently is left as it is
+func (b *BlobRepo) UpdateObjectPath(ctx context.Context, id int, from, to string) (err error) {
+	defer b.watcher.OnPreparedMethod(`UpdateObjectPath`).WithFields(map[string]any{
+		"id":   id,
+		"from": from,
+		"to":   to,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := b.client(ctx).
+		Update().
+		Where(blobFilterByID(id)).
+		Where(blobFilterByObjectPath(from)).
+		SetObjectPath(to).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		err = fmt.Errorf(`object path of blob [%d] is not [%s] anymore`, id, from)
+	}
+
+	return err
+}
+
+// FindObjectPaths returns paths of content objects of all blobs
+func (b *BlobRepo) FindObjectPaths(ctx context.Context) (objectPaths []string, err error) {
+	defer b.watcher.OnPreparedMethod(`FindObjectPaths`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	objectPaths, err = b.client(ctx).
+		Query().
+		Select(blob.FieldObjectPath).
+		Strings(ctx)
+
+	return objectPaths, err
+}
+
+func (b *BlobRepo) client(ctx context.Context) *ent.BlobClient {
+	return client(b.data)(ctx).Blob
+}
+
+func blobFilterByID(id int) predicate.Blob {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`id`, id))
+	}
+}
+
+func blobFilterBySHA256(sha256 string) predicate.Blob {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`sha256`, sha256))
+	}
+}
+
+func blobFilterByObjectPath(objectPath string) predicate.Blob {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`object_path`, objectPath))
+	}
+}
+
+func blobFilterReferenced() predicate.Blob {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().GT(`ref_count`, 0))
+	}
+}
+
+func blobFilterUnreferenced() predicate.Blob {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().LTE(`ref_count`, 0))
+	}
+}
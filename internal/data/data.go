@@ -25,15 +25,16 @@ const (
 )
 
 // ProviderRepoSet is data providers.
-var ProviderRepoSet = wire.NewSet(NewFileRepo)
+var ProviderRepoSet = wire.NewSet(NewFileRepo, NewMultipartRepo, NewBlobRepo, NewShareLinkRepo)
 
 var ProviderDataSet = wire.NewSet(NewData)
 
 // Data .
 type Data struct {
-	db     *sql.DB
-	ent    *ent.Client
-	logger *log.Helper
+	db      *sql.DB
+	dialect string
+	ent     *ent.Client
+	logger  *log.Helper
 }
 
 type Database interface {
@@ -77,9 +78,10 @@ func NewData(c *conf.Data, logger log.Logger) (Database, func(), error) {
 		}
 	}
 	return &Data{
-		db:     db,
-		ent:    client,
-		logger: logHelper,
+		db:      db,
+		dialect: drv.Dialect(),
+		ent:     client,
+		logger:  logHelper,
 	}, cleanup, nil
 }
 
@@ -91,9 +93,13 @@ func (d *Data) Ent() *ent.Client {
 	return d.ent
 }
 
-// MigrateSoft only creates and updates schema entities
+// MigrateSoft only creates and updates schema entities, indexes which must not be unique anymore are dropped
+// explicitly before, so they are created again as not unique ones
 func (d *Data) MigrateSoft(ctx context.Context) error {
-	err := d.ent.Schema.Create(ctx, schema.WithForeignKeys(false))
+	err := dropFileObjectPathUniqueIndex(ctx, d.db, d.dialect)
+	if err == nil {
+		err = d.ent.Schema.Create(ctx, schema.WithForeignKeys(false))
+	}
 	if err != nil {
 		d.logger.WithContext(ctx).Errorf(`failed to soft migrate database schema: %v`, err)
 		return err
@@ -124,6 +130,14 @@ func (d *Data) Prepare(ctx context.Context, m conf.Data_Database_Migrate) error
 		d.logger.WithContext(ctx).Info("preparing database: running hard migrate")
 		err = d.MigrateHard(ctx)
 	}
+	if err == nil {
+		d.logger.WithContext(ctx).Info("preparing database: backfilling file statuses")
+		err = backfillFileStatuses(ctx, d.ent)
+	}
+	if err == nil {
+		d.logger.WithContext(ctx).Info("preparing database: backfilling file versions")
+		err = backfillFileLogicalPaths(ctx, d.db)
+	}
 	migrateValuesAllowedSeeding := []conf.Data_Database_Migrate{
 		conf.Data_Database_soft,
 		conf.Data_Database_hard,
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver for Go's database/sql package
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/conf"
+)
+
+func TestMigrateSoftDropsUniqueObjectPathIndex(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup, err := NewData(&conf.Data{Database: &conf.Data_Database{
+		Driver: `sqlite3`,
+		Source: `file:` + uuid.NewString() + `?mode=memory&cache=shared&_fk=1`,
+	}}, log.NewStdLogger(io.Discard))
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	d := database.(*Data)
+
+	require.NoError(t, d.MigrateSoft(ctx))
+
+	// database created before versioning has unique index of object paths
+	_, err = d.db.ExecContext(ctx, `DROP INDEX `+fileObjectPathIndex)
+	require.NoError(t, err)
+	_, err = d.db.ExecContext(ctx, `CREATE UNIQUE INDEX `+fileObjectPathIndex+` ON files (object_path)`)
+	require.NoError(t, err)
+
+	isUnique := func() bool {
+		var unique bool
+		require.NoError(t, d.db.QueryRowContext(
+			ctx,
+			`SELECT "unique" FROM pragma_index_list('files') WHERE name = ?`,
+			fileObjectPathIndex,
+		).Scan(&unique))
+		return unique
+	}
+	require.True(t, isUnique())
+
+	require.NoError(t, d.MigrateSoft(ctx))
+	require.False(t, isUnique(), `index is created again as not unique one`)
+
+	require.NoError(t, d.MigrateSoft(ctx))
+	require.False(t, isUnique())
+}
@@ -2,8 +2,12 @@ package data
 
 import (
 	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/phlx-ru/hatchet/logger"
@@ -11,13 +15,28 @@ import (
 	"github.com/phlx-ru/hatchet/watcher"
 
 	"storage/ent"
+	"storage/ent/file"
 	"storage/ent/predicate"
 )
 
 const (
 	metricPrefix = `data.file`
+
+	// fileObjectPathIndex is a name of index of object paths given by ent
+	fileObjectPathIndex = `file_object_path`
 )
 
+// ErrStatusTransition is returned when file can not come to requested status from its current status
+var ErrStatusTransition = errors.New(`invalid file status transition`)
+
+// fileStatusTransitions lists statuses from which file may come to the status
+var fileStatusTransitions = map[file.Status][]file.Status{
+	file.StatusActive:  {file.StatusPending, file.StatusDeleted},
+	file.StatusFailed:  {file.StatusPending},
+	file.StatusDeleted: {file.StatusActive},
+	file.StatusPurged:  {file.StatusDeleted, file.StatusFailed},
+}
+
 type FileRepo struct {
 	data    Database
 	metric  metrics.Metrics
@@ -35,35 +54,163 @@ func NewFileRepo(data Database, logs log.Logger, metric metrics.Metrics) *FileRe
 	}
 }
 
-func (f *FileRepo) Create(ctx context.Context, file *ent.File) (*ent.File, error) {
+// Create saves new file which is either pending for upload or active at once, file becomes the next version
+// of files with the same logical path, numbers of failed versions are not reused
+func (f *FileRepo) Create(ctx context.Context, created *ent.File) (*ent.File, error) {
 	var err error
 	defer f.watcher.OnPreparedMethod(`Create`).WithFields(map[string]any{
-		"filename":   file.Filename,
-		"objectPath": file.ObjectPath,
+		"filename":   created.Filename,
+		"objectPath": created.ObjectPath,
+		"status":     created.Status,
 	}).Results(func() (context.Context, error) {
 		return ctx, err
 	})
 
-	creating := f.client(ctx).Create().
-		SetUserID(file.UserID).
-		SetFilename(file.Filename).
-		SetObjectPath(file.ObjectPath).
-		SetSize(file.Size).
-		SetMimeType(file.MimeType)
+	status := created.Status
+	if status == "" {
+		status = file.StatusPending
+	}
+	if status != file.StatusPending && status != file.StatusActive {
+		err = fmt.Errorf(`%w: file can not be created as %s`, ErrStatusTransition, status)
+		return nil, err
+	}
+
+	visibility := created.Visibility
+	if visibility == "" {
+		visibility = file.DefaultVisibility
+	}
 
-	if file.DeletedAt != nil {
-		creating.SetDeletedAt(*file.DeletedAt)
+	logicalPath := created.LogicalPath
+	if logicalPath == "" {
+		logicalPath = created.ObjectPath
+	}
+	version, err := f.nextVersion(ctx, logicalPath)
+	if err != nil {
+		return nil, err
 	}
 
-	created, err := creating.Save(ctx)
+	saved, err := f.client(ctx).Create().
+		SetUserID(created.UserID).
+		SetFilename(created.Filename).
+		SetObjectPath(created.ObjectPath).
+		SetLogicalPath(logicalPath).
+		SetVersion(version).
+		SetSize(created.Size).
+		SetMimeType(created.MimeType).
+		SetDetectedMimeType(created.DetectedMimeType).
+		SetEtag(created.Etag).
+		SetNillableLastModified(created.LastModified).
+		SetSha256(created.Sha256).
+		SetMd5(created.Md5).
+		SetNillableBlobID(created.BlobID).
+		SetStatus(status).
+		SetVisibility(visibility).
+		SetScanStatus(scanStatusOf(created)).
+		SetScanResult(created.ScanResult).
+		SetNillableScannedAt(created.ScannedAt).
+		Save(ctx)
 
-	return created, err
+	return saved, err
 }
 
+// Delete moves active file to deleted
 func (f *FileRepo) Delete(ctx context.Context, uid string) error {
 	var err error
 	defer f.watcher.OnPreparedMethod(`Delete`).WithFields(map[string]any{
 		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		isStatusTransitionError,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = f.transition(ctx, uid, file.StatusDeleted, f.client(ctx).Update().SetDeletedAt(time.Now()))
+
+	return err
+}
+
+// Restore makes deleted file active again
+func (f *FileRepo) Restore(ctx context.Context, uid string) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`Restore`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		isStatusTransitionError,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = f.transition(ctx, uid, file.StatusActive, f.client(ctx).Update().
+		Where(fileFilterByStatus(file.StatusDeleted)).
+		ClearDeletedAt(),
+	)
+
+	return err
+}
+
+// Activate makes pending file available and saves info of its uploaded object or blob
+func (f *FileRepo) Activate(ctx context.Context, activated *ent.File) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`Activate`).WithFields(map[string]any{
+		"uid": activated.UID.String(),
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		isStatusTransitionError,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = f.transition(ctx, activated.UID.String(), file.StatusActive, f.client(ctx).Update().
+		Where(fileFilterByStatus(file.StatusPending)).
+		SetSize(activated.Size).
+		SetDetectedMimeType(activated.DetectedMimeType).
+		SetEtag(activated.Etag).
+		SetNillableLastModified(activated.LastModified).
+		SetSha256(activated.Sha256).
+		SetMd5(activated.Md5).
+		SetNillableBlobID(activated.BlobID).
+		SetScanStatus(scanStatusOf(activated)),
+	)
+
+	return err
+}
+
+// Fail marks pending file which upload is failed
+func (f *FileRepo) Fail(ctx context.Context, uid string) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`Fail`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		isStatusTransitionError,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = f.transition(ctx, uid, file.StatusFailed, f.client(ctx).Update())
+
+	return err
+}
+
+// Purge marks file which content is removed, purged file does not refer to blob anymore
+func (f *FileRepo) Purge(ctx context.Context, uid string) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`Purge`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		isStatusTransitionError,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	err = f.transition(ctx, uid, file.StatusPurged, f.client(ctx).Update().ClearBlobID())
+
+	return err
+}
+
+// UpdateObjectInfo saves info of file object for files uploaded before it was stored
+func (f *FileRepo) UpdateObjectInfo(ctx context.Context, uid string, size int, etag string, lastModified time.Time) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`UpdateObjectInfo`).WithFields(map[string]any{
+		"uid": uid,
 	}).Results(func() (context.Context, error) {
 		return ctx, err
 	})
@@ -71,16 +218,26 @@ func (f *FileRepo) Delete(ctx context.Context, uid string) error {
 	_, err = f.client(ctx).
 		Update().
 		Where(fileFilterByUID(uid)).
-		SetDeletedAt(time.Now()).
+		SetSize(size).
+		SetEtag(etag).
+		SetLastModified(lastModified).
 		Save(ctx)
 
 	return err
 }
 
-func (f *FileRepo) Restore(ctx context.Context, uid string) error {
+// SaveScanResult saves result of antivirus scan of file content
+func (f *FileRepo) SaveScanResult(
+	ctx context.Context,
+	uid string,
+	status file.ScanStatus,
+	result string,
+	scannedAt time.Time,
+) error {
 	var err error
-	defer f.watcher.OnPreparedMethod(`Restore`).WithFields(map[string]any{
-		"uid": uid,
+	defer f.watcher.OnPreparedMethod(`SaveScanResult`).WithFields(map[string]any{
+		"uid":    uid,
+		"status": status,
 	}).Results(func() (context.Context, error) {
 		return ctx, err
 	})
@@ -88,8 +245,34 @@ func (f *FileRepo) Restore(ctx context.Context, uid string) error {
 	_, err = f.client(ctx).
 		Update().
 		Where(fileFilterByUID(uid)).
-		ClearDeletedAt().
+		SetScanStatus(status).
+		SetScanResult(result).
+		SetScannedAt(scannedAt).
+		Save(ctx)
+
+	return err
+}
+
+// UpdateObjectPath changes key of file object, file is not changed if its key is changed concurrently
+func (f *FileRepo) UpdateObjectPath(ctx context.Context, uid, from, to string) error {
+	var err error
+	defer f.watcher.OnPreparedMethod(`UpdateObjectPath`).WithFields(map[string]any{
+		"uid":  uid,
+		"from": from,
+		"to":   to,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := f.client(ctx).
+		Update().
+		Where(fileFilterByUID(uid)).
+		Where(fileFilterByObjectPath(from)).
+		SetObjectPath(to).
 		Save(ctx)
+	if err == nil && updated == 0 {
+		err = fmt.Errorf(`object path of file [%s] is not [%s] anymore`, uid, from)
+	}
 
 	return err
 }
@@ -102,15 +285,59 @@ func (f *FileRepo) FindByUID(ctx context.Context, uid string) (*ent.File, error)
 		return ctx, err
 	})
 
-	file, err := f.client(ctx).
+	found, err := f.client(ctx).
 		Query().
+		WithBlob().
 		Where(fileFilterActive()).
 		Where(fileFilterByUID(uid)).
 		Only(ctx)
 
-	return file, err
+	return found, err
+}
+
+// FindPendingByUID finds file which is created but not activated yet
+func (f *FileRepo) FindPendingByUID(ctx context.Context, uid string) (*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindPendingByUID`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatus(file.StatusPending)).
+		Where(fileFilterByUID(uid)).
+		Only(ctx)
+
+	return found, err
 }
 
+// FindDeletedByUID finds file which is in trash
+func (f *FileRepo) FindDeletedByUID(ctx context.Context, uid string) (*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindDeletedByUID`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatus(file.StatusDeleted)).
+		Where(fileFilterByUID(uid)).
+		Only(ctx)
+
+	return found, err
+}
+
+// FindByUserID finds the latest versions of user files
 func (f *FileRepo) FindByUserID(ctx context.Context, userID, limit, offset int) ([]*ent.File, error) {
 	var err error
 	defer f.watcher.OnPreparedMethod(`FindByUID`).WithFields(map[string]any{
@@ -119,15 +346,214 @@ func (f *FileRepo) FindByUserID(ctx context.Context, userID, limit, offset int)
 		return ctx, err
 	})
 
-	file, err := f.client(ctx).
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterActive()).
+		Where(fileFilterByUserID(userID)).
+		Where(fileFilterLatestVersion()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+
+	return found, err
+}
+
+// FindActive finds the latest versions of files of all users
+func (f *FileRepo) FindActive(ctx context.Context, limit, offset int) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindActive`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterActive()).
+		Where(fileFilterLatestVersion()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+
+	return found, err
+}
+
+// Usage counts files of user and their total size, pending and deleted files take space too until they fail or are purged
+func (f *FileRepo) Usage(ctx context.Context, userID int) (bytes int64, files int, err error) {
+	defer f.watcher.OnPreparedMethod(`Usage`).WithFields(map[string]any{
+		"userID": userID,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	var usage []struct {
+		Bytes stdsql.NullInt64 `json:"bytes"`
+		Files int              `json:"files"`
+	}
+	err = f.client(ctx).
+		Query().
+		Where(fileFilterByUserID(userID)).
+		Where(fileFilterByStatuses([]file.Status{file.StatusPending, file.StatusActive, file.StatusDeleted})).
+		Aggregate(
+			ent.As(ent.Sum(file.FieldSize), `bytes`),
+			ent.As(ent.Count(), `files`),
+		).
+		Scan(ctx, &usage)
+	if err != nil || len(usage) == 0 {
+		return 0, 0, err
+	}
+
+	return usage[0].Bytes.Int64, usage[0].Files, nil
+}
+
+// FindLatestVersion finds active version of file with the greatest number
+func (f *FileRepo) FindLatestVersion(ctx context.Context, logicalPath string) (*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindLatestVersion`).WithFields(map[string]any{
+		"logicalPath": logicalPath,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterActive()).
+		Where(fileFilterByLogicalPath(logicalPath)).
+		Order(ent.Desc(file.FieldVersion), ent.Desc(file.FieldID)).
+		First(ctx)
+
+	return found, err
+}
+
+// FindVersion finds active version of file by its number
+func (f *FileRepo) FindVersion(ctx context.Context, logicalPath string, version int) (*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindVersion`).WithFields(map[string]any{
+		"logicalPath": logicalPath,
+		"version":     version,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
 		Query().
+		WithBlob().
 		Where(fileFilterActive()).
+		Where(fileFilterByLogicalPath(logicalPath)).
+		Where(fileFilterByVersion(version)).
+		Order(ent.Desc(file.FieldID)).
+		First(ctx)
+
+	return found, err
+}
+
+// FindVersions finds active and deleted versions of file, the latest versions go first
+func (f *FileRepo) FindVersions(ctx context.Context, logicalPath string) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindVersions`).WithFields(map[string]any{
+		"logicalPath": logicalPath,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatuses([]file.Status{file.StatusActive, file.StatusDeleted})).
+		Where(fileFilterByLogicalPath(logicalPath)).
+		Order(ent.Desc(file.FieldVersion), ent.Desc(file.FieldID)).
+		All(ctx)
+
+	return found, err
+}
+
+// FindDeletedByUserID finds files of user which are in trash, the last deleted files go first
+func (f *FileRepo) FindDeletedByUserID(ctx context.Context, userID, limit, offset int) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindDeletedByUserID`).WithFields(map[string]any{
+		"userID": userID,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatus(file.StatusDeleted)).
 		Where(fileFilterByUserID(userID)).
+		Order(ent.Desc(file.FieldDeletedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+
+	return found, err
+}
+
+// FindDeletedBefore finds files of all users deleted before the time, the first deleted files go first
+func (f *FileRepo) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindDeletedBefore`).WithFields(map[string]any{
+		"before": before,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatus(file.StatusDeleted)).
+		Where(fileFilterDeletedBefore(before)).
+		Order(ent.Asc(file.FieldDeletedAt)).
+		Limit(limit).
+		All(ctx)
+
+	return found, err
+}
+
+// FindByStatus finds files of all users in the status, the oldest files go first
+func (f *FileRepo) FindByStatus(ctx context.Context, status file.Status, limit, offset int) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindByStatus`).WithFields(map[string]any{
+		"status": status,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterByStatus(status)).
+		Order(ent.Asc(file.FieldCreatedAt)).
 		Limit(limit).
 		Offset(offset).
 		All(ctx)
 
-	return file, err
+	return found, err
+}
+
+// FindQuarantined finds active files which wait for antivirus scan, files which were not updated for longer go first,
+// so files which scan fails again do not block the others
+func (f *FileRepo) FindQuarantined(ctx context.Context, limit int) ([]*ent.File, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindQuarantined`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	found, err := f.client(ctx).
+		Query().
+		WithBlob().
+		Where(fileFilterActive()).
+		Where(fileFilterByScanStatus(file.ScanStatusQuarantined)).
+		Order(ent.Asc(file.FieldUpdatedAt), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+
+	return found, err
 }
 
 func (f *FileRepo) FindByFilename(ctx context.Context, filename string) (*ent.File, error) {
@@ -140,13 +566,14 @@ func (f *FileRepo) FindByFilename(ctx context.Context, filename string) (*ent.Fi
 		return ctx, err
 	})
 
-	file, err := f.client(ctx).
+	found, err := f.client(ctx).
 		Query().
+		WithBlob().
 		Where(fileFilterActive()).
 		Where(fileFilterByFilename(filename)).
 		First(ctx)
 
-	return file, err
+	return found, err
 }
 
 func (f *FileRepo) FindByObjectPath(ctx context.Context, objectPath string) (*ent.File, error) {
@@ -159,22 +586,224 @@ func (f *FileRepo) FindByObjectPath(ctx context.Context, objectPath string) (*en
 		return ctx, err
 	})
 
-	file, err := f.client(ctx).
+	found, err := f.client(ctx).
 		Query().
+		WithBlob().
 		Where(fileFilterActive()).
 		Where(fileFilterByObjectPath(objectPath)).
 		First(ctx)
 
-	return file, err
+	return found, err
+}
+
+// FindObjectPaths returns paths of objects owned by files
+func (f *FileRepo) FindObjectPaths(ctx context.Context) ([]string, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`FindObjectPaths`).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	objectPaths, err := f.client(ctx).
+		Query().
+		Where(fileFilterOwnsObject()).
+		Select(file.FieldObjectPath).
+		Strings(ctx)
+
+	return objectPaths, err
+}
+
+// HasObjectOwner checks that some file owns object, files uploaded to the same path may share it
+func (f *FileRepo) HasObjectOwner(ctx context.Context, objectPath string) (bool, error) {
+	var err error
+	defer f.watcher.OnPreparedMethod(`HasObjectOwner`).WithFields(map[string]any{
+		"objectPath": objectPath,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	exists, err := f.client(ctx).
+		Query().
+		Where(fileFilterOwnsObject()).
+		Where(fileFilterByObjectPath(objectPath)).
+		Exist(ctx)
+
+	return exists, err
+}
+
+// nextVersion gives number to new version of file, concurrent uploads may get the same number,
+// then the later one is taken as the latest
+func (f *FileRepo) nextVersion(ctx context.Context, logicalPath string) (int, error) {
+	latest, err := f.client(ctx).
+		Query().
+		Where(fileFilterByLogicalPath(logicalPath)).
+		Order(ent.Desc(file.FieldVersion)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return latest.Version + 1, nil
+}
+
+// transition sets status of file by update with additional changes, if file is not in any status
+// from which requested one is reachable, ErrStatusTransition is returned
+func (f *FileRepo) transition(ctx context.Context, uid string, to file.Status, update *ent.FileUpdate) error {
+	updated, err := update.
+		Where(fileFilterByUID(uid)).
+		Where(fileFilterByStatuses(fileStatusTransitions[to])).
+		SetStatus(to).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	current, err := f.client(ctx).
+		Query().
+		Where(fileFilterByUID(uid)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf(`%w: file [%s] can not become %s from %s`, ErrStatusTransition, uid, to, current.Status)
 }
 
 func (f *FileRepo) client(ctx context.Context) *ent.FileClient {
 	return client(f.data)(ctx).File
 }
 
+// scanStatusOf returns scan status of file, files created without it are not scanned
+func scanStatusOf(f *ent.File) file.ScanStatus {
+	if f.ScanStatus == "" {
+		return file.DefaultScanStatus
+	}
+	return f.ScanStatus
+}
+
+func isStatusTransitionError(err error) bool {
+	return errors.Is(err, ErrStatusTransition)
+}
+
+// backfillFileLogicalPaths makes files created before versioning the first versions of themselves
+func backfillFileLogicalPaths(ctx context.Context, db *stdsql.DB) error {
+	_, err := db.ExecContext(ctx, `UPDATE files SET logical_path = object_path WHERE logical_path = ''`)
+	return err
+}
+
+// dropFileObjectPathUniqueIndex drops unique index of object paths made before versioning, versions and rolled back
+// files share object paths now. Index which is not unique already is kept, so it is not rebuilt on every start.
+func dropFileObjectPathUniqueIndex(ctx context.Context, db *stdsql.DB, driver string) error {
+	var query string
+	switch driver {
+	case dialect.Postgres:
+		query = `SELECT i.indisunique FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid WHERE c.relname = $1`
+	case dialect.SQLite:
+		query = `SELECT l."unique" FROM sqlite_master m, pragma_index_list(m.tbl_name) l ` +
+			`WHERE m.type = 'index' AND m.name = ? AND l.name = m.name`
+	default:
+		return nil
+	}
+
+	var unique bool
+	err := db.QueryRowContext(ctx, query, fileObjectPathIndex).Scan(&unique)
+	if errors.Is(err, stdsql.ErrNoRows) || (err == nil && !unique) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, `DROP INDEX `+fileObjectPathIndex)
+	return err
+}
+
+// backfillFileStatuses gives status to files created before statuses appeared, pending and deleted files
+// were both marked by deletion time, so they all become deleted
+func backfillFileStatuses(ctx context.Context, client *ent.Client) error {
+	_, err := client.File.
+		Update().
+		Where(fileFilterByStatus(file.StatusActive)).
+		Where(func(selector *sql.Selector) {
+			selector.Where(sql.P().NotNull(`deleted_at`))
+		}).
+		SetStatus(file.StatusDeleted).
+		Save(ctx)
+	return err
+}
+
 func fileFilterActive() predicate.File {
+	return fileFilterByStatus(file.StatusActive)
+}
+
+func fileFilterByStatus(status file.Status) predicate.File {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`status`, status))
+	}
+}
+
+func fileFilterByScanStatus(status file.ScanStatus) predicate.File {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`scan_status`, status))
+	}
+}
+
+func fileFilterByStatuses(statuses []file.Status) predicate.File {
+	values := make([]any, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, status)
+	}
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().In(`status`, values...))
+	}
+}
+
+// fileFilterOwnsObject selects files which own objects: deduplicated files keep content in blobs
+// and objects of failed and purged files are garbage
+func fileFilterOwnsObject() predicate.File {
+	statuses := fileFilterByStatuses([]file.Status{file.StatusPending, file.StatusActive, file.StatusDeleted})
+	return func(selector *sql.Selector) {
+		statuses(selector)
+		selector.Where(sql.P().IsNull(`blob_id`))
+	}
+}
+
+func fileFilterDeletedBefore(before time.Time) predicate.File {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().LT(`deleted_at`, before))
+	}
+}
+
+// fileFilterLatestVersion skips files which have newer active versions
+func fileFilterLatestVersion() predicate.File {
+	return func(selector *sql.Selector) {
+		newer := sql.Table(file.Table).As(`newer`)
+		selector.Where(sql.NotExists(
+			sql.Select(newer.C(file.FieldID)).
+				From(newer).
+				Where(sql.And(
+					sql.ColumnsEQ(newer.C(file.FieldLogicalPath), selector.C(file.FieldLogicalPath)),
+					sql.EQ(newer.C(file.FieldStatus), file.StatusActive),
+					sql.Or(
+						sql.ColumnsGT(newer.C(file.FieldVersion), selector.C(file.FieldVersion)),
+						sql.And(
+							sql.ColumnsEQ(newer.C(file.FieldVersion), selector.C(file.FieldVersion)),
+							sql.ColumnsGT(newer.C(file.FieldID), selector.C(file.FieldID)),
+						),
+					),
+				)),
+		))
+	}
+}
+
+func fileFilterByLogicalPath(logicalPath string) predicate.File {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`logical_path`, logicalPath))
+	}
+}
+
+func fileFilterByVersion(version int) predicate.File {
 	return func(selector *sql.Selector) {
-		selector.Where(sql.P().IsNull(`deleted_at`))
+		selector.Where(sql.P().EQ(`version`, version))
 	}
 }
 
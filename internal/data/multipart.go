@@ -0,0 +1,304 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/phlx-ru/hatchet/logger"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/phlx-ru/hatchet/watcher"
+
+	"storage/ent"
+	"storage/ent/multipart"
+	"storage/ent/predicate"
+)
+
+const (
+	multipartMetricPrefix = `data.multipart`
+)
+
+type MultipartRepo struct {
+	data    Database
+	metric  metrics.Metrics
+	logs    *log.Helper
+	watcher *watcher.Watcher
+}
+
+func NewMultipartRepo(data Database, logs log.Logger, metric metrics.Metrics) *MultipartRepo {
+	loggerHelper := logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, multipartMetricPrefix)
+	return &MultipartRepo{
+		data:    data,
+		metric:  metric,
+		logs:    loggerHelper,
+		watcher: watcher.New(multipartMetricPrefix, loggerHelper, metric),
+	}
+}
+
+func (m *MultipartRepo) Create(ctx context.Context, upload *ent.Multipart) (*ent.Multipart, error) {
+	var err error
+	defer m.watcher.OnPreparedMethod(`Create`).WithFields(map[string]any{
+		"filename":   upload.Filename,
+		"objectPath": upload.ObjectPath,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	visibility := upload.Visibility
+	if visibility == "" {
+		visibility = multipart.DefaultVisibility
+	}
+
+	created, err := m.client(ctx).Create().
+		SetUserID(upload.UserID).
+		SetFilename(upload.Filename).
+		SetObjectPath(upload.ObjectPath).
+		SetMimeType(upload.MimeType).
+		SetUploadID(upload.UploadID).
+		SetNillableUploadLength(upload.UploadLength).
+		SetVisibility(visibility).
+		Save(ctx)
+
+	return created, err
+}
+
+func (m *MultipartRepo) FindByUID(ctx context.Context, uid string) (*ent.Multipart, error) {
+	var err error
+	defer m.watcher.OnPreparedMethod(`FindByUID`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	upload, err := m.client(ctx).
+		Query().
+		Where(multipartFilterByUID(uid)).
+		Only(ctx)
+
+	return upload, err
+}
+
+func (m *MultipartRepo) Complete(ctx context.Context, id int, fileUID uuid.UUID) error {
+	var err error
+	defer m.watcher.OnPreparedMethod(`Complete`).WithFields(map[string]any{
+		"id":      id,
+		"fileUID": fileUID.String(),
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = m.client(ctx).
+		UpdateOneID(id).
+		SetStatus(multipart.StatusCompleted).
+		SetFileUID(fileUID).
+		Save(ctx)
+
+	return err
+}
+
+// SetDetectedMimeType saves type of content detected by the first part of upload
+func (m *MultipartRepo) SetDetectedMimeType(ctx context.Context, id int, detectedMimeType string) error {
+	var err error
+	defer m.watcher.OnPreparedMethod(`SetDetectedMimeType`).WithFields(map[string]any{
+		"id":               id,
+		"detectedMimeType": detectedMimeType,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = m.client(ctx).
+		UpdateOneID(id).
+		SetDetectedMimeType(detectedMimeType).
+		Save(ctx)
+
+	return err
+}
+
+func (m *MultipartRepo) Abort(ctx context.Context, id int) error {
+	var err error
+	defer m.watcher.OnPreparedMethod(`Abort`).WithFields(map[string]any{
+		"id": id,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = m.client(ctx).
+		UpdateOneID(id).
+		SetStatus(multipart.StatusAborted).
+		Save(ctx)
+
+	return err
+}
+
+// Lock takes or renews lock of active upload until given time, lock of another request is taken over only
+// after it is expired, locked flag is false if upload is locked by another request or is not active anymore
+func (m *MultipartRepo) Lock(
+	ctx context.Context,
+	id int,
+	lockUID uuid.UUID,
+	now time.Time,
+	until time.Time,
+) (locked bool, err error) {
+	defer m.watcher.OnPreparedMethod(`Lock`).WithFields(map[string]any{
+		"id":      id,
+		"lockUID": lockUID.String(),
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := m.client(ctx).
+		Update().
+		Where(multipartFilterByID(id)).
+		Where(multipartFilterActive()).
+		Where(multipartFilterLockable(lockUID, now)).
+		SetLockUID(lockUID).
+		SetLockedUntil(until).
+		Save(ctx)
+
+	return updated > 0, err
+}
+
+// Unlock releases lock which is taken by request, lock taken over by another request stays
+func (m *MultipartRepo) Unlock(ctx context.Context, id int, lockUID uuid.UUID) error {
+	var err error
+	defer m.watcher.OnPreparedMethod(`Unlock`).WithFields(map[string]any{
+		"id":      id,
+		"lockUID": lockUID.String(),
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = m.client(ctx).
+		Update().
+		Where(multipartFilterByID(id)).
+		Where(multipart.LockUID(lockUID)).
+		ClearLockUID().
+		ClearLockedUntil().
+		Save(ctx)
+
+	return err
+}
+
+// SavePart creates part or replaces previously uploaded part with the same number
+func (m *MultipartRepo) SavePart(ctx context.Context, part *ent.MultipartPart) (*ent.MultipartPart, error) {
+	var err error
+	defer m.watcher.OnPreparedMethod(`SavePart`).WithFields(map[string]any{
+		"multipartID": part.MultipartID,
+		"partNumber":  part.PartNumber,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	parts := client(m.data)(ctx).MultipartPart
+	updated, err := parts.Update().
+		Where(multipartPartFilterByMultipartID(part.MultipartID)).
+		Where(multipartPartFilterByPartNumber(part.PartNumber)).
+		SetEtag(part.Etag).
+		SetSize(part.Size).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		saved, err := parts.Create().
+			SetMultipartID(part.MultipartID).
+			SetPartNumber(part.PartNumber).
+			SetEtag(part.Etag).
+			SetSize(part.Size).
+			Save(ctx)
+		return saved, err
+	}
+
+	saved, err := parts.Query().
+		Where(multipartPartFilterByMultipartID(part.MultipartID)).
+		Where(multipartPartFilterByPartNumber(part.PartNumber)).
+		Only(ctx)
+
+	return saved, err
+}
+
+// DeletePart forgets stored part, so it is uploaded again, its content stays in s3 until it is replaced
+func (m *MultipartRepo) DeletePart(ctx context.Context, multipartID, partNumber int) error {
+	var err error
+	defer m.watcher.OnPreparedMethod(`DeletePart`).WithFields(map[string]any{
+		"multipartID": multipartID,
+		"partNumber":  partNumber,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = client(m.data)(ctx).MultipartPart.
+		Delete().
+		Where(multipartPartFilterByMultipartID(multipartID)).
+		Where(multipartPartFilterByPartNumber(partNumber)).
+		Exec(ctx)
+
+	return err
+}
+
+// FindParts returns parts of multipart upload ordered by part number
+func (m *MultipartRepo) FindParts(ctx context.Context, multipartID int) ([]*ent.MultipartPart, error) {
+	var err error
+	defer m.watcher.OnPreparedMethod(`FindParts`).WithFields(map[string]any{
+		"multipartID": multipartID,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	parts, err := client(m.data)(ctx).MultipartPart.
+		Query().
+		Where(multipartPartFilterByMultipartID(multipartID)).
+		Order(ent.Asc(`part_number`)).
+		All(ctx)
+
+	return parts, err
+}
+
+func (m *MultipartRepo) client(ctx context.Context) *ent.MultipartClient {
+	return client(m.data)(ctx).Multipart
+}
+
+func multipartFilterByUID(uid string) predicate.Multipart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`uid`, uid))
+	}
+}
+
+func multipartFilterByID(id int) predicate.Multipart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`id`, id))
+	}
+}
+
+func multipartFilterActive() predicate.Multipart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`status`, multipart.StatusActive))
+	}
+}
+
+// multipartFilterLockable selects uploads which are not locked, are locked by the same request or which lock is expired
+func multipartFilterLockable(lockUID uuid.UUID, now time.Time) predicate.Multipart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.Or(
+			sql.IsNull(selector.C(multipart.FieldLockUID)),
+			sql.EQ(selector.C(multipart.FieldLockUID), lockUID),
+			sql.LT(selector.C(multipart.FieldLockedUntil), now),
+		))
+	}
+}
+
+func multipartPartFilterByMultipartID(multipartID int) predicate.MultipartPart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`multipart_id`, multipartID))
+	}
+}
+
+func multipartPartFilterByPartNumber(partNumber int) predicate.MultipartPart {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`part_number`, partNumber))
+	}
+}
@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/phlx-ru/hatchet/watcher"
+
+	"storage/ent"
+	"storage/ent/predicate"
+	"storage/ent/sharelink"
+)
+
+const (
+	shareLinkMetricPrefix = `data.share_link`
+)
+
+type ShareLinkRepo struct {
+	data    Database
+	metric  metrics.Metrics
+	logs    *log.Helper
+	watcher *watcher.Watcher
+}
+
+func NewShareLinkRepo(data Database, logs log.Logger, metric metrics.Metrics) *ShareLinkRepo {
+	loggerHelper := logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, shareLinkMetricPrefix)
+	return &ShareLinkRepo{
+		data:    data,
+		metric:  metric,
+		logs:    loggerHelper,
+		watcher: watcher.New(shareLinkMetricPrefix, loggerHelper, metric),
+	}
+}
+
+func (s *ShareLinkRepo) Create(ctx context.Context, link *ent.ShareLink) (*ent.ShareLink, error) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`Create`).WithFields(map[string]any{
+		"fileID": link.FileID,
+		"userID": link.UserID,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	created, err := s.client(ctx).Create().
+		SetToken(link.Token).
+		SetFileID(link.FileID).
+		SetUserID(link.UserID).
+		SetPasswordHash(link.PasswordHash).
+		SetNillableExpiresAt(link.ExpiresAt).
+		SetNillableMaxDownloads(link.MaxDownloads).
+		Save(ctx)
+
+	return created, err
+}
+
+// FindByToken finds link with its shared file and content blob of the file
+func (s *ShareLinkRepo) FindByToken(ctx context.Context, token string) (*ent.ShareLink, error) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`FindByToken`).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	link, err := s.client(ctx).
+		Query().
+		WithFile(func(query *ent.FileQuery) {
+			query.WithBlob()
+		}).
+		Where(shareLinkFilterByToken(token)).
+		Only(ctx)
+
+	return link, err
+}
+
+// FindByUID finds link with its shared file
+func (s *ShareLinkRepo) FindByUID(ctx context.Context, uid string) (*ent.ShareLink, error) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`FindByUID`).WithFields(map[string]any{
+		"uid": uid,
+	}).WithIgnoredErrorsChecks([]func(error) bool{
+		ent.IsNotFound,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	link, err := s.client(ctx).
+		Query().
+		WithFile().
+		Where(shareLinkFilterByUID(uid)).
+		Only(ctx)
+
+	return link, err
+}
+
+// FindByFileID finds all links of file including revoked and expired ones, the last created links go first
+func (s *ShareLinkRepo) FindByFileID(ctx context.Context, fileID int) ([]*ent.ShareLink, error) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`FindByFileID`).WithFields(map[string]any{
+		"fileID": fileID,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	links, err := s.client(ctx).
+		Query().
+		Where(shareLinkFilterByFileID(fileID)).
+		Order(ent.Desc(sharelink.FieldCreatedAt), ent.Desc(sharelink.FieldID)).
+		All(ctx)
+
+	return links, err
+}
+
+// Revoke makes link unavailable, revoked flag is false if link was already revoked
+func (s *ShareLinkRepo) Revoke(ctx context.Context, uid string) (revoked bool, err error) {
+	defer s.watcher.OnPreparedMethod(`Revoke`).WithFields(map[string]any{
+		"uid": uid,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := s.client(ctx).
+		Update().
+		Where(shareLinkFilterByUID(uid)).
+		Where(shareLinkFilterNotRevoked()).
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+
+	return updated > 0, err
+}
+
+// CountDownload adds download to link if it is still available at the moment, so concurrent downloads
+// never exceed the limit, counted flag is false if link is revoked, expired or exhausted
+func (s *ShareLinkRepo) CountDownload(ctx context.Context, id int, now time.Time) (counted bool, err error) {
+	defer s.watcher.OnPreparedMethod(`CountDownload`).WithFields(map[string]any{
+		"id": id,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	updated, err := s.client(ctx).
+		Update().
+		Where(shareLinkFilterByID(id)).
+		Where(shareLinkFilterAvailable(now)).
+		AddDownloads(1).
+		Save(ctx)
+
+	return updated > 0, err
+}
+
+// UncountDownload removes download which is counted for request not finished with whole file
+func (s *ShareLinkRepo) UncountDownload(ctx context.Context, id int) (err error) {
+	defer s.watcher.OnPreparedMethod(`UncountDownload`).WithFields(map[string]any{
+		"id": id,
+	}).Results(func() (context.Context, error) {
+		return ctx, err
+	})
+
+	_, err = s.client(ctx).
+		Update().
+		Where(shareLinkFilterByID(id)).
+		Where(shareLinkFilterDownloaded()).
+		AddDownloads(-1).
+		Save(ctx)
+
+	return err
+}
+
+func (s *ShareLinkRepo) client(ctx context.Context) *ent.ShareLinkClient {
+	return client(s.data)(ctx).ShareLink
+}
+
+func shareLinkFilterByID(id int) predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`id`, id))
+	}
+}
+
+func shareLinkFilterByUID(uid string) predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`uid`, uid))
+	}
+}
+
+func shareLinkFilterByToken(token string) predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`token`, token))
+	}
+}
+
+func shareLinkFilterByFileID(fileID int) predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().EQ(`file_id`, fileID))
+	}
+}
+
+func shareLinkFilterNotRevoked() predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().IsNull(`revoked_at`))
+	}
+}
+
+func shareLinkFilterDownloaded() predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.P().GT(`downloads`, 0))
+	}
+}
+
+// shareLinkFilterAvailable selects links which are not revoked, expired or exhausted
+func shareLinkFilterAvailable(now time.Time) predicate.ShareLink {
+	return func(selector *sql.Selector) {
+		selector.Where(sql.And(
+			sql.IsNull(selector.C(sharelink.FieldRevokedAt)),
+			sql.Or(
+				sql.IsNull(selector.C(sharelink.FieldExpiresAt)),
+				sql.GT(selector.C(sharelink.FieldExpiresAt), now),
+			),
+			sql.Or(
+				sql.IsNull(selector.C(sharelink.FieldMaxDownloads)),
+				sql.ColumnsLT(selector.C(sharelink.FieldDownloads), selector.C(sharelink.FieldMaxDownloads)),
+			),
+		))
+	}
+}
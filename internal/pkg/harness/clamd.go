@@ -0,0 +1,111 @@
+package harness
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// EICAR is a standard antivirus test file, fake clamd reports content which contains it as infected
+	EICAR = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+	// EICARSignature is a name of malware found in EICAR test file
+	EICARSignature = `Win.Test.EICAR_HDB-1`
+)
+
+// Clamd is a fake clamd daemon which answers INSTREAM commands over tcp
+type Clamd struct {
+	listener net.Listener
+
+	mutex   sync.RWMutex
+	failing bool
+	scans   int
+}
+
+// NewClamd starts fake clamd on free local port and stops it on the test cleanup
+func NewClamd(t *testing.T) *Clamd {
+	t.Helper()
+	listener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+	require.NoError(t, err)
+	fake := &Clamd{listener: listener}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go fake.serve()
+	return fake
+}
+
+// Address is an address which fake clamd listens on
+func (c *Clamd) Address() string {
+	return c.listener.Addr().String()
+}
+
+// SetFailing makes fake clamd answer with error instead of verdict
+func (c *Clamd) SetFailing(failing bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.failing = failing
+}
+
+// Scans is a count of received streams
+func (c *Clamd) Scans() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.scans
+}
+
+func (c *Clamd) serve() {
+	for {
+		conn, err := c.listener.Accept()
+		if err != nil {
+			return
+		}
+		go c.handle(conn)
+	}
+}
+
+func (c *Clamd) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	reader := bufio.NewReader(conn)
+	if command, err := reader.ReadString(0); err != nil || command != "zINSTREAM\x00" {
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+	content := &bytes.Buffer{}
+	for {
+		var length uint32
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return
+		}
+		if length == 0 {
+			break
+		}
+		if _, err := io.CopyN(content, reader, int64(length)); err != nil {
+			return
+		}
+	}
+
+	c.mutex.Lock()
+	c.scans++
+	failing := c.failing
+	c.mutex.Unlock()
+
+	reply := "stream: OK\x00"
+	switch {
+	case failing:
+		reply = "Can't allocate memory ERROR\x00"
+	case strings.Contains(content.String(), EICAR):
+		reply = "stream: " + EICARSignature + " FOUND\x00"
+	}
+	_, _ = conn.Write([]byte(reply))
+}
@@ -0,0 +1,259 @@
+// Package harness builds the whole storage service on top of in-memory dependencies for end-to-end tests:
+// SQLite ent client, in-memory object storage and fake auth client behind a real gin router and httptest server.
+package harness
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	entDialectSQL "entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver for Go's database/sql package
+	"github.com/phlx-ru/hatchet/jwt"
+	"github.com/phlx-ru/hatchet/metrics"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"storage/ent"
+	"storage/ent/enttest"
+	"storage/internal/biz"
+	"storage/internal/clients/auth"
+	"storage/internal/clients/clamd"
+	"storage/internal/clients/minio"
+	"storage/internal/conf"
+	"storage/internal/data"
+	"storage/internal/server"
+	"storage/internal/service"
+)
+
+const (
+	name          = `storage-harness`
+	jwtSecret     = `harness-secret`
+	serverTimeout = 10 * time.Second
+)
+
+// Harness is a running storage service with accessible dependencies
+type Harness struct {
+	Server  *httptest.Server
+	Ent     *ent.Client
+	Storage *minio.Memory
+	Auth    *Auth
+	// Usecase runs background jobs which are not reachable by http
+	Usecase *biz.StorageUsecase
+	// StorageConf is used by running service, so tests may change it
+	StorageConf *conf.Storage
+	// PolicyConf is used by running service, roles which are not set get default permissions
+	PolicyConf *conf.Policy
+
+	integrationsToken string
+}
+
+// Option changes dependencies of service before it is started
+type Option func(o *options)
+
+type options struct {
+	clamd *Clamd
+}
+
+// WithClamd makes service scan uploaded files by fake clamd
+func WithClamd(fake *Clamd) Option {
+	return func(o *options) {
+		o.clamd = fake
+	}
+}
+
+// New starts storage service and stops it on the test cleanup
+func New(t *testing.T, opts ...Option) *Harness {
+	t.Helper()
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	gin.DefaultWriter = io.Discard // silent access log
+	logs := log.NewStdLogger(io.Discard)
+	metric, err := metrics.New(`localhost:8125`, name, true)
+	require.NoError(t, err)
+
+	drv, err := entDialectSQL.Open(`sqlite3`, `file:`+uuid.NewString()+`?mode=memory&cache=shared&_fk=1`)
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	database := &Database{db: drv.DB(), ent: client}
+
+	storage := minio.NewMemory()
+	authClient := NewAuth()
+	var scanner clamd.Client
+	if o.clamd != nil {
+		scanner, err = clamd.New(`tcp`, o.clamd.Address(), serverTimeout, metric, logs)
+		require.NoError(t, err)
+	}
+	authConf := &conf.Auth{Jwt: &conf.Auth_JWT{Secret: jwtSecret}}
+	storageConf := &conf.Storage{
+		Download:  &conf.Storage_Download{Mode: conf.Storage_Download_proxy},
+		Reconcile: &conf.Storage_Reconcile{RemoveOrphans: true},
+	}
+	policyConf := &conf.Policy{Roles: map[string]*conf.Policy_Role{}}
+	serverConf := &conf.Server{Http: &conf.Server_HTTP{Timeout: durationpb.New(serverTimeout)}}
+
+	fileRepo := data.NewFileRepo(database, logs, metric)
+	multipartRepo := data.NewMultipartRepo(database, logs, metric)
+	blobRepo := data.NewBlobRepo(database, logs, metric)
+	shareLinkRepo := data.NewShareLinkRepo(database, logs, metric)
+	storageUsecase := biz.NewStorageUsecase(
+		authClient,
+		storage,
+		scanner,
+		fileRepo,
+		multipartRepo,
+		blobRepo,
+		shareLinkRepo,
+		authConf,
+		storageConf,
+		policyConf,
+		metric,
+		logs,
+	)
+	storageService := service.NewGatewayService(storageUsecase, metric, logs)
+	httpServer := server.NewHTTPServer(serverConf, storageService, metric)
+
+	testServer := httptest.NewServer(httpServer)
+	t.Cleanup(func() {
+		testServer.Close()
+		_ = client.Close()
+	})
+
+	return &Harness{
+		Server:            testServer,
+		Ent:               client,
+		Storage:           storage,
+		Auth:              authClient,
+		Usecase:           storageUsecase,
+		StorageConf:       storageConf,
+		PolicyConf:        policyConf,
+		integrationsToken: jwt.Make(name, jwtSecret),
+	}
+}
+
+// Request makes request to the running service, token may be empty for anonymous requests
+func (h *Harness) Request(t *testing.T, method, path, token string, body io.Reader) *http.Response {
+	t.Helper()
+	request, err := http.NewRequest(method, h.Server.URL+path, body)
+	require.NoError(t, err)
+	if token != "" {
+		request.Header.Set(`Authorization`, `Bearer `+token)
+	}
+	return h.Do(t, request)
+}
+
+// IntegrationsRequest makes request on behalf of integrations
+func (h *Harness) IntegrationsRequest(t *testing.T, method, path string, body io.Reader) *http.Response {
+	t.Helper()
+	request, err := http.NewRequest(method, h.Server.URL+path, body)
+	require.NoError(t, err)
+	request.Header.Set(`X-Integrations-Token`, h.integrationsToken)
+	return h.Do(t, request)
+}
+
+// Do sends prepared request and closes response body on the test cleanup, redirects are not followed
+func (h *Harness) Do(t *testing.T, request *http.Request) *http.Response {
+	t.Helper()
+	client := h.Server.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = response.Body.Close()
+	})
+	return response
+}
+
+// ReadBody reads whole response body as string
+func ReadBody(t *testing.T, response *http.Response) string {
+	t.Helper()
+	content, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(content)
+}
+
+// Body makes request body from string
+func Body(content string) io.Reader {
+	return strings.NewReader(content)
+}
+
+// Auth is a fake auth client which knows only registered tokens
+type Auth struct {
+	mutex sync.RWMutex
+	users map[string]*auth.User
+}
+
+func NewAuth() *Auth {
+	return &Auth{
+		users: map[string]*auth.User{},
+	}
+}
+
+// AddUser registers user with token for the next checks
+func (a *Auth) AddUser(token string, user *auth.User) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.users[token] = user
+}
+
+func (a *Auth) Check(_ context.Context, token string) (*auth.CheckResult, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	user, ok := a.users[token]
+	if !ok {
+		return nil, auth.ErrSessionExpiredOrNotFound
+	}
+	return &auth.CheckResult{
+		User: user,
+		Session: &auth.Session{
+			Until: time.Now().Add(time.Hour),
+		},
+	}, nil
+}
+
+// Database implements data.Database over the test ent client
+type Database struct {
+	db  *sql.DB
+	ent *ent.Client
+}
+
+func (d *Database) DB() *sql.DB {
+	return d.db
+}
+
+func (d *Database) Ent() *ent.Client {
+	return d.ent
+}
+
+func (d *Database) MigrateSoft(ctx context.Context) error {
+	return d.ent.Schema.Create(ctx)
+}
+
+func (d *Database) MigrateHard(ctx context.Context) error {
+	return d.ent.Schema.Create(ctx)
+}
+
+func (d *Database) Prepare(_ context.Context, _ conf.Data_Database_Migrate) error {
+	return nil // schema is already created by enttest
+}
+
+func (d *Database) CollectDatabaseMetrics(_ context.Context, _ metrics.Metrics) {}
+
+func (d *Database) Seed(ctx context.Context, seeding func(context.Context, *ent.Client) error) error {
+	return seeding(ctx, d.ent)
+}
@@ -0,0 +1,101 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	// OrientationNormal is orientation of images which pixels are stored as they are shown
+	OrientationNormal = 1
+
+	markerStartOfImage = 0xD8
+	markerStartOfScan  = 0xDA
+	markerEndOfImage   = 0xD9
+	markerApp1         = 0xE1
+
+	tiffMagic          = 42
+	tagOrientation     = 0x0112
+	typeShort          = 3
+	ifdEntrySize       = 12
+	tiffHeaderSize     = 8
+	exifHeader         = "Exif\x00\x00"
+	maxOrientationCode = 8
+)
+
+// Orientation reads orientation of JPEG image from EXIF metadata, cameras of phones store photos as they are
+// captured and tell how to rotate them there; normal orientation is returned when it is unknown
+func Orientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != markerStartOfImage {
+		return OrientationNormal
+	}
+	for pos := 2; pos+4 <= len(content); {
+		if content[pos] != 0xFF {
+			return OrientationNormal
+		}
+		marker := content[pos+1]
+		switch {
+		case marker == 0xFF:
+			pos++ // fill byte
+			continue
+		case marker == markerStartOfScan || marker == markerEndOfImage:
+			return OrientationNormal // metadata goes before image data
+		case marker >= 0xD0 && marker <= 0xD7 || marker == 0x01:
+			pos += 2 // markers without segment
+			continue
+		}
+		length := int(binary.BigEndian.Uint16(content[pos+2:]))
+		if length < 2 || pos+2+length > len(content) {
+			return OrientationNormal
+		}
+		segment := content[pos+4 : pos+2+length]
+		if marker == markerApp1 && bytes.HasPrefix(segment, []byte(exifHeader)) {
+			return tiffOrientation(segment[len(exifHeader):])
+		}
+		pos += 2 + length
+	}
+	return OrientationNormal
+}
+
+// tiffOrientation finds orientation tag in the first directory of TIFF structure of EXIF metadata
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < tiffHeaderSize {
+		return OrientationNormal
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case `II`:
+		order = binary.LittleEndian
+	case `MM`:
+		order = binary.BigEndian
+	default:
+		return OrientationNormal
+	}
+	if order.Uint16(tiff[2:]) != tiffMagic {
+		return OrientationNormal
+	}
+	ifd := int64(order.Uint32(tiff[4:]))
+	if ifd < tiffHeaderSize || ifd+2 > int64(len(tiff)) {
+		return OrientationNormal
+	}
+	count := int64(order.Uint16(tiff[ifd:]))
+	for i := int64(0); i < count; i++ {
+		entry := ifd + 2 + i*ifdEntrySize
+		if entry+ifdEntrySize > int64(len(tiff)) {
+			return OrientationNormal
+		}
+		if order.Uint16(tiff[entry:]) != tagOrientation {
+			continue
+		}
+		// short value is stored in the first bytes of value field
+		if order.Uint16(tiff[entry+2:]) != typeShort {
+			return OrientationNormal
+		}
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < OrientationNormal || orientation > maxOrientationCode {
+			return OrientationNormal
+		}
+		return orientation
+	}
+	return OrientationNormal
+}
@@ -0,0 +1,194 @@
+// Package imaging makes renditions of images: orients them by EXIF metadata, resizes and encodes them
+// with the standard library only, so it is made for previews rather than for high quality resampling.
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+)
+
+// Fit is a way to fit image into requested box
+type Fit string
+
+const (
+	// FitContain scales image to fit the whole of it into box, so one side of rendition may be shorter than box
+	FitContain Fit = `contain`
+	// FitCover scales image to cover the whole box and crops the rest of it around the center
+	FitCover Fit = `cover`
+)
+
+// Format is a format which rendition is encoded in
+type Format string
+
+const (
+	FormatJPEG Format = `jpeg`
+	FormatPNG  Format = `png`
+)
+
+// ContentType returns mime type of format
+func (f Format) ContentType() string {
+	return `image/` + string(f)
+}
+
+// Orient draws image in the way it must be shown by orientation from EXIF metadata
+func Orient(img image.Image, orientation int) *image.RGBA {
+	src := toRGBA(img)
+	if orientation <= OrientationNormal || orientation > maxOrientationCode {
+		return src
+	}
+
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		// orientations from 5 to 8 rotate image by quarter turn
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			sx, sy := x, y
+			switch orientation {
+			case 2: // mirrored horizontally
+				sx = width - 1 - x
+			case 3: // rotated by 180
+				sx, sy = width-1-x, height-1-y
+			case 4: // mirrored vertically
+				sy = height - 1 - y
+			case 5: // mirrored along top-left diagonal
+				sx, sy = y, x
+			case 6: // must be rotated by 90 clockwise
+				sx, sy = y, height-1-x
+			case 7: // mirrored along top-right diagonal
+				sx, sy = width-1-y, height-1-x
+			case 8: // must be rotated by 90 counterclockwise
+				sx, sy = width-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// Resize fits image into box of width and height, zero side of box is derived from aspect ratio of image,
+// images are never enlarged
+func Resize(img *image.RGBA, width, height int, fit Fit) *image.RGBA {
+	crop, dstWidth, dstHeight := Size(img.Rect.Dx(), img.Rect.Dy(), width, height, fit)
+	crop = crop.Add(img.Rect.Min)
+	if crop == img.Rect && dstWidth == crop.Dx() && dstHeight == crop.Dy() {
+		return img
+	}
+	return resample(img, crop, dstWidth, dstHeight)
+}
+
+// Size calculates part of source image which is taken to rendition and size of rendition
+func Size(srcWidth, srcHeight, width, height int, fit Fit) (crop image.Rectangle, dstWidth, dstHeight int) {
+	crop = image.Rect(0, 0, srcWidth, srcHeight)
+	if srcWidth <= 0 || srcHeight <= 0 {
+		return crop, 0, 0
+	}
+
+	if width <= 0 || height <= 0 || fit != FitCover {
+		scale := 1.0
+		if width > 0 {
+			scale = math.Min(scale, float64(width)/float64(srcWidth))
+		}
+		if height > 0 {
+			scale = math.Min(scale, float64(height)/float64(srcHeight))
+		}
+		return crop, scaled(srcWidth, scale), scaled(srcHeight, scale)
+	}
+
+	// cover crops source to aspect ratio of box around the center
+	cropWidth, cropHeight := srcWidth, srcHeight
+	if srcWidth*height > srcHeight*width {
+		cropWidth = scaled(srcHeight, float64(width)/float64(height))
+	} else {
+		cropHeight = scaled(srcWidth, float64(height)/float64(width))
+	}
+	left, top := (srcWidth-cropWidth)/2, (srcHeight-cropHeight)/2
+	crop = image.Rect(left, top, left+cropWidth, top+cropHeight)
+	if cropWidth < width {
+		// cropped source is smaller than box, it is not enlarged
+		return crop, cropWidth, cropHeight
+	}
+	return crop, width, height
+}
+
+// Encode writes image in format, images with transparency are put on white background for JPEG
+func Encode(writer io.Writer, img *image.RGBA, format Format, quality int) error {
+	switch format {
+	case FormatPNG:
+		return png.Encode(writer, img)
+	case FormatJPEG:
+		if !img.Opaque() {
+			background := image.NewRGBA(img.Rect)
+			draw.Draw(background, background.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+			draw.Draw(background, background.Rect, img, img.Rect.Min, draw.Over)
+			img = background
+		}
+		return jpeg.Encode(writer, img, &jpeg.Options{Quality: quality})
+	}
+	return fmt.Errorf(`unknown image format [%s]`, format)
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, bounds.Min, draw.Src)
+	return rgba
+}
+
+// resample averages source pixels covered by each pixel of rendition, which suits downscaling
+func resample(src *image.RGBA, crop image.Rectangle, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	cropWidth, cropHeight := crop.Dx(), crop.Dy()
+	for y := 0; y < height; y++ {
+		y0, y1 := span(y, height, cropHeight)
+		for x := 0; x < width; x++ {
+			x0, x1 := span(x, width, cropWidth)
+			var sum [4]uint64
+			for sy := crop.Min.Y + y0; sy < crop.Min.Y+y1; sy++ {
+				row := src.Pix[src.PixOffset(crop.Min.X+x0, sy):src.PixOffset(crop.Min.X+x1, sy)]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += uint64(row[i])
+					sum[1] += uint64(row[i+1])
+					sum[2] += uint64(row[i+2])
+					sum[3] += uint64(row[i+3])
+				}
+			}
+			count := uint64((x1 - x0) * (y1 - y0))
+			offset := dst.PixOffset(x, y)
+			for i := range sum {
+				dst.Pix[offset+i] = uint8((sum[i] + count/2) / count)
+			}
+		}
+	}
+	return dst
+}
+
+// span returns range of source pixels covered by pixel of rendition, it covers one pixel at least
+func span(i, dstSize, srcSize int) (int, int) {
+	from := i * srcSize / dstSize
+	to := (i + 1) * srcSize / dstSize
+	if to <= from {
+		to = from + 1
+	}
+	return from, to
+}
+
+func scaled(size int, scale float64) int {
+	result := int(math.Round(float64(size) * scale))
+	if result < 1 {
+		return 1
+	}
+	return result
+}
@@ -0,0 +1,201 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// exifJPEG puts EXIF segment with orientation right after start of JPEG image
+func exifJPEG(t *testing.T, content []byte, order binary.ByteOrder, orientation uint16) []byte {
+	t.Helper()
+	tiff := &bytes.Buffer{}
+	if order == binary.LittleEndian {
+		tiff.WriteString(`II`)
+	} else {
+		tiff.WriteString(`MM`)
+	}
+	require.NoError(t, binary.Write(tiff, order, uint16(tiffMagic)))
+	require.NoError(t, binary.Write(tiff, order, uint32(tiffHeaderSize)))
+	require.NoError(t, binary.Write(tiff, order, uint16(2)))
+	// unrelated tag goes first
+	require.NoError(t, binary.Write(tiff, order, []uint16{0x010F, 2}))
+	require.NoError(t, binary.Write(tiff, order, []uint32{1, 0}))
+	require.NoError(t, binary.Write(tiff, order, []uint16{tagOrientation, typeShort}))
+	require.NoError(t, binary.Write(tiff, order, uint32(1)))
+	require.NoError(t, binary.Write(tiff, order, []uint16{orientation, 0}))
+	require.NoError(t, binary.Write(tiff, order, uint32(0)))
+
+	segment := append([]byte(exifHeader), tiff.Bytes()...)
+	result := []byte{0xFF, markerStartOfImage, 0xFF, markerApp1}
+	result = binary.BigEndian.AppendUint16(result, uint16(len(segment)+2))
+	result = append(result, segment...)
+	return append(result, content[2:]...)
+}
+
+func encodedJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	buffer := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buffer, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buffer.Bytes()
+}
+
+func TestOrientation(t *testing.T) {
+	content := encodedJPEG(t, 4, 2)
+	require.Equal(t, OrientationNormal, Orientation(content))
+	require.Equal(t, 6, Orientation(exifJPEG(t, content, binary.BigEndian, 6)))
+	require.Equal(t, 8, Orientation(exifJPEG(t, content, binary.LittleEndian, 8)))
+	require.Equal(t, OrientationNormal, Orientation(exifJPEG(t, content, binary.BigEndian, 9)))
+
+	decoded, err := jpeg.Decode(bytes.NewReader(exifJPEG(t, content, binary.BigEndian, 6)))
+	require.NoError(t, err)
+	require.Equal(t, 4, decoded.Bounds().Dx())
+
+	require.Equal(t, OrientationNormal, Orientation([]byte("\x89PNG")))
+	require.Equal(t, OrientationNormal, Orientation(content[:3]))
+}
+
+func TestOrient(t *testing.T) {
+	// pixels of 3x2 image are numbered by their red channel
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			src.Set(x, y, color.RGBA{R: uint8(y*3 + x), A: 255})
+		}
+	}
+	reds := func(img *image.RGBA) [][]uint8 {
+		rows := [][]uint8{}
+		for y := 0; y < img.Rect.Dy(); y++ {
+			row := []uint8{}
+			for x := 0; x < img.Rect.Dx(); x++ {
+				row = append(row, img.RGBAAt(x, y).R)
+			}
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	testCases := map[int][][]uint8{
+		1: {{0, 1, 2}, {3, 4, 5}},
+		2: {{2, 1, 0}, {5, 4, 3}},
+		3: {{5, 4, 3}, {2, 1, 0}},
+		4: {{3, 4, 5}, {0, 1, 2}},
+		5: {{0, 3}, {1, 4}, {2, 5}},
+		6: {{3, 0}, {4, 1}, {5, 2}},
+		7: {{5, 2}, {4, 1}, {3, 0}},
+		8: {{2, 5}, {1, 4}, {0, 3}},
+	}
+	for orientation, expected := range testCases {
+		require.Equal(t, expected, reds(Orient(src, orientation)), `orientation %d`, orientation)
+	}
+}
+
+func TestSize(t *testing.T) {
+	testCases := []struct {
+		name      string
+		srcWidth  int
+		srcHeight int
+		width     int
+		height    int
+		fit       Fit
+		crop      image.Rectangle
+		dstWidth  int
+		dstHeight int
+	}{
+		{
+			name:     "contain_by_width",
+			srcWidth: 4000, srcHeight: 3000, width: 320,
+			crop: image.Rect(0, 0, 4000, 3000), dstWidth: 320, dstHeight: 240,
+		},
+		{
+			name:     "contain_in_box",
+			srcWidth: 4000, srcHeight: 3000, width: 320, height: 320, fit: FitContain,
+			crop: image.Rect(0, 0, 4000, 3000), dstWidth: 320, dstHeight: 240,
+		},
+		{
+			name:     "cover_box",
+			srcWidth: 4000, srcHeight: 3000, width: 320, height: 320, fit: FitCover,
+			crop: image.Rect(500, 0, 3500, 3000), dstWidth: 320, dstHeight: 320,
+		},
+		{
+			name:     "cover_tall_box",
+			srcWidth: 300, srcHeight: 400, width: 100, height: 50, fit: FitCover,
+			crop: image.Rect(0, 125, 300, 275), dstWidth: 100, dstHeight: 50,
+		},
+		{
+			name:     "not_enlarged",
+			srcWidth: 200, srcHeight: 100, width: 1000, height: 1000,
+			crop: image.Rect(0, 0, 200, 100), dstWidth: 200, dstHeight: 100,
+		},
+		{
+			name:     "cover_not_enlarged",
+			srcWidth: 200, srcHeight: 100, width: 1000, height: 1000, fit: FitCover,
+			crop: image.Rect(50, 0, 150, 100), dstWidth: 100, dstHeight: 100,
+		},
+		{
+			name:     "at_least_one_pixel",
+			srcWidth: 1000, srcHeight: 1, width: 10,
+			crop: image.Rect(0, 0, 1000, 1), dstWidth: 10, dstHeight: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			crop, width, height := Size(testCase.srcWidth, testCase.srcHeight, testCase.width, testCase.height, testCase.fit)
+			require.Equal(t, testCase.crop, crop)
+			require.Equal(t, testCase.dstWidth, width)
+			require.Equal(t, testCase.dstHeight, height)
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	// left half is black, right half is white, so each pixel of rendition averages one color
+	src := image.NewRGBA(image.Rect(0, 0, 8, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 8; x++ {
+			if x < 4 {
+				src.Set(x, y, color.Black)
+			} else {
+				src.Set(x, y, color.White)
+			}
+		}
+	}
+
+	resized := Resize(src, 2, 0, FitContain)
+	require.Equal(t, image.Rect(0, 0, 2, 1), resized.Rect)
+	require.Equal(t, color.RGBA{A: 255}, resized.RGBAAt(0, 0))
+	require.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, resized.RGBAAt(1, 0))
+
+	// the middle of image is half black and half white
+	covered := Resize(src, 1, 1, FitCover)
+	require.Equal(t, color.RGBA{R: 128, G: 128, B: 128, A: 255}, covered.RGBAAt(0, 0))
+
+	require.Same(t, src, Resize(src, 100, 100, FitContain))
+}
+
+func TestEncode(t *testing.T) {
+	transparent := image.NewRGBA(image.Rect(0, 0, 2, 2))
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, Encode(buffer, transparent, FormatJPEG, 85))
+	decoded, format, err := image.Decode(buffer)
+	require.NoError(t, err)
+	require.Equal(t, `jpeg`, format)
+	r, g, b, _ := decoded.At(0, 0).RGBA()
+	require.Greater(t, r+g+b, uint32(3*0xF000), `transparent pixels are put on white background`)
+
+	buffer.Reset()
+	require.NoError(t, Encode(buffer, transparent, FormatPNG, 85))
+	_, format, err = image.Decode(buffer)
+	require.NoError(t, err)
+	require.Equal(t, `png`, format)
+
+	require.Error(t, Encode(buffer, transparent, Format(`webp`), 85))
+	require.Equal(t, `image/jpeg`, FormatJPEG.ContentType())
+}
@@ -0,0 +1,154 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"storage/internal/clients/auth"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+const directContent = `%PDF-1.4 act of acceptance`
+
+func directInitiate(t *testing.T, h *harness.Harness, filename string, size int) *storageComponents.DirectUploadResponse {
+	t.Helper()
+	response := h.Request(
+		t,
+		http.MethodPost,
+		`/api/1/direct?filename=`+filename+`&size=`+strconv.Itoa(size),
+		driverToken,
+		nil,
+	)
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.DirectUploadResponse](t, response)
+}
+
+// directPut does what browser does with presigned url: puts bytes right into object storage by key of slot
+func directPut(t *testing.T, h *harness.Harness, slot *storageComponents.DirectUploadResponse, contentType, content string) {
+	t.Helper()
+	_, err := h.Storage.UploadFromReader(
+		context.Background(),
+		strings.NewReader(content),
+		int64(len(content)),
+		contentType,
+		slot.PostFields[`key`],
+	)
+	require.NoError(t, err)
+}
+
+func TestDirectUpload(t *testing.T) {
+	h := newHarness(t)
+
+	slot := directInitiate(t, h, `act.pdf`, len(directContent))
+	require.Equal(t, `application/pdf`, slot.MimeType)
+	require.NotEmpty(t, slot.PutUrl)
+	require.NotEmpty(t, slot.PostUrl)
+	require.Equal(t, `direct/`+slot.Uid, slot.PostFields[`key`], `client uploads to staging object, not to object of file`)
+	require.Equal(t, `application/pdf`, slot.PostFields[`Content-Type`])
+
+	// pending file is not visible before completion
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+slot.Uid, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	directPut(t, h, slot, `application/pdf`, directContent)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	file := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, slot.Uid, file.Uid)
+	require.Equal(t, len(directContent), *file.Size)
+
+	// staging object is moved to file
+	require.Equal(t, []string{file.ObjectPath}, h.Storage.Objects())
+
+	// presigned url is still valid, but bytes put after completion never become content of file
+	directPut(t, h, slot, `application/pdf`, `%PDF-1.4 forged act`)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+slot.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, directContent, harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func TestDirectUploadWithoutChecksums(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`act.pdf`), driverToken, harness.Body(directContent))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	// content is never read by the service, so it is not deduplicated like completed multipart upload
+	slot := directInitiate(t, h, `copy.pdf`, len(directContent))
+	directPut(t, h, slot, `application/pdf`, directContent)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	file := decode[storageComponents.UploadResponse](t, response)
+
+	require.Nil(t, file.Sha256)
+	require.Nil(t, file.Md5)
+	require.ElementsMatch(t, []string{blobObjectPath(t, h, *uploaded.Sha256), file.ObjectPath}, h.Storage.Objects())
+}
+
+func TestDirectUploadURLExpiry(t *testing.T) {
+	h := newHarness(t)
+	h.StorageConf.Upload = &conf.Storage_Upload{UrlExpiry: durationpb.New(48 * time.Hour)}
+	h.StorageConf.Reconcile = &conf.Storage_Reconcile{PendingTimeout: durationpb.New(2 * time.Hour)}
+
+	// url does not outlive pending file
+	slot := directInitiate(t, h, `act.pdf`, len(directContent))
+	require.Equal(t, `7200`, slot.PostFields[`X-Amz-Expires`])
+}
+
+func TestDirectUploadMismatch(t *testing.T) {
+	h := newHarness(t)
+
+	slot := directInitiate(t, h, `act.pdf`, len(directContent))
+
+	directPut(t, h, slot, `application/pdf`, directContent+` with extra bytes`)
+	response := h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	require.Empty(t, h.Storage.Objects())
+
+	directPut(t, h, slot, `text/html`, directContent)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	require.Empty(t, h.Storage.Objects())
+
+	directPut(t, h, slot, `application/pdf`, directContent)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+}
+
+func TestDirectUploadForeignAndInvalid(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`other-token`, &auth.User{ID: 100, Type: `driver`})
+
+	slot := directInitiate(t, h, `act.pdf`, len(directContent))
+	directPut(t, h, slot, `application/pdf`, directContent)
+
+	response := h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, `other-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct/123e4567-e89b-12d3-a456-426614174000/complete`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct?filename=act.pdf&size=0`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct?filename=act.pdf&size=10`, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+}
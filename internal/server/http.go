@@ -81,6 +81,27 @@ func NewHTTPServer(
 				"Accept-Language",
 				"Accept-Range",
 				"Range",
+				"If-None-Match",
+				"If-Modified-Since",
+				"If-Range",
+				"Tus-Resumable",
+				"Upload-Length",
+				"Upload-Metadata",
+				"Upload-Offset",
+			},
+			ExposeHeaders: []string{
+				"Location",
+				"ETag",
+				"Last-Modified",
+				"Content-Length",
+				"Content-Range",
+				"Content-Disposition",
+				"Tus-Resumable",
+				"Tus-Version",
+				"Tus-Extension",
+				"Upload-Offset",
+				"Upload-Length",
+				"Upload-File-Uid",
 			},
 		}),
 	)
@@ -0,0 +1,480 @@
+package server_test
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // md5 is a part of Digest header
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/blob"
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+const (
+	driverToken = `driver-token`
+	driverID    = 7
+)
+
+func newHarness(t *testing.T, opts ...harness.Option) *harness.Harness {
+	h := harness.New(t, opts...)
+	h.Auth.AddUser(driverToken, &auth.User{
+		ID:          driverID,
+		Type:        `driver`,
+		DisplayName: `Test Driver`,
+	})
+	return h
+}
+
+func uploadPath(filename string) string {
+	return `/api/1/upload?filename=` + url.QueryEscape(filename)
+}
+
+func requireStatus(t *testing.T, expected int, response *http.Response) {
+	t.Helper()
+	if response.StatusCode != expected {
+		require.Failf(t, `unexpected status`, `expected %d, got %d: %s`, expected, response.StatusCode, harness.ReadBody(t, response))
+	}
+}
+
+// blobObjectPath returns path of content object of blob with the checksum
+func blobObjectPath(t *testing.T, h *harness.Harness, sha256 string) string {
+	t.Helper()
+	stored, err := h.Ent.Blob.Query().Where(blob.Sha256EQ(sha256)).Only(context.Background())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(stored.ObjectPath, `blobs/`+sha256[:2]+`/`+sha256))
+	return stored.ObjectPath
+}
+
+func decode[T any](t *testing.T, response *http.Response) *T {
+	t.Helper()
+	var result T
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	return &result
+}
+
+func TestUploadListDownload(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 waybill content`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`Накладная №1.pdf`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, `Накладная №1.pdf`, uploaded.Filename)
+	require.Equal(t, driverID, uploaded.UserId)
+	require.Equal(t, `application/pdf`, *uploaded.MimeType)
+	require.Equal(t, len(content), *uploaded.Size)
+
+	stored, ok := h.Storage.Content(blobObjectPath(t, h, *uploaded.Sha256))
+	require.True(t, ok)
+	require.Equal(t, content, string(stored))
+	require.Len(t, h.Storage.Objects(), 1)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	list := decode[storageComponents.FilesListResponse](t, response)
+	require.Len(t, list.Files, 1)
+	require.Equal(t, uploaded.Uid, list.Files[0].Uid)
+	require.Equal(t, uploaded.ObjectPath, list.Files[0].ObjectPath)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `application/pdf`, response.Header.Get(`Content-Type`))
+	require.Contains(t, response.Header.Get(`Content-Disposition`), `attachment`)
+	require.Equal(t, content, harness.ReadBody(t, response))
+}
+
+func TestUploadByIntegrations(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg bytes`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, 0, uploaded.UserId)
+
+	response = h.IntegrationsRequest(t, http.MethodGet, `/api/1/files/list`, nil)
+	requireStatus(t, http.StatusOK, response)
+	list := decode[storageComponents.FilesListResponse](t, response)
+	require.Len(t, list.Files, 1)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `inline`, response.Header.Get(`Content-Disposition`))
+	require.Equal(t, `jpeg bytes`, harness.ReadBody(t, response))
+}
+
+func TestUploadUnauthorized(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`photo.jpg`), ``, harness.Body(`jpeg bytes`))
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`photo.jpg`), `unknown-token`, harness.Body(`jpeg bytes`))
+	requireStatus(t, http.StatusUnauthorized, response)
+	require.Empty(t, h.Storage.Objects())
+}
+
+func TestUploadSameFilename(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`report.pdf`), driverToken, harness.Body(`first`))
+	requireStatus(t, http.StatusOK, response)
+	first := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, 1, *first.Version)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`report.pdf`), driverToken, harness.Body(`second`))
+	requireStatus(t, http.StatusOK, response)
+	second := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, 2, *second.Version)
+	require.NotEqual(t, first.Uid, second.Uid)
+}
+
+func TestUploadChecksums(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 signed waybill`
+	sha256Sum := sha256.Sum256([]byte(content))
+	md5Sum := md5.Sum([]byte(content)) //nolint:gosec
+
+	upload := func(filename string, headers map[string]string) *http.Response {
+		request, err := http.NewRequest(http.MethodPost, h.Server.URL+uploadPath(filename), harness.Body(content))
+		require.NoError(t, err)
+		request.Header.Set(`Authorization`, `Bearer `+driverToken)
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+		return h.Do(t, request)
+	}
+
+	response := upload(`plain.pdf`, nil)
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, hex.EncodeToString(sha256Sum[:]), *uploaded.Sha256)
+	require.Equal(t, hex.EncodeToString(md5Sum[:]), *uploaded.Md5)
+
+	response = upload(`digest.pdf`, map[string]string{
+		`Digest`: `SHA-256=` + base64.StdEncoding.EncodeToString(sha256Sum[:]) +
+			`,MD5=` + base64.StdEncoding.EncodeToString(md5Sum[:]),
+	})
+	requireStatus(t, http.StatusOK, response)
+
+	response = upload(`checksum.pdf`, map[string]string{`X-Checksum-SHA256`: hex.EncodeToString(sha256Sum[:])})
+	requireStatus(t, http.StatusOK, response)
+	// content with declared checksum is uploaded right to new blob and is dropped as the same blob exists
+	require.Equal(t, []string{blobObjectPath(t, h, hex.EncodeToString(sha256Sum[:]))}, h.Storage.Objects())
+
+	response = upload(`broken.pdf`, map[string]string{`X-Checksum-SHA256`: strings.Repeat(`0`, 64)})
+	requireStatus(t, http.StatusBadRequest, response)
+	_, stored := h.Storage.Content(`7/broken.pdf`)
+	require.False(t, stored)
+
+	response = upload(`invalid.pdf`, map[string]string{`Digest`: `SHA-256=not-base64`})
+	requireStatus(t, http.StatusBadRequest, response)
+}
+
+func TestUploadDeduplication(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`other-token`, &auth.User{ID: 100, Type: `driver`})
+	content := `jpeg bytes of the same photo`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`photo.jpg`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	first := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`photo again.jpg`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	second := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`photo.jpg`), `other-token`, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	foreign := decode[storageComponents.UploadResponse](t, response)
+
+	require.NotEqual(t, first.Uid, second.Uid)
+	// files of different users share object of their blob
+	require.Equal(t, first.ObjectPath, foreign.ObjectPath)
+	require.Equal(t, []string{blobObjectPath(t, h, *first.Sha256)}, h.Storage.Objects())
+	require.Equal(t, []string{first.ObjectPath}, h.Storage.Objects())
+
+	blob, err := h.Ent.Blob.Query().Only(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, blob.RefCount)
+	require.Equal(t, len(content), blob.Size)
+
+	for _, uploaded := range []*storageComponents.UploadResponse{first, second, foreign} {
+		response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+		requireStatus(t, http.StatusOK, response)
+		require.Equal(t, content, harness.ReadBody(t, response))
+	}
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Len(t, decode[storageComponents.FilesListResponse](t, response).Files, 2)
+}
+
+func TestDownloadNotFound(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodGet, `/api/1/download/123e4567-e89b-12d3-a456-426614174000`, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/123e4567-e89b-12d3-a456-426614174000?mode=redirect`, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func TestDownloadRedirect(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`report.pdf`), driverToken, harness.Body(`report`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid+`?mode=redirect`, ``, nil)
+	requireStatus(t, http.StatusFound, response)
+	location, err := url.Parse(response.Header.Get(`Location`))
+	require.NoError(t, err)
+	require.Equal(t, `/`+blobObjectPath(t, h, *uploaded.Sha256), location.Path)
+	require.Equal(t, `application/pdf`, location.Query().Get(`response-content-type`))
+	require.Equal(t, `attachment; filename="report.pdf"`, location.Query().Get(`response-content-disposition`))
+	require.NotEmpty(t, location.Query().Get(`X-Amz-Expires`))
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid+`?mode=proxy`, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `report`, harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid+`?mode=teleport`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+}
+
+func TestDownloadRange(t *testing.T) {
+	h := newHarness(t)
+	content := `0123456789abcdefghij`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`movie.mp4`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	downloadPath := h.Server.URL + `/api/1/download/` + uploaded.Uid
+
+	rangeRequest := func(value string) *http.Response {
+		request, err := http.NewRequest(http.MethodGet, downloadPath, nil)
+		require.NoError(t, err)
+		request.Header.Set(`Range`, value)
+		return h.Do(t, request)
+	}
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `bytes`, response.Header.Get(`Accept-Ranges`))
+
+	response = rangeRequest(`bytes=10-14`)
+	requireStatus(t, http.StatusPartialContent, response)
+	require.Equal(t, `bytes 10-14/20`, response.Header.Get(`Content-Range`))
+	require.Equal(t, `5`, response.Header.Get(`Content-Length`))
+	require.Equal(t, `video/mp4`, response.Header.Get(`Content-Type`))
+	require.Equal(t, `abcde`, harness.ReadBody(t, response))
+
+	response = rangeRequest(`bytes=-3`)
+	requireStatus(t, http.StatusPartialContent, response)
+	require.Equal(t, `hij`, harness.ReadBody(t, response))
+
+	response = rangeRequest(`bytes=0-1,18-`)
+	requireStatus(t, http.StatusPartialContent, response)
+	mediaType, params, err := mime.ParseMediaType(response.Header.Get(`Content-Type`))
+	require.NoError(t, err)
+	require.Equal(t, `multipart/byteranges`, mediaType)
+	body := harness.ReadBody(t, response)
+	require.Equal(t, response.Header.Get(`Content-Length`), strconv.Itoa(len(body)))
+	reader := multipart.NewReader(strings.NewReader(body), params[`boundary`])
+	for _, expected := range []struct{ contentRange, content string }{
+		{`bytes 0-1/20`, `01`},
+		{`bytes 18-19/20`, `ij`},
+	} {
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		require.Equal(t, expected.contentRange, part.Header.Get(`Content-Range`))
+		require.Equal(t, `video/mp4`, part.Header.Get(`Content-Type`))
+		partContent, err := io.ReadAll(part)
+		require.NoError(t, err)
+		require.Equal(t, expected.content, string(partContent))
+	}
+	_, err = reader.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+
+	response = rangeRequest(`bytes=20-30`)
+	requireStatus(t, http.StatusRequestedRangeNotSatisfiable, response)
+	require.Equal(t, `bytes */20`, response.Header.Get(`Content-Range`))
+
+	response = rangeRequest(`bytes=5-1`)
+	requireStatus(t, http.StatusRequestedRangeNotSatisfiable, response)
+}
+
+func TestDownloadConditional(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 cached waybill`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	downloadPath := h.Server.URL + `/api/1/download/` + uploaded.Uid
+
+	conditionalRequest := func(method string, headers map[string]string) *http.Response {
+		request, err := http.NewRequest(method, downloadPath, nil)
+		require.NoError(t, err)
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+		return h.Do(t, request)
+	}
+
+	response = conditionalRequest(http.MethodGet, nil)
+	requireStatus(t, http.StatusOK, response)
+	etag := response.Header.Get(`ETag`)
+	lastModified := response.Header.Get(`Last-Modified`)
+	require.Regexp(t, `^"[0-9a-f]+"$`, etag)
+	require.NotEmpty(t, lastModified)
+	require.Equal(t, `private, no-cache`, response.Header.Get(`Cache-Control`))
+	require.Equal(t, strconv.Itoa(len(content)), response.Header.Get(`Content-Length`))
+	require.Equal(t, content, harness.ReadBody(t, response))
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`If-None-Match`: `"stale", W/` + etag})
+	requireStatus(t, http.StatusNotModified, response)
+	require.Equal(t, etag, response.Header.Get(`ETag`))
+	require.Empty(t, harness.ReadBody(t, response))
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`If-None-Match`: `"stale"`})
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, content, harness.ReadBody(t, response))
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`If-Modified-Since`: lastModified})
+	requireStatus(t, http.StatusNotModified, response)
+
+	response = conditionalRequest(http.MethodGet, map[string]string{
+		`If-Modified-Since`: lastModified,
+		`If-None-Match`:     `"stale"`,
+	})
+	requireStatus(t, http.StatusOK, response)
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`If-Modified-Since`: `Mon, 01 Jan 2001 00:00:00 GMT`})
+	requireStatus(t, http.StatusOK, response)
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`Range`: `bytes=0-3`, `If-Range`: etag})
+	requireStatus(t, http.StatusPartialContent, response)
+	require.Equal(t, `%PDF`, harness.ReadBody(t, response))
+
+	response = conditionalRequest(http.MethodGet, map[string]string{`Range`: `bytes=0-3`, `If-Range`: `"stale"`})
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, content, harness.ReadBody(t, response))
+}
+
+func TestDownloadHead(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 waybill to check`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodHead, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `application/pdf`, response.Header.Get(`Content-Type`))
+	require.Equal(t, strconv.Itoa(len(content)), response.Header.Get(`Content-Length`))
+	require.NotEmpty(t, response.Header.Get(`ETag`))
+	require.NotEmpty(t, response.Header.Get(`Last-Modified`))
+	require.Empty(t, harness.ReadBody(t, response))
+
+	request, err := http.NewRequest(http.MethodHead, h.Server.URL+`/api/1/download/`+uploaded.Uid, nil)
+	require.NoError(t, err)
+	request.Header.Set(`If-None-Match`, response.Header.Get(`ETag`))
+	response = h.Do(t, request)
+	requireStatus(t, http.StatusNotModified, response)
+
+	response = h.Request(t, http.MethodHead, `/api/1/download/123e4567-e89b-12d3-a456-426614174000`, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func TestDownloadFillsObjectInfoOfOldFiles(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 waybill uploaded long ago`
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	ctx := context.Background()
+	_, err := h.Ent.File.Update().SetEtag(``).ClearLastModified().Save(ctx)
+	require.NoError(t, err)
+
+	response = h.Request(t, http.MethodHead, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.NotEmpty(t, response.Header.Get(`ETag`))
+
+	saved, err := h.Ent.File.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, response.Header.Get(`ETag`), `"`+saved.Etag+`"`)
+	require.NotNil(t, saved.LastModified)
+}
+
+func TestFileStatuses(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`admin-token`, &auth.User{ID: 1, Type: `admin`})
+
+	request, err := http.NewRequest(http.MethodPost, h.Server.URL+uploadPath(`waybill.pdf`), harness.Body(`waybill`))
+	require.NoError(t, err)
+	request.Header.Set(`Authorization`, `Bearer `+driverToken)
+	request.Header.Set(`X-Checksum-SHA256`, strings.Repeat(`0`, 64))
+	response := h.Do(t, request)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	// failed upload does not hold the filename
+	response = h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	slot := directInitiate(t, h, `act.pdf`, len(directContent))
+
+	listFiles := func(token, status string) []storageComponents.FileItemCompact {
+		response := h.Request(t, http.MethodGet, `/api/1/files/list?status=`+status, token, nil)
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.FilesListResponse](t, response).Files
+	}
+
+	files := listFiles(driverToken, ``)
+	require.Len(t, files, 1)
+	require.Equal(t, uploaded.Uid, files[0].Uid)
+	require.Equal(t, storageComponents.PropertyFileStatusActive, *files[0].Status)
+
+	files = listFiles(`admin-token`, `failed`)
+	require.Len(t, files, 1)
+	require.Equal(t, `waybill.pdf`, files[0].Filename)
+	require.NotEqual(t, uploaded.Uid, files[0].Uid)
+	require.Equal(t, storageComponents.PropertyFileStatusFailed, *files[0].Status)
+	failedUID := files[0].Uid
+
+	files = listFiles(`admin-token`, `pending`)
+	require.Len(t, files, 1)
+	require.Equal(t, slot.Uid, files[0].Uid)
+	require.NotNil(t, files[0].CreatedAt)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list?status=failed`, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list?status=lost`, `admin-token`, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	// failed file is neither downloadable nor completable
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+failedUID, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+failedUID+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// job runs task on schedule as kratos server until it is stopped, zero interval disables it
+type job struct {
+	interval time.Duration
+	run      func(ctx context.Context)
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newJob(interval time.Duration, run func(ctx context.Context)) *job {
+	return &job{
+		interval: interval,
+		run:      run,
+		stop:     make(chan struct{}),
+	}
+}
+
+func (j *job) Start(ctx context.Context) error {
+	if j.interval <= 0 {
+		return nil
+	}
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-j.stop:
+			return nil
+		case <-ticker.C:
+			j.run(ctx)
+		}
+	}
+}
+
+func (j *job) Stop(_ context.Context) error {
+	j.stopOnce.Do(func() {
+		close(j.stop)
+	})
+	return nil
+}
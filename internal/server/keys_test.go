@@ -0,0 +1,148 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func TestObjectKeyLayouts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		layout  conf.Storage_Keys_Layout
+		pattern string
+	}{
+		{
+			name:    "slug",
+			layout:  conf.Storage_Keys_slug,
+			pattern: `^7/video\.mp4$`,
+		},
+		{
+			name:    "uuid",
+			layout:  conf.Storage_Keys_uuid,
+			pattern: `^[0-9a-f-]{36}\.mp4$`,
+		},
+		{
+			name:    "date",
+			layout:  conf.Storage_Keys_date,
+			pattern: `^\d{4}/\d{2}/\d{2}/[0-9a-f-]{36}\.mp4$`,
+		},
+		{
+			name:    "hash",
+			layout:  conf.Storage_Keys_hash,
+			pattern: `^[0-9a-f]{2}/[0-9a-f]{2}/[0-9a-f-]{36}\.mp4$`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.StorageConf.Keys = &conf.Storage_Keys{Layout: testCase.layout}
+
+			file := uploadMultipart(t, h, `video.mp4`, `video content`)
+			require.Regexp(t, regexp.MustCompile(testCase.pattern), file.ObjectPath)
+
+			stored, ok := h.Storage.Content(file.ObjectPath)
+			require.True(t, ok)
+			require.Equal(t, `video content`, string(stored))
+		})
+	}
+}
+
+func TestMigrateObjectKeys(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	own := uploadMultipart(t, h, `video.mp4`, `video content`)
+	uploaded := uploadContent(t, h, `photo.jpg`, `jpeg bytes`)
+	deduplicated := uploadContent(t, h, `copy.jpg`, `jpeg bytes`)
+	// deduplicated files show key of object of their blob
+	require.Regexp(t, `^blobs/`, uploaded.ObjectPath)
+	require.Equal(t, uploaded.ObjectPath, deduplicated.ObjectPath)
+	stored, ok := h.Storage.Content(uploaded.ObjectPath)
+	require.True(t, ok)
+	require.Equal(t, `jpeg bytes`, string(stored))
+	objects := len(h.Storage.Objects())
+
+	h.StorageConf.Keys = &conf.Storage_Keys{Layout: conf.Storage_Keys_date}
+
+	report, err := h.Usecase.MigrateObjectKeys(ctx, false)
+	require.NoError(t, err)
+	require.False(t, report.Applied)
+	require.ElementsMatch(t, []string{own.Uid, uploaded.Uid, deduplicated.Uid}, report.Migrated)
+	_, ok = h.Storage.Content(own.ObjectPath)
+	require.True(t, ok)
+	_, ok = h.Storage.Content(uploaded.ObjectPath)
+	require.True(t, ok)
+
+	report, err = h.Usecase.MigrateObjectKeys(ctx, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{own.Uid, uploaded.Uid, deduplicated.Uid}, report.Migrated)
+	require.Empty(t, report.Failed)
+
+	_, ok = h.Storage.Content(own.ObjectPath)
+	require.False(t, ok)
+	_, ok = h.Storage.Content(uploaded.ObjectPath)
+	require.False(t, ok)
+	// blob shared by deduplicated files is moved once
+	require.Len(t, h.Storage.Objects(), objects)
+
+	response := h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	listed := map[string]string{}
+	for _, item := range decode[storageComponents.FilesListResponse](t, response).Files {
+		require.Regexp(t, `^\d{4}/\d{2}/\d{2}/`, item.ObjectPath)
+		_, ok = h.Storage.Content(item.ObjectPath)
+		require.True(t, ok)
+		listed[item.Uid] = item.ObjectPath
+	}
+	require.Equal(t, listed[uploaded.Uid], listed[deduplicated.Uid])
+
+	for uid, content := range map[string]string{
+		own.Uid:          `video content`,
+		uploaded.Uid:     `jpeg bytes`,
+		deduplicated.Uid: `jpeg bytes`,
+	} {
+		response = h.Request(t, http.MethodGet, `/api/1/download/`+uid, ``, nil)
+		requireStatus(t, http.StatusOK, response)
+		require.Equal(t, content, harness.ReadBody(t, response))
+	}
+
+	// keys which follow layout are not migrated again
+	report, err = h.Usecase.MigrateObjectKeys(ctx, true)
+	require.NoError(t, err)
+	require.Empty(t, report.Migrated)
+
+	// blobs of new uploads follow layout, uploaded object becomes content of blob without copy
+	fresh := uploadContent(t, h, `fresh.jpg`, `fresh bytes`)
+	require.Regexp(t, `^\d{4}/\d{2}/\d{2}/[0-9a-f-]{36}\.jpg$`, fresh.ObjectPath)
+	require.Len(t, h.Storage.Objects(), objects+1)
+}
+
+func uploadContent(t *testing.T, h *harness.Harness, filename, content string) *storageComponents.UploadResponse {
+	t.Helper()
+	response := h.Request(t, http.MethodPost, uploadPath(filename), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.UploadResponse](t, response)
+}
+
+func uploadMultipart(t *testing.T, h *harness.Harness, filename, content string) *storageComponents.UploadResponse {
+	t.Helper()
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=`+filename, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.UploadResponse](t, response)
+}
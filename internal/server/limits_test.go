@@ -0,0 +1,105 @@
+package server_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func chunkedUpload(t *testing.T, h *harness.Harness, filename, content string) *http.Response {
+	t.Helper()
+	request, err := http.NewRequest(http.MethodPost, h.Server.URL+uploadPath(filename), harness.Body(content))
+	require.NoError(t, err)
+	// unknown length makes client send body in chunks without Content-Length
+	request.ContentLength = -1
+	request.Header.Set(`Authorization`, `Bearer `+driverToken)
+	return h.Do(t, request)
+}
+
+func TestUploadMaxSize(t *testing.T) {
+	h := newHarness(t)
+	h.StorageConf.Upload = &conf.Storage_Upload{MaxSize: 16}
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill which is too large`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+	count, err := h.Ent.File.Query().Count(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, count, `file must be rejected before it is created`)
+
+	response = h.Request(t, http.MethodPost, `/api/1/direct?filename=waybill.pdf&size=17`, driverToken, nil)
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+	response = tusRequest(t, h, http.MethodPost, `/api/1/tus`, map[string]string{
+		`Upload-Length`:   strconv.Itoa(17),
+		`Upload-Metadata`: `filename ` + base64.StdEncoding.EncodeToString([]byte(`video.mp4`)),
+	}, nil)
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+
+	// integrations are limited by configured size too
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg which is too large`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+
+	// and by size of integrationsRole which may only lower it
+	h.PolicyConf.IntegrationsRole = &conf.Policy_Role{MaxSize: 8}
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg 9 b!`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg 8 b`))
+	requireStatus(t, http.StatusOK, response)
+	h.PolicyConf.IntegrationsRole = &conf.Policy_Role{MaxSize: 1024}
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg which is too large`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+}
+
+func TestUploadChunked(t *testing.T) {
+	h := newHarness(t)
+	h.StorageConf.Upload = &conf.Storage_Upload{MaxSize: 1024}
+
+	response := chunkedUpload(t, h, `waybill.pdf`, `waybill`)
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, len(`waybill`), *uploaded.Size)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill`, harness.ReadBody(t, response))
+
+	// content is streamed after its first bytes are sniffed, so limit is exceeded when file is already created
+	response = chunkedUpload(t, h, `invoice.pdf`, strings.Repeat(`invoice `, 200))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+	require.Len(t, h.Storage.Objects(), 1)
+
+	failed, err := h.Ent.File.Query().Where(file.Filename(`invoice.pdf`)).Only(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, file.StatusFailed, failed.Status)
+	require.Zero(t, failed.Size)
+}
+
+func TestUploadMaxPartSize(t *testing.T) {
+	h := newHarness(t)
+	h.StorageConf.Upload = &conf.Storage_Upload{MaxPartSize: 8}
+
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=video.mp4`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`part which is too large`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`part`))
+	requireStatus(t, http.StatusOK, response)
+
+	location := tusCreate(t, h, `video.mp4`, 16)
+	response = tusPatch(t, h, location, 0, []byte(`chunk is too big`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+}
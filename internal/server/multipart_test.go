@@ -0,0 +1,111 @@
+package server_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func partPath(uid string, partNumber int) string {
+	return `/api/1/multipart/` + uid + `/parts/` + strconv.Itoa(partNumber)
+}
+
+func TestMultipartResumeAndComplete(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=video.mp4`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+	require.Equal(t, storageComponents.PropertyMultipartStatusActive, upload.Status)
+	require.Empty(t, upload.Parts)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 2), driverToken, harness.Body(`second part`))
+	requireStatus(t, http.StatusOK, response)
+	part := decode[storageComponents.MultipartPart](t, response)
+	require.Equal(t, 2, part.PartNumber)
+	require.Equal(t, len(`second part`), part.Size)
+
+	// client reconnects and asks which parts are already stored
+	response = h.Request(t, http.MethodGet, `/api/1/multipart/`+upload.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	status := decode[storageComponents.MultipartResponse](t, response)
+	require.Len(t, status.Parts, 1)
+	require.Equal(t, 2, status.Parts[0].PartNumber)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`broken`))
+	requireStatus(t, http.StatusOK, response)
+
+	// s3 refuses parts smaller than 5 MiB except the last one, so completion names the part to upload again
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	require.Contains(t, harness.ReadBody(t, response), `part 1 of multipart upload`)
+
+	firstPart := strings.Repeat(`first part, `, (5<<20)/len(`first part, `)+1)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(firstPart))
+	requireStatus(t, http.StatusOK, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	file := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, `video.mp4`, file.Filename)
+	require.Equal(t, len(firstPart+`second part`), *file.Size)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+file.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, firstPart+`second part`, harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodGet, `/api/1/multipart/`+upload.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	status = decode[storageComponents.MultipartResponse](t, response)
+	require.Equal(t, storageComponents.PropertyMultipartStatusCompleted, status.Status)
+	require.Equal(t, file.Uid, *status.FileUid)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 3), driverToken, harness.Body(`late part`))
+	requireStatus(t, http.StatusBadRequest, response)
+}
+
+func TestMultipartAbort(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=video.mp4`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`part`))
+	requireStatus(t, http.StatusOK, response)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/multipart/`+upload.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	require.Empty(t, h.Storage.Objects())
+}
+
+func TestMultipartForeignAndInvalid(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`other-token`, &auth.User{ID: 100, Type: `driver`})
+
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=video.mp4`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/multipart/`+upload.Uid, `other-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/multipart/123e4567-e89b-12d3-a456-426614174000`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 10001), driverToken, harness.Body(`part`))
+	requireStatus(t, http.StatusBadRequest, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+}
@@ -0,0 +1,163 @@
+package server_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+const (
+	dispatcherToken = `dispatcher-token`
+	dispatcherID    = 3
+)
+
+func newPolicyHarness(t *testing.T) *harness.Harness {
+	h := newHarness(t)
+	h.Auth.AddUser(dispatcherToken, &auth.User{ID: dispatcherID, Type: `dispatcher`})
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`dispatcher`: {
+			List:           conf.Policy_Role_all,
+			DownloadOthers: true,
+			Delete:         conf.Policy_Role_all,
+			Restore:        conf.Policy_Role_own,
+		},
+		`driver`: {
+			Upload:    true,
+			List:      conf.Policy_Role_own,
+			Delete:    conf.Policy_Role_own,
+			Restore:   conf.Policy_Role_own,
+			MaxSize:   16,
+			MimeTypes: []string{`application/pdf`, `image/*`},
+		},
+	}
+	return h
+}
+
+func TestPolicyDispatcherSeesDriverFiles(t *testing.T) {
+	h := newPolicyHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`)+`&visibility=owner`, driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, dispatcherToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill`, harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list?all=true`, dispatcherToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	list := decode[storageComponents.FilesListResponse](t, response)
+	require.Len(t, list.Files, 1)
+	require.Equal(t, uploaded.Uid, list.Files[0].Uid)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/`+uploaded.Uid+`/versions`, dispatcherToken, nil)
+	requireStatus(t, http.StatusOK, response)
+
+	// driver lists own files only
+	response = h.Request(t, http.MethodGet, `/api/1/files/list?all=true`, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodGet, `/api/1/files/list?status=deleted`, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	// dispatcher deletes files of all users, but restores own files only
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, dispatcherToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, dispatcherToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+}
+
+func TestPolicyDeleteScope(t *testing.T) {
+	const otherToken = `other-driver-token`
+
+	h := newPolicyHarness(t)
+	h.Auth.AddUser(otherToken, &auth.User{ID: 8, Type: `driver`})
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, otherToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	h.PolicyConf.Roles[`driver`].Delete = conf.Policy_Role_none
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	h.PolicyConf.Roles[`driver`].Delete = conf.Policy_Role_own
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+}
+
+func TestPolicyUploadLimits(t *testing.T) {
+	h := newPolicyHarness(t)
+
+	testCases := []struct {
+		name     string
+		token    string
+		filename string
+		content  string
+		expected int
+	}{
+		{
+			name:     "allowed",
+			token:    driverToken,
+			filename: `photo.jpg`,
+			content:  `photo`,
+			expected: http.StatusOK,
+		},
+		{
+			name:     "upload is not allowed",
+			token:    dispatcherToken,
+			filename: `waybill.pdf`,
+			content:  `waybill`,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "too large",
+			token:    driverToken,
+			filename: `waybill.pdf`,
+			content:  `waybill which is too large`,
+			expected: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:     "mime type is not allowed",
+			token:    driverToken,
+			filename: `video.mp4`,
+			content:  `video`,
+			expected: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := h.Request(t, http.MethodPost, uploadPath(testCase.filename), testCase.token, harness.Body(testCase.content))
+			requireStatus(t, testCase.expected, response)
+
+			path := `/api/1/direct?filename=` + testCase.filename + `&size=` + strconv.Itoa(len(testCase.content))
+			response = h.Request(t, http.MethodPost, path, testCase.token, nil)
+			requireStatus(t, testCase.expected, response)
+		})
+	}
+
+	// parts of multipart upload are rejected as soon as they make file too large
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=waybill.pdf`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`waybill which `))
+	requireStatus(t, http.StatusOK, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 2), driverToken, harness.Body(`is too large`))
+	requireStatus(t, http.StatusRequestEntityTooLarge, response)
+
+	// integrations are not limited by policy, only by configured maximal size
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`video.mp4`), harness.Body(`video which is too large`))
+	requireStatus(t, http.StatusOK, response)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+
+	"storage/internal/biz"
+	"storage/internal/conf"
+)
+
+const (
+	purgeMetricPrefix = `server.purge`
+)
+
+// PurgeServer removes content of files which retention period in trash is over on schedule
+type PurgeServer struct {
+	*job
+	usecase *biz.StorageUsecase
+	logger  *log.Helper
+}
+
+func NewPurgeServer(c *conf.Storage, usecase *biz.StorageUsecase, logs log.Logger) *PurgeServer {
+	p := &PurgeServer{
+		usecase: usecase,
+		logger:  logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, purgeMetricPrefix),
+	}
+	p.job = newJob(c.GetPurge().GetInterval().AsDuration(), p.purge)
+	return p
+}
+
+// purge logs result, failure of one run must not stop the next ones
+func (p *PurgeServer) purge(ctx context.Context) {
+	purged, err := p.usecase.Purge(ctx)
+	if err != nil {
+		p.logger.WithContext(ctx).Errorf(`failed to purge deleted files, %d files are purged before: %v`, purged, err)
+		return
+	}
+	if purged > 0 {
+		p.logger.WithContext(ctx).Infof(`%d deleted files are purged`, purged)
+	}
+}
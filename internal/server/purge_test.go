@@ -0,0 +1,87 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+
+	upload := func(filename, content string) *storageComponents.UploadResponse {
+		response := h.Request(t, http.MethodPost, uploadPath(filename), driverToken, harness.Body(content))
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.UploadResponse](t, response)
+	}
+	remove := func(uid string, age time.Duration) {
+		response := h.Request(t, http.MethodDelete, `/api/1/files/`+uid, driverToken, nil)
+		requireStatus(t, http.StatusNoContent, response)
+		_, err := h.Ent.File.Update().
+			Where(file.UIDEQ(uuid.MustParse(uid))).
+			SetDeletedAt(time.Now().Add(-age)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	statusOf := func(uid string) file.Status {
+		found, err := h.Ent.File.Query().Where(file.UIDEQ(uuid.MustParse(uid))).Only(ctx)
+		require.NoError(t, err)
+		return found.Status
+	}
+
+	shared := upload(`waybill.pdf`, `waybill`)
+	copied := upload(`waybill-copy.pdf`, `waybill`)
+	single := upload(`invoice.pdf`, `invoice`)
+	kept := upload(`act.pdf`, `act`)
+
+	slot := directInitiate(t, h, `scan.pdf`, len(directContent))
+	directPut(t, h, slot, slot.MimeType, directContent)
+	response := h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+
+	expired := 40 * 24 * time.Hour
+	remove(shared.Uid, expired)
+	remove(single.Uid, expired)
+	remove(slot.Uid, expired)
+	remove(copied.Uid, time.Hour)
+
+	purged, err := h.Usecase.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, purged)
+
+	require.Equal(t, file.StatusPurged, statusOf(shared.Uid))
+	require.Equal(t, file.StatusPurged, statusOf(single.Uid))
+	require.Equal(t, file.StatusPurged, statusOf(slot.Uid))
+	require.Equal(t, file.StatusDeleted, statusOf(copied.Uid))
+
+	// content of copy in trash is still stored
+	require.ElementsMatch(t, []string{
+		blobObjectPath(t, h, *kept.Sha256),
+		blobObjectPath(t, h, *copied.Sha256),
+	}, h.Storage.Objects())
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+shared.Uid+`/restore`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+copied.Uid+`/restore`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill`, harness.ReadBody(t, h.Request(t, http.MethodGet, `/api/1/download/`+copied.Uid, ``, nil)))
+
+	remove(copied.Uid, expired)
+	purged, err = h.Usecase.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.Equal(t, []string{blobObjectPath(t, h, *kept.Sha256)}, h.Storage.Objects())
+
+	purged, err = h.Usecase.Purge(ctx)
+	require.NoError(t, err)
+	require.Zero(t, purged)
+}
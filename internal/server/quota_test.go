@@ -0,0 +1,119 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func usage(t *testing.T, h *harness.Harness, token string) *storageComponents.UsageResponse {
+	var response *http.Response
+	if token == `` {
+		response = h.IntegrationsRequest(t, http.MethodGet, `/api/1/usage`, nil)
+	} else {
+		response = h.Request(t, http.MethodGet, `/api/1/usage`, token, nil)
+	}
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.UsageResponse](t, response)
+}
+
+func TestQuotaFiles(t *testing.T) {
+	h := newHarness(t)
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {Upload: true, List: conf.Policy_Role_own, Delete: conf.Policy_Role_own, Quota: &conf.Policy_Quota{Files: 2}},
+	}
+
+	response := h.Request(t, http.MethodPost, uploadPath(`first.pdf`), driverToken, harness.Body(`first`))
+	requireStatus(t, http.StatusOK, response)
+	response = h.Request(t, http.MethodPost, uploadPath(`second.pdf`), driverToken, harness.Body(`second`))
+	requireStatus(t, http.StatusOK, response)
+	second := decode[storageComponents.UploadResponse](t, response)
+
+	used := usage(t, h, driverToken)
+	require.Equal(t, int64(len(`first`)+len(`second`)), used.Bytes)
+	require.Equal(t, 2, used.Files)
+	require.Equal(t, int64(2), *used.QuotaFiles)
+	require.Nil(t, used.QuotaBytes)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`third.pdf`), driverToken, harness.Body(`third`))
+	requireStatus(t, http.StatusInsufficientStorage, response)
+	response = h.Request(t, http.MethodPost, `/api/1/multipart?filename=third.pdf`, driverToken, nil)
+	requireStatus(t, http.StatusInsufficientStorage, response)
+	require.Len(t, h.Storage.Objects(), 2)
+
+	// files in trash are still counted
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+second.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodPost, uploadPath(`third.pdf`), driverToken, harness.Body(`third`))
+	requireStatus(t, http.StatusInsufficientStorage, response)
+}
+
+func TestQuotaBytes(t *testing.T) {
+	h := newHarness(t)
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {Upload: true, Quota: &conf.Policy_Quota{Bytes: 16}},
+	}
+
+	response := h.Request(t, http.MethodPost, uploadPath(`first.pdf`), driverToken, harness.Body(`0123456789`))
+	requireStatus(t, http.StatusOK, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`second.pdf`), driverToken, harness.Body(`0123456789`))
+	requireStatus(t, http.StatusInsufficientStorage, response)
+
+	// size of chunked content is checked after upload, so pending file is removed
+	request, err := http.NewRequest(http.MethodPost, h.Server.URL+uploadPath(`second.pdf`), harness.Body(`0123456789`))
+	require.NoError(t, err)
+	request.Header.Set(`Authorization`, `Bearer `+driverToken)
+	request.ContentLength = -1
+	response = h.Do(t, request)
+	requireStatus(t, http.StatusInsufficientStorage, response)
+	require.Len(t, h.Storage.Objects(), 1)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`second.pdf`), driverToken, harness.Body(`012345`))
+	requireStatus(t, http.StatusOK, response)
+
+	used := usage(t, h, driverToken)
+	require.Equal(t, int64(16), used.Bytes)
+	require.Equal(t, int64(16), *used.QuotaBytes)
+}
+
+func TestQuotaOverrides(t *testing.T) {
+	const otherToken = `other-driver-token`
+
+	h := newHarness(t)
+	h.Auth.AddUser(otherToken, &auth.User{ID: 8, Type: `driver`})
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {Upload: true, Quota: &conf.Policy_Quota{Files: 1}},
+	}
+	h.PolicyConf.Users = map[int64]*conf.Policy_Quota{
+		8: {Files: 2},
+	}
+	h.PolicyConf.Integrations = &conf.Policy_Quota{Bytes: 8}
+
+	for _, filename := range []string{`first.pdf`, `second.pdf`} {
+		response := h.Request(t, http.MethodPost, uploadPath(filename), otherToken, harness.Body(`waybill`))
+		requireStatus(t, http.StatusOK, response)
+	}
+	response := h.Request(t, http.MethodPost, uploadPath(`first.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	response = h.Request(t, http.MethodPost, uploadPath(`second.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusInsufficientStorage, response)
+	require.Equal(t, int64(2), *usage(t, h, otherToken).QuotaFiles)
+
+	// files of users are not counted in usage of integrations
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg`))
+	requireStatus(t, http.StatusOK, response)
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.jpg`), harness.Body(`jpeg bytes`))
+	requireStatus(t, http.StatusInsufficientStorage, response)
+
+	used := usage(t, h, ``)
+	require.Equal(t, int64(4), used.Bytes)
+	require.Equal(t, 1, used.Files)
+	require.Equal(t, int64(8), *used.QuotaBytes)
+}
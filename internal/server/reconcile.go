@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+
+	"storage/internal/biz"
+	"storage/internal/conf"
+)
+
+const (
+	reconcileMetricPrefix = `server.reconcile`
+)
+
+// ReconcileServer runs reconciliation of files with s3 storage on schedule
+type ReconcileServer struct {
+	*job
+	usecase *biz.StorageUsecase
+	apply   bool
+	logger  *log.Helper
+}
+
+func NewReconcileServer(c *conf.Storage, usecase *biz.StorageUsecase, logs log.Logger) *ReconcileServer {
+	r := &ReconcileServer{
+		usecase: usecase,
+		apply:   c.GetReconcile().GetApply(),
+		logger:  logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, reconcileMetricPrefix),
+	}
+	r.job = newJob(c.GetReconcile().GetInterval().AsDuration(), r.reconcile)
+	return r
+}
+
+// reconcile logs the report, failure of one run must not stop the next ones
+func (r *ReconcileServer) reconcile(ctx context.Context) {
+	report, err := r.usecase.Reconcile(ctx, r.apply)
+	if err != nil {
+		r.logger.WithContext(ctx).Errorf(`failed to reconcile storage: %v`, err)
+		return
+	}
+	r.logger.WithContext(ctx).Infof(
+		`storage is reconciled (applied: %t): failed uploads %v, orphaned objects %v, removed objects %v, missing objects %v`,
+		report.Applied,
+		report.FailedUploads,
+		report.OrphanedObjects,
+		report.RemovedObjects,
+		report.MissingObjects,
+	)
+}
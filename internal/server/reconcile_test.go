@@ -0,0 +1,138 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+// reconcile starts reconciliation in background and waits for its report
+func reconcile(t *testing.T, h *harness.Harness, apply bool) *storageComponents.ReconcileResponse {
+	t.Helper()
+	path := `/api/1/admin/reconcile`
+	if apply {
+		path += `?apply=true`
+	}
+	response := h.Request(t, http.MethodPost, path, `admin-token`, nil)
+	requireStatus(t, http.StatusAccepted, response)
+	require.True(t, decode[storageComponents.ReconcileStatusResponse](t, response).Running)
+
+	var status *storageComponents.ReconcileStatusResponse
+	require.Eventually(t, func() bool {
+		response = h.Request(t, http.MethodGet, `/api/1/admin/reconcile`, `admin-token`, nil)
+		requireStatus(t, http.StatusOK, response)
+		status = decode[storageComponents.ReconcileStatusResponse](t, response)
+		return !status.Running
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, status.Error)
+	require.NotNil(t, status.FinishedAt)
+	require.NotNil(t, status.Report)
+	return status.Report
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+	h.Auth.AddUser(`admin-token`, &auth.User{ID: 1, Type: `admin`})
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	young := directInitiate(t, h, `act.pdf`, len(directContent))
+
+	// uploads paused longer than grace period keep their received bytes
+	directPut(t, h, young, young.MimeType, directContent)
+	h.Storage.Age(`direct/`+young.Uid, 48*time.Hour)
+	location := tusCreate(t, h, `video.mp4`, 1000)
+	requireStatus(t, http.StatusNoContent, tusPatch(t, h, location, 0, make([]byte, 100)))
+	tusPending := `tus/` + strings.TrimPrefix(location, `/api/1/tus/`) + `/00001`
+	h.Storage.Age(tusPending, 48*time.Hour)
+
+	// crash between creation of file and upload of its object
+	stuck, err := h.Ent.File.Create().
+		SetUserID(driverID).
+		SetFilename(`stuck.pdf`).
+		SetObjectPath(`7/stuck.pdf`).
+		SetSize(5).
+		SetMimeType(`application/pdf`).
+		SetStatus(file.StatusPending).
+		SetCreatedAt(time.Now().Add(-48 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	lost, err := h.Ent.File.Create().
+		SetUserID(driverID).
+		SetFilename(`lost.pdf`).
+		SetObjectPath(`7/lost.pdf`).
+		SetSize(4).
+		SetMimeType(`application/pdf`).
+		SetStatus(file.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// crash after upload of object, young objects may belong to uploads in progress
+	for _, objectPath := range []string{`7/orphan.pdf`, `7/fresh.pdf`} {
+		_, err = h.Storage.UploadFromReader(ctx, strings.NewReader(`orphan`), 6, `application/pdf`, objectPath)
+		require.NoError(t, err)
+	}
+	h.Storage.Age(`7/orphan.pdf`, 48*time.Hour)
+
+	response = h.Request(t, http.MethodPost, `/api/1/admin/reconcile`, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodGet, `/api/1/admin/reconcile`, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/admin/reconcile`, `admin-token`, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, &storageComponents.ReconcileStatusResponse{}, decode[storageComponents.ReconcileStatusResponse](t, response))
+
+	report := reconcile(t, h, false)
+	require.Equal(t, &storageComponents.ReconcileResponse{
+		Applied:         false,
+		FailedUploads:   []string{stuck.UID.String()},
+		OrphanedObjects: []string{`7/orphan.pdf`},
+		RemovedObjects:  []string{},
+		MissingObjects:  []string{lost.UID.String()},
+	}, report)
+
+	// dry run changes nothing
+	_, stored := h.Storage.Content(`7/orphan.pdf`)
+	require.True(t, stored)
+	stuck, err = h.Ent.File.Get(ctx, stuck.ID)
+	require.NoError(t, err)
+	require.Equal(t, file.StatusPending, stuck.Status)
+
+	report = reconcile(t, h, true)
+	require.True(t, report.Applied)
+	require.Equal(t, []string{stuck.UID.String()}, report.FailedUploads)
+	require.Equal(t, []string{`7/orphan.pdf`}, report.RemovedObjects)
+	require.Equal(t, []string{lost.UID.String()}, report.MissingObjects)
+
+	_, stored = h.Storage.Content(`7/orphan.pdf`)
+	require.False(t, stored)
+	_, stored = h.Storage.Content(`7/fresh.pdf`)
+	require.True(t, stored)
+	stuck, err = h.Ent.File.Get(ctx, stuck.ID)
+	require.NoError(t, err)
+	require.Equal(t, file.StatusFailed, stuck.Status)
+	_, stored = h.Storage.Content(tusPending)
+	require.True(t, stored)
+	_, stored = h.Storage.Content(`direct/` + young.Uid)
+	require.True(t, stored)
+
+	// young pending upload can still be completed with the kept content
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+young.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+
+	report = reconcile(t, h, true)
+	require.Empty(t, report.FailedUploads)
+	require.Empty(t, report.RemovedObjects)
+}
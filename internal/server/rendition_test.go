@@ -0,0 +1,156 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+// exifRotated is EXIF segment of photo which must be rotated by 90 clockwise to be shown
+const exifRotated = "\xFF\xE1\x00\x22Exif\x00\x00MM\x00\x2A\x00\x00\x00\x08\x00\x01" +
+	"\x01\x12\x00\x03\x00\x00\x00\x01\x00\x06\x00\x00\x00\x00\x00\x00"
+
+func photoContent(t *testing.T, width, height int) string {
+	t.Helper()
+	buffer := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buffer, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buffer.String()
+}
+
+func uploadPhoto(t *testing.T, h *harness.Harness, filename, content string) *storageComponents.UploadResponse {
+	t.Helper()
+	response := h.Request(t, http.MethodPost, uploadPath(filename), driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.UploadResponse](t, response)
+}
+
+func requireRendition(t *testing.T, response *http.Response, format string, width, height int) {
+	t.Helper()
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `image/`+format, response.Header.Get(`Content-Type`))
+	config, decodedFormat, err := image.DecodeConfig(strings.NewReader(harness.ReadBody(t, response)))
+	require.NoError(t, err)
+	require.Equal(t, format, decodedFormat)
+	require.Equal(t, width, config.Width)
+	require.Equal(t, height, config.Height)
+}
+
+func TestRenditions(t *testing.T) {
+	h := newHarness(t)
+	photo := uploadPhoto(t, h, `photo.jpg`, photoContent(t, 400, 300))
+	path := `/api/1/download/` + photo.Uid
+
+	response := h.Request(t, http.MethodGet, path+`?w=200`, ``, nil)
+	etag := response.Header.Get(`ETag`)
+	requireRendition(t, response, `jpeg`, 200, 150)
+	cached := `renditions/` + photo.Uid + `/200x0-contain.jpeg`
+	_, ok := h.Storage.Content(cached)
+	require.True(t, ok)
+
+	// rendition is made once and served from cache after that
+	marker := photoContent(t, 7, 7)
+	_, err := h.Storage.UploadFromReader(context.Background(), strings.NewReader(marker), int64(len(marker)), `image/jpeg`, cached)
+	require.NoError(t, err)
+	response = h.Request(t, http.MethodGet, path+`?w=200`, ``, nil)
+	requireRendition(t, response, `jpeg`, 7, 7)
+	require.Equal(t, etag, response.Header.Get(`ETag`))
+
+	request, err := http.NewRequest(http.MethodGet, h.Server.URL+path+`?w=200`, nil)
+	require.NoError(t, err)
+	request.Header.Set(`If-None-Match`, etag)
+	requireStatus(t, http.StatusNotModified, h.Do(t, request))
+
+	response = h.Request(t, http.MethodGet, path+`?w=100&h=100&fit=cover&format=png`, ``, nil)
+	requireRendition(t, response, `png`, 100, 100)
+	require.NotEqual(t, etag, response.Header.Get(`ETag`))
+
+	// images are never enlarged
+	response = h.Request(t, http.MethodGet, path+`?w=1000&h=1000`, ``, nil)
+	requireRendition(t, response, `jpeg`, 400, 300)
+
+	content := photoContent(t, 400, 300)
+	rotated := uploadPhoto(t, h, `rotated.jpg`, content[:2]+exifRotated+content[2:])
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+rotated.Uid+`?w=150`, ``, nil)
+	requireRendition(t, response, `jpeg`, 150, 200)
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buffer, image.NewRGBA(image.Rect(0, 0, 64, 32))))
+	logo := uploadPhoto(t, h, `logo.png`, buffer.String())
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+logo.Uid+`?h=16`, ``, nil)
+	requireRendition(t, response, `png`, 32, 16)
+}
+
+func TestRenditionsLimits(t *testing.T) {
+	h := newHarness(t)
+	photo := uploadPhoto(t, h, `photo.jpg`, photoContent(t, 400, 300))
+	path := `/api/1/download/` + photo.Uid
+	waybill := uploadPhoto(t, h, `waybill.pdf`, pdfContent)
+
+	for _, query := range []string{`?w=5000`, `?w=200&fit=stretch`, `?w=200&format=webp`} {
+		response := h.Request(t, http.MethodGet, path+query, ``, nil)
+		requireStatus(t, http.StatusBadRequest, response)
+	}
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+waybill.Uid+`?w=200`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	h.StorageConf.Renditions = &conf.Storage_Renditions{Sizes: []int32{100, 320}}
+	response = h.Request(t, http.MethodGet, path+`?w=200`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	response = h.Request(t, http.MethodGet, path+`?w=100`, ``, nil)
+	requireRendition(t, response, `jpeg`, 100, 75)
+
+	h.StorageConf.Renditions = &conf.Storage_Renditions{MaxSourcePixels: 1000}
+	response = h.Request(t, http.MethodGet, path+`?w=320`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	h.StorageConf.Renditions = &conf.Storage_Renditions{MaxSourceSize: 16}
+	response = h.Request(t, http.MethodGet, path+`?w=320`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	// cached renditions are not served when limits forbid them
+	h.StorageConf.Renditions = &conf.Storage_Renditions{Sizes: []int32{320}}
+	response = h.Request(t, http.MethodGet, path+`?w=100`, ``, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+}
+
+func TestRenditionsPurge(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness(t)
+	photo := uploadPhoto(t, h, `photo.jpg`, photoContent(t, 400, 300))
+
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+photo.Uid+`?w=200`, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Len(t, h.Storage.Objects(), 2)
+
+	// renditions are not reported as orphaned objects
+	h.Storage.Age(`renditions/`+photo.Uid+`/200x0-contain.jpeg`, 48*time.Hour)
+	report, err := h.Usecase.Reconcile(ctx, true)
+	require.NoError(t, err)
+	require.Empty(t, report.OrphanedObjects)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+photo.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	_, err = h.Ent.File.Update().
+		Where(file.UIDEQ(uuid.MustParse(photo.Uid))).
+		SetDeletedAt(time.Now().Add(-40 * 24 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	purged, err := h.Usecase.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.Empty(t, h.Storage.Objects())
+}
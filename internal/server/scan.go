@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/phlx-ru/hatchet/logger"
+
+	"storage/internal/biz"
+	"storage/internal/conf"
+)
+
+const (
+	scanMetricPrefix = `server.scan`
+)
+
+// ScanServer scans again quarantined files which scans are failed on upload on schedule
+type ScanServer struct {
+	*job
+	usecase *biz.StorageUsecase
+	logger  *log.Helper
+}
+
+func NewScanServer(c *conf.Storage, usecase *biz.StorageUsecase, logs log.Logger) *ScanServer {
+	s := &ScanServer{
+		usecase: usecase,
+		logger:  logger.NewHelper(logs, `ts`, log.DefaultTimestamp, `scope`, scanMetricPrefix),
+	}
+	s.job = newJob(c.GetScan().GetInterval().AsDuration(), s.scan)
+	return s
+}
+
+// scan logs result, failure of one run must not stop the next ones
+func (s *ScanServer) scan(ctx context.Context) {
+	scanned, err := s.usecase.ScanQuarantined(ctx)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf(`failed to scan quarantined files, %d files are scanned before: %v`, scanned, err)
+		return
+	}
+	if scanned > 0 {
+		s.logger.WithContext(ctx).Infof(`%d quarantined files are scanned`, scanned)
+	}
+}
@@ -0,0 +1,103 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func requireScanStatus(t *testing.T, expected storageComponents.PropertyScanStatus, uploaded *storageComponents.UploadResponse) {
+	t.Helper()
+	require.NotNil(t, uploaded.ScanStatus)
+	require.Equal(t, expected, *uploaded.ScanStatus)
+}
+
+func TestScanUploadedFiles(t *testing.T) {
+	fake := harness.NewClamd(t)
+	h := newHarness(t, harness.WithClamd(fake))
+
+	uploaded := uploadShared(t, h, `waybill.pdf`, pdfContent)
+	requireScanStatus(t, storageComponents.Clean, uploaded)
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, pdfContent, harness.ReadBody(t, response))
+
+	// infected file is kept with its verdict, but it is never served
+	infected := uploadShared(t, h, `eicar.txt`, harness.EICAR)
+	requireScanStatus(t, storageComponents.Infected, infected)
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+infected.Uid, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodHead, `/api/1/download/`+infected.Uid, driverToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	link := createShareLink(t, h, infected.Uid, `{}`)
+	response = h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	found, err := h.Ent.File.Query().Where(file.Filename(`eicar.txt`)).Only(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, file.ScanStatusInfected, found.ScanStatus)
+	require.Equal(t, harness.EICARSignature, found.ScanResult)
+	require.NotNil(t, found.ScannedAt)
+
+	// content stored by multipart and direct uploads is scanned on completion
+	completed := uploadMultipart(t, h, `eicar.txt`, harness.EICAR)
+	requireScanStatus(t, storageComponents.Infected, completed)
+
+	slot := directInitiate(t, h, `waybill.pdf`, len(pdfContent))
+	directPut(t, h, slot, `application/pdf`, pdfContent)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	requireScanStatus(t, storageComponents.Clean, decode[storageComponents.UploadResponse](t, response))
+
+	require.Equal(t, 4, fake.Scans())
+}
+
+func TestScanQuarantine(t *testing.T) {
+	fake := harness.NewClamd(t)
+	h := newHarness(t, harness.WithClamd(fake))
+	fake.SetFailing(true)
+
+	// failed scan does not fail upload, file waits in quarantine for the next scan
+	uploaded := uploadShared(t, h, `waybill.pdf`, pdfContent)
+	requireScanStatus(t, storageComponents.Quarantined, uploaded)
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusLocked, response)
+	response = h.Request(t, http.MethodHead, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusLocked, response)
+	link := createShareLink(t, h, uploaded.Uid, `{}`)
+	response = h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusLocked, response)
+
+	ctx := context.Background()
+	scanned, err := h.Usecase.ScanQuarantined(ctx)
+	require.NoError(t, err)
+	require.Zero(t, scanned)
+
+	fake.SetFailing(false)
+	scanned, err = h.Usecase.ScanQuarantined(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, scanned)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, pdfContent, harness.ReadBody(t, response))
+
+	scanned, err = h.Usecase.ScanQuarantined(ctx)
+	require.NoError(t, err)
+	require.Zero(t, scanned)
+}
+
+func TestScanDisabled(t *testing.T) {
+	h := newHarness(t)
+
+	uploaded := uploadShared(t, h, `eicar.txt`, harness.EICAR)
+	requireScanStatus(t, storageComponents.Skipped, uploaded)
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+}
@@ -0,0 +1,187 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/sharelink"
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func uploadShared(t *testing.T, h *harness.Harness, filename, content string) *storageComponents.UploadResponse {
+	response := h.Request(t, http.MethodPost, uploadPath(filename)+`&visibility=shared`, driverToken, harness.Body(content))
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.UploadResponse](t, response)
+}
+
+func createShareLink(t *testing.T, h *harness.Harness, uid, limits string) *storageComponents.ShareLinkResponse {
+	response := h.Request(t, http.MethodPost, `/api/1/files/`+uid+`/shares`, driverToken, harness.Body(limits))
+	requireStatus(t, http.StatusOK, response)
+	return decode[storageComponents.ShareLinkResponse](t, response)
+}
+
+func shareRequest(t *testing.T, h *harness.Harness, path string, headers map[string]string) *http.Response {
+	request, err := http.NewRequest(http.MethodGet, h.Server.URL+path, nil)
+	require.NoError(t, err)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	return h.Do(t, request)
+}
+
+func shareDownloads(t *testing.T, h *harness.Harness, uid string) int {
+	response := h.Request(t, http.MethodGet, `/api/1/files/`+uid+`/shares`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	links := decode[storageComponents.ShareLinksResponse](t, response)
+	require.Len(t, links.Links, 1)
+	return links.Links[0].Downloads
+}
+
+func TestShareLinkDownload(t *testing.T) {
+	h := newHarness(t)
+	content := `%PDF-1.4 waybill for consignee`
+	uploaded := uploadShared(t, h, `waybill.pdf`, content)
+
+	// file is not available to anonymous users without link
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	link := createShareLink(t, h, uploaded.Uid, `{"maxDownloads":2}`)
+	require.Equal(t, uploaded.Uid, link.FileUid)
+	require.Equal(t, `/s/`+link.Token, link.Url)
+	require.False(t, link.HasPassword)
+	require.Equal(t, 2, *link.MaxDownloads)
+
+	response = h.Request(t, http.MethodHead, link.Url, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+
+	response = h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `application/pdf`, response.Header.Get(`Content-Type`))
+	require.Equal(t, content, harness.ReadBody(t, response))
+
+	response = shareRequest(t, h, link.Url, map[string]string{`Range`: `bytes=0-7`})
+	requireStatus(t, http.StatusPartialContent, response)
+	require.Equal(t, content[:8], harness.ReadBody(t, response))
+
+	response = shareRequest(t, h, link.Url, map[string]string{`If-None-Match`: response.Header.Get(`ETag`)})
+	requireStatus(t, http.StatusNotModified, response)
+
+	// HEAD, partial and conditional requests are not counted
+	require.Equal(t, 1, shareDownloads(t, h, uploaded.Uid))
+
+	response = h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, content, harness.ReadBody(t, response))
+	require.Equal(t, 2, shareDownloads(t, h, uploaded.Uid))
+
+	response = h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusGone, response)
+
+	response = h.Request(t, http.MethodGet, `/s/unknown`, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func TestShareLinkPassword(t *testing.T) {
+	h := newHarness(t)
+	uploaded := uploadShared(t, h, `waybill.pdf`, `waybill`)
+	link := createShareLink(t, h, uploaded.Uid, `{"password":"consignee secret"}`)
+	require.True(t, link.HasPassword)
+
+	response := h.Request(t, http.MethodGet, link.Url, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	// password is not accepted in url, where it is left in access logs and browser history
+	response = h.Request(t, http.MethodGet, link.Url+`?password=`+url.QueryEscape(`consignee secret`), ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	response = shareRequest(t, h, link.Url, map[string]string{`X-Share-Password`: `wrong`})
+	requireStatus(t, http.StatusForbidden, response)
+	require.Equal(t, 0, shareDownloads(t, h, uploaded.Uid))
+
+	response = shareRequest(t, h, link.Url, map[string]string{`X-Share-Password`: `consignee secret`})
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill`, harness.ReadBody(t, response))
+	require.Equal(t, 1, shareDownloads(t, h, uploaded.Uid))
+}
+
+func TestShareLinkFailedDownload(t *testing.T) {
+	h := newHarness(t)
+	uploaded := uploadShared(t, h, `waybill.pdf`, `waybill`)
+	link := createShareLink(t, h, uploaded.Uid, `{"maxDownloads":1}`)
+
+	// content is lost in storage, so nothing is downloaded and the only download stays available
+	require.NoError(t, h.Storage.Remove(context.Background(), blobObjectPath(t, h, *uploaded.Sha256)))
+	response := h.Request(t, http.MethodGet, link.Url, ``, nil)
+	require.NotEqual(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 0, shareDownloads(t, h, uploaded.Uid))
+}
+
+func TestShareLinkAvailability(t *testing.T) {
+	h := newHarness(t)
+	uploaded := uploadShared(t, h, `waybill.pdf`, `waybill`)
+
+	response := h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/shares`, driverToken,
+		harness.Body(`{"expiresAt":"2000-01-01T00:00:00Z"}`))
+	requireStatus(t, http.StatusBadRequest, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/shares`, driverToken,
+		harness.Body(`{"maxDownloads":0}`))
+	requireStatus(t, http.StatusBadRequest, response)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	expiring := createShareLink(t, h, uploaded.Uid, `{"expiresAt":"`+expiresAt+`"}`)
+	response = h.Request(t, http.MethodGet, expiring.Url, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+
+	err := h.Ent.ShareLink.Update().
+		Where(sharelink.UID(uuid.MustParse(expiring.Uid))).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Exec(context.Background())
+	require.NoError(t, err)
+	response = h.Request(t, http.MethodGet, expiring.Url, ``, nil)
+	requireStatus(t, http.StatusGone, response)
+
+	revoked := createShareLink(t, h, uploaded.Uid, `{}`)
+	response = h.Request(t, http.MethodDelete, `/api/1/shares/`+revoked.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodDelete, `/api/1/shares/`+revoked.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodGet, revoked.Url, ``, nil)
+	requireStatus(t, http.StatusGone, response)
+
+	deleted := createShareLink(t, h, uploaded.Uid, `{}`)
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodGet, deleted.Url, ``, nil)
+	requireStatus(t, http.StatusGone, response)
+}
+
+func TestShareLinkPermissions(t *testing.T) {
+	const otherToken = `other-driver-token`
+
+	h := newHarness(t)
+	h.Auth.AddUser(otherToken, &auth.User{ID: 8, Type: `driver`})
+	uploaded := uploadShared(t, h, `waybill.pdf`, `waybill`)
+	link := createShareLink(t, h, uploaded.Uid, `{}`)
+
+	response := h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/shares`, otherToken, harness.Body(`{}`))
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodGet, `/api/1/files/`+uploaded.Uid+`/shares`, otherToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodDelete, `/api/1/shares/`+link.Uid, otherToken, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`private.pdf`)+`&visibility=owner`, driverToken, harness.Body(`private`))
+	requireStatus(t, http.StatusOK, response)
+	private := decode[storageComponents.UploadResponse](t, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+private.Uid+`/shares`, driverToken, harness.Body(`{}`))
+	requireStatus(t, http.StatusForbidden, response)
+}
@@ -0,0 +1,182 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/ent/file"
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+const (
+	pdfContent  = `%PDF-1.4 waybill for consignee`
+	htmlContent = `<!DOCTYPE html><html><script>alert(document.cookie)</script></html>`
+	pngContent  = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+)
+
+func TestUploadDetectsContentType(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(pdfContent))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, `application/pdf`, *uploaded.MimeType)
+	require.Equal(t, `application/pdf`, *uploaded.DetectedMimeType)
+
+	// text of unknown format matches any declared type
+	response = h.Request(t, http.MethodPost, uploadPath(`notes.pdf`), driverToken, harness.Body(`plain notes`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded = decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, `text/plain`, *uploaded.DetectedMimeType)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	list := decode[storageComponents.FilesListResponse](t, response)
+	require.Len(t, list.Files, 2)
+	require.NotNil(t, list.Files[0].DetectedMimeType)
+}
+
+func TestUploadRejectsMismatchingContent(t *testing.T) {
+	h := newHarness(t)
+
+	testCases := []struct {
+		name     string
+		filename string
+		content  string
+	}{
+		{
+			name:     "html in pdf",
+			filename: `evil.pdf`,
+			content:  htmlContent,
+		},
+		{
+			name:     "html in file of unknown type",
+			filename: `evil.bin`,
+			content:  htmlContent,
+		},
+		{
+			name:     "png in pdf",
+			filename: `photo.pdf`,
+			content:  pngContent,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := h.Request(t, http.MethodPost, uploadPath(testCase.filename), driverToken, harness.Body(testCase.content))
+			requireStatus(t, http.StatusBadRequest, response)
+		})
+	}
+
+	count, err := h.Ent.File.Query().Count(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, count, `files must be rejected before they are created`)
+	require.Empty(t, h.Storage.Objects())
+
+	// the first part of multipart upload is sniffed as it is streamed
+	response := h.Request(t, http.MethodPost, `/api/1/multipart?filename=evil.pdf`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(htmlContent))
+	requireStatus(t, http.StatusBadRequest, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(pdfContent))
+	requireStatus(t, http.StatusOK, response)
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	completed := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, `application/pdf`, *completed.DetectedMimeType)
+
+	// content of direct upload is sniffed on completion
+	slot := directInitiate(t, h, `act.pdf`, len(htmlContent))
+	directPut(t, h, slot, `application/pdf`, htmlContent)
+	response = h.Request(t, http.MethodPost, `/api/1/direct/`+slot.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+	require.NotContains(t, h.Storage.Objects(), slot.ObjectPath)
+}
+
+func TestUploadTypeLists(t *testing.T) {
+	h := newHarness(t)
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {
+			Upload:           true,
+			Extensions:       []string{`pdf`, `.jpg`, `bin`},
+			DeniedMimeTypes:  []string{`image/png`},
+			DeniedExtensions: []string{`bin`},
+		},
+	}
+	h.PolicyConf.IntegrationsRole = &conf.Policy_Role{
+		MimeTypes:        []string{`image/*`},
+		DeniedExtensions: []string{`gif`},
+	}
+
+	testCases := []struct {
+		name     string
+		filename string
+		content  string
+		expected int
+	}{
+		{
+			name:     "allowed extension",
+			filename: `waybill.PDF`,
+			content:  pdfContent,
+			expected: http.StatusOK,
+		},
+		{
+			name:     "extension is not allowed",
+			filename: `waybill.txt`,
+			content:  `waybill`,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "denied extension wins over allowed one",
+			filename: `waybill.bin`,
+			content:  `waybill`,
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "file without extension",
+			filename: `waybill`,
+			content:  `waybill`,
+			expected: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := h.Request(t, http.MethodPost, uploadPath(testCase.filename), driverToken, harness.Body(testCase.content))
+			requireStatus(t, testCase.expected, response)
+		})
+	}
+
+	// integrations are limited by their own role
+	response := h.IntegrationsRequest(t, http.MethodPost, uploadPath(`photo.png`), harness.Body(pngContent))
+	requireStatus(t, http.StatusOK, response)
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`waybill.pdf`), harness.Body(pdfContent))
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.IntegrationsRequest(t, http.MethodPost, uploadPath(`animation.gif`), harness.Body(`GIF89a`))
+	requireStatus(t, http.StatusForbidden, response)
+
+	count, err := h.Ent.File.Query().Where(file.MimeType(`image/png`)).Count(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestUploadDeniedDetectedType(t *testing.T) {
+	h := newHarness(t)
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {Upload: true, DeniedMimeTypes: []string{`image/png`}},
+	}
+
+	// denied type is checked by content too, not only by declared type
+	response := h.Request(t, http.MethodPost, uploadPath(`photo`), driverToken, harness.Body(pngContent))
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodPost, uploadPath(`photo.png`), driverToken, harness.Body(pngContent))
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodPost, uploadPath(`photo.jpg`), driverToken, harness.Body("\xff\xd8\xff\xe0 jpeg"))
+	requireStatus(t, http.StatusOK, response)
+}
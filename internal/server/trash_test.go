@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func TestDeleteRestoreFile(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`dispatcher-token`, &auth.User{ID: 8, Type: `dispatcher`})
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	listTrash := func() []storageComponents.FileItemCompact {
+		response := h.Request(t, http.MethodGet, `/api/1/files/trash`, driverToken, nil)
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.FilesListResponse](t, response).Files
+	}
+	require.Empty(t, listTrash())
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, `dispatcher-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	trash := listTrash()
+	require.Len(t, trash, 1)
+	require.Equal(t, uploaded.Uid, trash[0].Uid)
+	require.Equal(t, storageComponents.PropertyFileStatusDeleted, *trash[0].Status)
+	require.NotNil(t, trash[0].DeletedAt)
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, `dispatcher-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+
+	// restored file is kept as the older version of the file uploaded after it was deleted
+	response = h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill v2`))
+	requireStatus(t, http.StatusOK, response)
+	replacement := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, 2, *replacement.Version)
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	restored := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, uploaded.Uid, restored.Uid)
+	require.Equal(t, 1, *restored.Version)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill v2`, harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+replacement.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill`, harness.ReadBody(t, response))
+
+	trash = listTrash()
+	require.Len(t, trash, 1)
+	require.Equal(t, replacement.Uid, trash[0].Uid)
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func TestDeleteFileByAdmin(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`admin-token`, &auth.User{ID: 1, Type: `admin`})
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+uploaded.Uid, `admin-token`, nil)
+	requireStatus(t, http.StatusNoContent, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+uploaded.Uid+`/restore`, `admin-token`, nil)
+	requireStatus(t, http.StatusOK, response)
+}
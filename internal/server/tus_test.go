@@ -0,0 +1,316 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/conf"
+	"storage/internal/pkg/harness"
+)
+
+const (
+	tusVersion   = `1.0.0`
+	tusChunkSize = 5 << 20
+)
+
+func tusRequest(
+	t *testing.T,
+	h *harness.Harness,
+	method, path string,
+	headers map[string]string,
+	body io.Reader,
+) *http.Response {
+	t.Helper()
+	request, err := http.NewRequest(method, h.Server.URL+path, body)
+	require.NoError(t, err)
+	request.Header.Set(`Authorization`, `Bearer `+driverToken)
+	request.Header.Set(`Tus-Resumable`, tusVersion)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	return h.Do(t, request)
+}
+
+func tusCreate(t *testing.T, h *harness.Harness, filename string, length int) string {
+	t.Helper()
+	response := tusRequest(t, h, http.MethodPost, `/api/1/tus`, map[string]string{
+		`Upload-Length`:   strconv.Itoa(length),
+		`Upload-Metadata`: `filename ` + base64.StdEncoding.EncodeToString([]byte(filename)) + `,filetype dmlkZW8vbXA0`,
+	}, nil)
+	requireStatus(t, http.StatusCreated, response)
+	require.Equal(t, tusVersion, response.Header.Get(`Tus-Resumable`))
+	location := response.Header.Get(`Location`)
+	require.NotEmpty(t, location)
+	return location
+}
+
+func tusPatch(t *testing.T, h *harness.Harness, location string, offset int, chunk []byte) *http.Response {
+	t.Helper()
+	return tusRequest(t, h, http.MethodPatch, location, map[string]string{
+		`Upload-Offset`: strconv.Itoa(offset),
+		`Content-Type`:  `application/offset+octet-stream`,
+	}, bytes.NewReader(chunk))
+}
+
+func TestTusOptions(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodOptions, `/api/1/tus`, ``, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	require.Equal(t, tusVersion, response.Header.Get(`Tus-Version`))
+	require.Equal(t, `creation,termination`, response.Header.Get(`Tus-Extension`))
+}
+
+func TestTusResumeAndComplete(t *testing.T) {
+	h := newHarness(t)
+	content := bytes.Repeat([]byte(`0123456789`), tusChunkSize/10+3)
+
+	location := tusCreate(t, h, `video.mp4`, len(content))
+
+	response := tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `0`, response.Header.Get(`Upload-Offset`))
+	require.Equal(t, strconv.Itoa(len(content)), response.Header.Get(`Upload-Length`))
+	require.Equal(t, `no-store`, response.Header.Get(`Cache-Control`))
+
+	response = tusPatch(t, h, location, 0, content[:tusChunkSize])
+	requireStatus(t, http.StatusNoContent, response)
+	require.Equal(t, strconv.Itoa(tusChunkSize), response.Header.Get(`Upload-Offset`))
+	require.Empty(t, response.Header.Get(`Upload-File-Uid`))
+
+	// client reconnects and asks where to resume from
+	response = tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, strconv.Itoa(tusChunkSize), response.Header.Get(`Upload-Offset`))
+
+	response = tusPatch(t, h, location, 0, content[tusChunkSize:])
+	requireStatus(t, http.StatusConflict, response)
+
+	response = tusPatch(t, h, location, tusChunkSize, content[tusChunkSize:])
+	requireStatus(t, http.StatusNoContent, response)
+	require.Equal(t, strconv.Itoa(len(content)), response.Header.Get(`Upload-Offset`))
+	fileUID := response.Header.Get(`Upload-File-Uid`)
+	require.NotEmpty(t, fileUID)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+fileUID, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, string(content), harness.ReadBody(t, response))
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Contains(t, harness.ReadBody(t, response), fileUID)
+
+	response = tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, fileUID, response.Header.Get(`Upload-File-Uid`))
+}
+
+func TestTusEmptyFile(t *testing.T) {
+	h := newHarness(t)
+
+	location := tusCreate(t, h, `empty.txt`, 0)
+
+	response := tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `0`, response.Header.Get(`Upload-Offset`))
+	require.NotEmpty(t, response.Header.Get(`Upload-File-Uid`))
+}
+
+func TestTusTerminate(t *testing.T) {
+	h := newHarness(t)
+
+	location := tusCreate(t, h, `video.mp4`, 100)
+
+	response := tusRequest(t, h, http.MethodDelete, location, nil, nil)
+	requireStatus(t, http.StatusNoContent, response)
+
+	response = tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusNotFound, response)
+	require.Empty(t, h.Storage.Objects())
+}
+
+func TestTusInvalidRequests(t *testing.T) {
+	h := newHarness(t)
+
+	location := tusCreate(t, h, `video.mp4`, tusChunkSize+10)
+
+	response := tusRequest(t, h, http.MethodHead, location, map[string]string{`Tus-Resumable`: `0.2.2`}, nil)
+	requireStatus(t, http.StatusPreconditionFailed, response)
+	require.Equal(t, tusVersion, response.Header.Get(`Tus-Version`))
+
+	response = tusRequest(t, h, http.MethodPatch, location, map[string]string{
+		`Upload-Offset`: `0`,
+		`Content-Type`:  `application/octet-stream`,
+	}, harness.Body(`chunk`))
+	requireStatus(t, http.StatusUnsupportedMediaType, response)
+
+	response = tusPatch(t, h, location, 0, make([]byte, tusChunkSize+11))
+	requireStatus(t, http.StatusBadRequest, response)
+
+	response = tusRequest(t, h, http.MethodPost, `/api/1/tus`, map[string]string{`Upload-Length`: `10`}, nil)
+	requireStatus(t, http.StatusBadRequest, response)
+
+	response = tusRequest(t, h, http.MethodHead, `/api/1/tus/123e4567-e89b-12d3-a456-426614174000`, nil, nil)
+	requireStatus(t, http.StatusNotFound, response)
+}
+
+func tusOffset(t *testing.T, h *harness.Harness, location string) int {
+	t.Helper()
+	response := tusRequest(t, h, http.MethodHead, location, nil, nil)
+	requireStatus(t, http.StatusOK, response)
+	offset, err := strconv.Atoi(response.Header.Get(`Upload-Offset`))
+	require.NoError(t, err)
+	return offset
+}
+
+func TestTusSmallChunks(t *testing.T) {
+	h := newHarness(t)
+	content := bytes.Repeat([]byte(`0123456789`), tusChunkSize/10+300)
+	location := tusCreate(t, h, `video.mp4`, len(content))
+
+	// chunks of any size are accepted, bytes which are not enough for s3 part wait for the next chunks
+	offset := 0
+	for _, size := range []int{100, tusChunkSize - 50, 1000, 0} {
+		response := tusPatch(t, h, location, offset, content[offset:offset+size])
+		requireStatus(t, http.StatusNoContent, response)
+		offset += size
+		require.Equal(t, strconv.Itoa(offset), response.Header.Get(`Upload-Offset`))
+		require.Equal(t, offset, tusOffset(t, h, location))
+	}
+
+	response := tusPatch(t, h, location, offset, content[offset:])
+	requireStatus(t, http.StatusNoContent, response)
+	fileUID := response.Header.Get(`Upload-File-Uid`)
+	require.NotEmpty(t, fileUID)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+fileUID, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, string(content), harness.ReadBody(t, response))
+	require.Len(t, h.Storage.Objects(), 1, `pending bytes are not left in storage`)
+}
+
+func TestTusInterruptedChunk(t *testing.T) {
+	h := newHarness(t)
+	content := bytes.Repeat([]byte(`0123456789`), tusChunkSize/10+300)
+	location := tusCreate(t, h, `video.mp4`, len(content))
+
+	// connection drops after a part of chunk is sent
+	sent := tusChunkSize + 1000
+	endpoint, err := url.Parse(h.Server.URL)
+	require.NoError(t, err)
+	conn, err := net.Dial(`tcp`, endpoint.Host)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(
+		conn,
+		"PATCH %s HTTP/1.1\r\nHost: %s\r\nAuthorization: Bearer %s\r\nTus-Resumable: %s\r\n"+
+			"Upload-Offset: 0\r\nContent-Type: application/offset+octet-stream\r\nContent-Length: %d\r\n\r\n",
+		location, endpoint.Host, driverToken, tusVersion, len(content),
+	)
+	require.NoError(t, err)
+	_, err = conn.Write(content[:sent])
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	require.Eventually(t, func() bool {
+		return tusOffset(t, h, location) == sent
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// interrupted request may still hold lock for a moment after its bytes are stored
+	var response *http.Response
+	require.Eventually(t, func() bool {
+		response = tusPatch(t, h, location, sent, content[sent:])
+		return response.StatusCode != http.StatusConflict
+	}, 5*time.Second, 10*time.Millisecond)
+	requireStatus(t, http.StatusNoContent, response)
+	fileUID := response.Header.Get(`Upload-File-Uid`)
+	require.NotEmpty(t, fileUID)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+fileUID, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, string(content), harness.ReadBody(t, response))
+}
+
+func TestTusRetriesFailedCompletion(t *testing.T) {
+	h := newHarness(t)
+	content := bytes.Repeat([]byte(`0123456789`), tusChunkSize/10+3)
+	location := tusCreate(t, h, `video.mp4`, len(content))
+
+	response := tusPatch(t, h, location, 0, content[:tusChunkSize])
+	requireStatus(t, http.StatusNoContent, response)
+
+	h.PolicyConf.Roles = map[string]*conf.Policy_Role{
+		`driver`: {Upload: true, Quota: &conf.Policy_Quota{Bytes: int64(tusChunkSize)}},
+	}
+	response = tusPatch(t, h, location, tusChunkSize, content[tusChunkSize:])
+	requireStatus(t, http.StatusInsufficientStorage, response)
+
+	// the last part is not counted, so client sends it again once quota allows
+	require.Equal(t, tusChunkSize, tusOffset(t, h, location))
+	h.PolicyConf.Roles = nil
+	response = tusPatch(t, h, location, tusChunkSize, content[tusChunkSize:])
+	requireStatus(t, http.StatusNoContent, response)
+	require.NotEmpty(t, response.Header.Get(`Upload-File-Uid`))
+}
+
+func TestTusConcurrentChunks(t *testing.T) {
+	h := newHarness(t)
+	content := bytes.Repeat([]byte(`0123456789`), tusChunkSize/10+300)
+	location := tusCreate(t, h, `video.mp4`, len(content))
+
+	// the first chunk is streamed slowly, so it holds lock of upload
+	reader, writer := io.Pipe()
+	request, err := http.NewRequest(http.MethodPatch, h.Server.URL+location, reader)
+	require.NoError(t, err)
+	request.ContentLength = int64(len(content))
+	request.Header.Set(`Authorization`, `Bearer `+driverToken)
+	request.Header.Set(`Tus-Resumable`, tusVersion)
+	request.Header.Set(`Upload-Offset`, `0`)
+	request.Header.Set(`Content-Type`, `application/offset+octet-stream`)
+	responses := make(chan *http.Response, 1)
+	go func() {
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			responses <- nil
+			return
+		}
+		responses <- response
+	}()
+	_, err = writer.Write(content[:1000])
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		upload, err := h.Ent.Multipart.Query().Only(context.Background())
+		require.NoError(t, err)
+		return upload.LockUID != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the same offset is not appended twice
+	response := tusPatch(t, h, location, 0, content)
+	requireStatus(t, http.StatusConflict, response)
+
+	_, err = writer.Write(content[1000:])
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	response = <-responses
+	require.NotNil(t, response)
+	t.Cleanup(func() {
+		_ = response.Body.Close()
+	})
+	requireStatus(t, http.StatusNoContent, response)
+	fileUID := response.Header.Get(`Upload-File-Uid`)
+	require.NotEmpty(t, fileUID)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+fileUID, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, string(content), harness.ReadBody(t, response))
+}
@@ -0,0 +1,110 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func TestFileVersions(t *testing.T) {
+	h := newHarness(t)
+	h.Auth.AddUser(`dispatcher-token`, &auth.User{ID: 8, Type: `dispatcher`})
+
+	upload := func(content string) *storageComponents.UploadResponse {
+		response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(content))
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.UploadResponse](t, response)
+	}
+	download := func(path string) string {
+		response := h.Request(t, http.MethodGet, path, ``, nil)
+		requireStatus(t, http.StatusOK, response)
+		return harness.ReadBody(t, response)
+	}
+	listVersions := func(uid string) []storageComponents.FileItemCompact {
+		response := h.Request(t, http.MethodGet, `/api/1/files/`+uid+`/versions`, driverToken, nil)
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.FilesListResponse](t, response).Files
+	}
+
+	first := upload(`waybill v1`)
+	second := upload(`waybill v2`)
+	require.Equal(t, 1, *first.Version)
+	require.Equal(t, 2, *second.Version)
+
+	require.Equal(t, `waybill v2`, download(`/api/1/download/`+first.Uid))
+	require.Equal(t, `waybill v1`, download(`/api/1/download/`+second.Uid+`?version=1`))
+
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+first.Uid+`?version=3`, ``, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	versions := listVersions(first.Uid)
+	require.Len(t, versions, 2)
+	require.Equal(t, second.Uid, versions[0].Uid)
+	require.Equal(t, first.Uid, versions[1].Uid)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/list`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	list := decode[storageComponents.FilesListResponse](t, response)
+	require.Len(t, list.Files, 1)
+	require.Equal(t, second.Uid, list.Files[0].Uid)
+
+	response = h.Request(t, http.MethodGet, `/api/1/files/`+first.Uid+`/versions`, `dispatcher-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+first.Uid+`/versions/1/rollback`, `dispatcher-token`, nil)
+	requireStatus(t, http.StatusForbidden, response)
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+first.Uid+`/versions/5/rollback`, driverToken, nil)
+	requireStatus(t, http.StatusNotFound, response)
+
+	response = h.Request(t, http.MethodPost, `/api/1/files/`+second.Uid+`/versions/1/rollback`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	rollback := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, 3, *rollback.Version)
+	require.Equal(t, *first.Sha256, *rollback.Sha256)
+
+	require.Equal(t, `waybill v1`, download(`/api/1/download/`+second.Uid))
+	require.Equal(t, `waybill v2`, download(`/api/1/download/`+second.Uid+`?version=2`))
+	require.Len(t, listVersions(second.Uid), 3)
+
+	// rolled back versions share content, so it is stored once
+	require.Len(t, h.Storage.Objects(), 2)
+}
+
+func TestDownloadByUIDOfOlderVersion(t *testing.T) {
+	h := newHarness(t)
+
+	upload := func(content string) *storageComponents.UploadResponse {
+		response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(content))
+		requireStatus(t, http.StatusOK, response)
+		return decode[storageComponents.UploadResponse](t, response)
+	}
+	first := upload(`waybill v1`)
+	second := upload(`waybill v2 with stamp`)
+
+	// uid of any version addresses the file, its own version is downloaded only by number
+	response := h.Request(t, http.MethodGet, `/api/1/download/`+first.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill v2 with stamp`, harness.ReadBody(t, response))
+	latestETag := response.Header.Get(`ETag`)
+
+	response = h.Request(t, http.MethodHead, `/api/1/download/`+first.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, latestETag, response.Header.Get(`ETag`))
+	require.Equal(t, `21`, response.Header.Get(`Content-Length`))
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+first.Uid+`?version=1`, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill v1`, harness.ReadBody(t, response))
+	require.NotEqual(t, latestETag, response.Header.Get(`ETag`))
+
+	// deleted version is not the latest one anymore
+	response = h.Request(t, http.MethodDelete, `/api/1/files/`+second.Uid, driverToken, nil)
+	requireStatus(t, http.StatusNoContent, response)
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+first.Uid, ``, nil)
+	requireStatus(t, http.StatusOK, response)
+	require.Equal(t, `waybill v1`, harness.ReadBody(t, response))
+}
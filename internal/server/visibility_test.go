@@ -0,0 +1,131 @@
+package server_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storage/internal/clients/auth"
+	"storage/internal/pkg/harness"
+	storageComponents "storage/schema/storage"
+)
+
+func TestDownloadByVisibility(t *testing.T) {
+	const (
+		otherToken = `other-driver-token`
+		adminToken = `admin-token`
+	)
+
+	testCases := []struct {
+		visibility storageComponents.PropertyVisibility
+		// expected statuses of download by anonymous, other user, owner and admin
+		anonymous, other, owner, admin int
+	}{
+		{
+			visibility: storageComponents.Public,
+			anonymous:  http.StatusOK,
+			other:      http.StatusOK,
+			owner:      http.StatusOK,
+			admin:      http.StatusOK,
+		},
+		{
+			visibility: storageComponents.Authenticated,
+			anonymous:  http.StatusUnauthorized,
+			other:      http.StatusOK,
+			owner:      http.StatusOK,
+			admin:      http.StatusOK,
+		},
+		{
+			visibility: storageComponents.Owner,
+			anonymous:  http.StatusUnauthorized,
+			other:      http.StatusForbidden,
+			owner:      http.StatusOK,
+			admin:      http.StatusOK,
+		},
+		{
+			visibility: storageComponents.Shared,
+			anonymous:  http.StatusUnauthorized,
+			other:      http.StatusForbidden,
+			owner:      http.StatusOK,
+			admin:      http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.visibility), func(t *testing.T) {
+			h := newHarness(t)
+			h.Auth.AddUser(otherToken, &auth.User{ID: 8, Type: `driver`})
+			h.Auth.AddUser(adminToken, &auth.User{ID: 1, Type: `admin`})
+
+			path := uploadPath(`waybill.pdf`) + `&visibility=` + string(testCase.visibility)
+			response := h.Request(t, http.MethodPost, path, driverToken, harness.Body(`waybill`))
+			requireStatus(t, http.StatusOK, response)
+			uploaded := decode[storageComponents.UploadResponse](t, response)
+			require.Equal(t, testCase.visibility, *uploaded.Visibility)
+
+			for token, expected := range map[string]int{
+				``:          testCase.anonymous,
+				otherToken:  testCase.other,
+				driverToken: testCase.owner,
+				adminToken:  testCase.admin,
+			} {
+				response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, token, nil)
+				requireStatus(t, expected, response)
+				response = h.Request(t, http.MethodHead, `/api/1/download/`+uploaded.Uid, token, nil)
+				requireStatus(t, expected, response)
+				response = h.Request(t, http.MethodGet, `/api/1/download/`+uploaded.Uid+`?mode=redirect`, token, nil)
+				if expected == http.StatusOK {
+					expected = http.StatusFound
+				}
+				requireStatus(t, expected, response)
+			}
+
+			response = h.IntegrationsRequest(t, http.MethodGet, `/api/1/download/`+uploaded.Uid, nil)
+			requireStatus(t, http.StatusOK, response)
+		})
+	}
+}
+
+func TestUploadVisibility(t *testing.T) {
+	h := newHarness(t)
+
+	response := h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`)+`&visibility=secret`, driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusBadRequest, response)
+
+	response = h.Request(t, http.MethodPost, uploadPath(`waybill.pdf`), driverToken, harness.Body(`waybill`))
+	requireStatus(t, http.StatusOK, response)
+	uploaded := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, storageComponents.Public, *uploaded.Visibility)
+
+	response = h.Request(t, http.MethodPost, `/api/1/multipart?filename=video.mp4&visibility=owner`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	upload := decode[storageComponents.MultipartResponse](t, response)
+	response = h.Request(t, http.MethodPut, partPath(upload.Uid, 1), driverToken, harness.Body(`video`))
+	requireStatus(t, http.StatusOK, response)
+	response = h.Request(t, http.MethodPost, `/api/1/multipart/`+upload.Uid+`/complete`, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+	multipartFile := decode[storageComponents.UploadResponse](t, response)
+	require.Equal(t, storageComponents.Owner, *multipartFile.Visibility)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+multipartFile.Uid, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+
+	response = tusRequest(t, h, http.MethodPost, `/api/1/tus`, map[string]string{
+		`Upload-Length`: strconv.Itoa(len(`photo`)),
+		`Upload-Metadata`: `filename ` + base64.StdEncoding.EncodeToString([]byte(`photo.jpg`)) +
+			`,visibility ` + base64.StdEncoding.EncodeToString([]byte(`authenticated`)),
+	}, nil)
+	requireStatus(t, http.StatusCreated, response)
+	response = tusPatch(t, h, response.Header.Get(`Location`), 0, []byte(`photo`))
+	requireStatus(t, http.StatusNoContent, response)
+	tusFileUID := response.Header.Get(`Upload-File-Uid`)
+	require.NotEmpty(t, tusFileUID)
+
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+tusFileUID, ``, nil)
+	requireStatus(t, http.StatusUnauthorized, response)
+	response = h.Request(t, http.MethodGet, `/api/1/download/`+tusFileUID, driverToken, nil)
+	requireStatus(t, http.StatusOK, response)
+}
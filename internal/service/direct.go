@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	storage "storage/schema"
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) DirectUploadInitiate(c *gin.Context, params storage.DirectUploadInitiateParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`DirectUploadInitiate`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.validate(params); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.DirectUploadInitiate(
+		c.Request.Context(),
+		params.Filename,
+		params.Size,
+		visibilityOf(params.Visibility),
+	)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, &storageComponents.DirectUploadResponse{
+		ExpiresAt:  upload.ExpiresAt,
+		Filename:   upload.File.Filename,
+		MimeType:   upload.File.MimeType,
+		ObjectPath: upload.File.ObjectPath,
+		PostFields: upload.PostFields,
+		PostUrl:    upload.PostURL,
+		PutUrl:     upload.PutURL,
+		Size:       upload.File.Size,
+		Uid:        upload.File.UID.String(),
+	})
+}
+
+func (s *StorageService) DirectUploadComplete(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`DirectUploadComplete`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	file, err := s.usecase.DirectUploadComplete(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, uploadResponse(file))
+}
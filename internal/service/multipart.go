@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+	"github.com/gin-gonic/gin"
+
+	"storage/internal/biz"
+	storage "storage/schema"
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) MultipartInitiate(c *gin.Context, params storage.MultipartInitiateParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`MultipartInitiate`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.validate(params); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.MultipartInitiate(c.Request.Context(), params.Filename, visibilityOf(params.Visibility))
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, multipartResponse(upload))
+}
+
+func (s *StorageService) MultipartStatus(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`MultipartStatus`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.MultipartStatus(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, multipartResponse(upload))
+}
+
+func (s *StorageService) MultipartUploadPart(
+	c *gin.Context,
+	uid storageComponents.Uid,
+	partNumber storageComponents.PartNumber,
+) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`MultipartUploadPart`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	part, err := s.usecase.MultipartUploadPart(c.Request.Context(), uid, &biz.UploadPart{
+		Reader:     c.Request.Body,
+		Size:       c.Request.ContentLength,
+		PartNumber: partNumber,
+	})
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, &storageComponents.MultipartPart{
+		Etag:       part.Etag,
+		PartNumber: part.PartNumber,
+		Size:       part.Size,
+	})
+}
+
+func (s *StorageService) MultipartComplete(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`MultipartComplete`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	file, err := s.usecase.MultipartComplete(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, uploadResponse(file))
+}
+
+func (s *StorageService) MultipartAbort(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`MultipartAbort`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	if err = s.usecase.MultipartAbort(c.Request.Context(), uid); err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseNoContent(c)
+}
+
+func multipartResponse(upload *biz.MultipartUpload) *storageComponents.MultipartResponse {
+	parts := make([]storageComponents.MultipartPart, 0, len(upload.Parts))
+	for _, part := range upload.Parts {
+		parts = append(parts, storageComponents.MultipartPart{
+			Etag:       part.Etag,
+			PartNumber: part.PartNumber,
+			Size:       part.Size,
+		})
+	}
+	response := &storageComponents.MultipartResponse{
+		Filename:   upload.Multipart.Filename,
+		MimeType:   pointer.ToString(upload.Multipart.MimeType),
+		ObjectPath: upload.Multipart.ObjectPath,
+		Parts:      parts,
+		Status:     storageComponents.PropertyMultipartStatus(upload.Multipart.Status),
+		Uid:        upload.Multipart.UID.String(),
+	}
+	if upload.Multipart.FileUID != nil {
+		response.FileUid = pointer.ToString(upload.Multipart.FileUID.String())
+	}
+	return response
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/gin-gonic/gin"
+
+	"storage/internal/biz"
+	storage "storage/schema"
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) Reconcile(c *gin.Context, params storage.ReconcileParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`Reconcile`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	state, err := s.usecase.ReconcileOnDemand(c.Request.Context(), pointer.GetBool(params.Apply))
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, reconcileStatusResponse(state))
+}
+
+func (s *StorageService) ReconcileStatus(c *gin.Context) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`ReconcileStatus`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	state, err := s.usecase.ReconcileStatus(c.Request.Context())
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, reconcileStatusResponse(state))
+}
+
+func reconcileStatusResponse(state *biz.ReconcileState) *storageComponents.ReconcileStatusResponse {
+	response := &storageComponents.ReconcileStatusResponse{
+		Running:    state.Running,
+		StartedAt:  state.StartedAt,
+		FinishedAt: state.FinishedAt,
+	}
+	if state.Err != nil {
+		response.Error = pointer.ToString(state.Err.Error())
+	}
+	if report := state.Report; report != nil {
+		response.Report = &storageComponents.ReconcileResponse{
+			Applied:         report.Applied,
+			FailedUploads:   report.FailedUploads,
+			OrphanedObjects: report.OrphanedObjects,
+			RemovedObjects:  report.RemovedObjects,
+			MissingObjects:  report.MissingObjects,
+		}
+	}
+	return response
+}
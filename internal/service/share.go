@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+	"github.com/gin-gonic/gin"
+
+	v1 "storage/api/storage/v1"
+	"storage/ent"
+	"storage/internal/biz"
+	storage "storage/schema"
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) CreateShareLink(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`CreateShareLink`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	var body storage.CreateShareLinkJSONRequestBody
+	if err = c.ShouldBindJSON(&body); err != nil {
+		s.responseValidationError(c, v1.ErrorValidationFailed(`limits of share link are invalid: %s`, err))
+		return
+	}
+
+	link, err := s.usecase.CreateShareLink(c.Request.Context(), uid, &biz.ShareLinkLimits{
+		ExpiresAt:    body.ExpiresAt,
+		Password:     pointer.GetString(body.Password),
+		MaxDownloads: body.MaxDownloads,
+	})
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, shareLinkResponse(link))
+}
+
+func (s *StorageService) ShareLinks(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`ShareLinks`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	links, err := s.usecase.ShareLinks(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	response := &storageComponents.ShareLinksResponse{
+		Links: make([]storageComponents.ShareLinkResponse, 0, len(links)),
+	}
+	for _, link := range links {
+		response.Links = append(response.Links, *shareLinkResponse(link))
+	}
+
+	s.responseOK(c, response)
+}
+
+func (s *StorageService) RevokeShareLink(c *gin.Context, shareUid storageComponents.ShareUid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`RevokeShareLink`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(shareUid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	if err = s.usecase.RevokeShareLink(c.Request.Context(), shareUid); err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseNoContent(c)
+}
+
+func (s *StorageService) ShareDownload(c *gin.Context, token storageComponents.ShareToken, params storage.ShareDownloadParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`ShareDownload`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	err = s.usecase.ShareDownload(c.Request.Context(), token, pointer.GetString(params.XSharePassword), &biz.DownloadRequest{
+		Range:           pointer.GetString(params.Range),
+		IfRange:         pointer.GetString(params.IfRange),
+		IfNoneMatch:     pointer.GetString(params.IfNoneMatch),
+		IfModifiedSince: pointer.GetString(params.IfModifiedSince),
+	}, c.Writer)
+	if err != nil {
+		s.responseError(c, err)
+	}
+}
+
+func (s *StorageService) ShareDownloadHead(
+	c *gin.Context,
+	token storageComponents.ShareToken,
+	params storage.ShareDownloadHeadParams,
+) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`ShareDownloadHead`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	err = s.usecase.ShareDownloadHead(c.Request.Context(), token, pointer.GetString(params.XSharePassword), &biz.DownloadRequest{
+		IfNoneMatch:     pointer.GetString(params.IfNoneMatch),
+		IfModifiedSince: pointer.GetString(params.IfModifiedSince),
+	}, c.Writer)
+	if err != nil {
+		s.responseError(c, err)
+	}
+}
+
+func shareLinkResponse(link *ent.ShareLink) *storageComponents.ShareLinkResponse {
+	response := &storageComponents.ShareLinkResponse{
+		Uid:          link.UID.String(),
+		Token:        link.Token,
+		Url:          biz.ShareLinkURL(link),
+		HasPassword:  link.PasswordHash != ``,
+		ExpiresAt:    link.ExpiresAt,
+		MaxDownloads: link.MaxDownloads,
+		Downloads:    link.Downloads,
+		RevokedAt:    link.RevokedAt,
+		CreatedAt:    link.CreatedAt,
+	}
+	if link.Edges.File != nil {
+		response.FileUid = link.Edges.File.UID.String()
+	}
+	return response
+}
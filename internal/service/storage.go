@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/AlekSi/pointer"
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,7 @@ import (
 	"github.com/phlx-ru/hatchet/watcher"
 
 	v1 "storage/api/storage/v1"
+	"storage/ent"
 	"storage/internal/biz"
 	storage "storage/schema"
 	storageComponents "storage/schema/storage"
@@ -43,8 +45,13 @@ func NewGatewayService(
 		watcher: watcher.New(metricPrefix, loggerHelper, metric).
 			WithWarningErrorChecks([]func(err error) bool{
 				v1.IsAccessDenied,
+				v1.IsConflict,
+				v1.IsNotFound,
 				v1.IsValidationFailed,
 				v1.IsUnauthorized,
+				v1.IsQuotaExceeded,
+				v1.IsPayloadTooLarge,
+				v1.IsQuarantined,
 			}),
 	}
 }
@@ -64,23 +71,83 @@ func (s *StorageService) GetSwagger(c *gin.Context) {
 	s.responseOK(c, swagger)
 }
 
-func (s *StorageService) Download(c *gin.Context, uid storageComponents.Uid) {
+func (s *StorageService) Download(c *gin.Context, uid storageComponents.Uid, params storage.DownloadParams) {
 	var err error
 	defer s.watcher.OnPreparedMethod(`Download`).Results(func() (context.Context, error) {
 		return c.Request.Context(), err
 	})
 
-	if uid == "" {
-		s.responseError(c, fmt.Errorf(`UID is empty`))
+	if err = checkUID(uid); err != nil {
+		s.responseError(c, err)
 		return
 	}
 
-	if len(uid) > uidMaxLength {
-		s.responseError(c, fmt.Errorf(`UID must have 36 symbols or less`))
+	version := pointer.GetInt(params.Version)
+	if params.W != nil || params.H != nil {
+		// renditions are always streamed through the service, they are made on the first request
+		request := &biz.RenditionRequest{
+			UID:         uid,
+			Version:     version,
+			Width:       pointer.GetInt(params.W),
+			Height:      pointer.GetInt(params.H),
+			IfNoneMatch: pointer.GetString(params.IfNoneMatch),
+		}
+		if params.Fit != nil {
+			request.Fit = string(*params.Fit)
+		}
+		if params.Format != nil {
+			request.Format = string(*params.Format)
+		}
+		if err = s.usecase.DownloadRendition(c.Request.Context(), request, c.Writer); err != nil {
+			s.responseError(c, err)
+		}
+		return
+	}
+
+	mode := ""
+	if params.Mode != nil {
+		mode = string(*params.Mode)
+	}
+	downloadURL, err := s.usecase.DownloadURL(c.Request.Context(), uid, version, mode)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+	if downloadURL != "" {
+		c.Redirect(http.StatusFound, downloadURL)
 		return
 	}
 
-	err = s.usecase.Download(c.Request.Context(), uid, c.Writer)
+	err = s.usecase.Download(c.Request.Context(), &biz.DownloadRequest{
+		UID:             uid,
+		Version:         version,
+		Range:           pointer.GetString(params.Range),
+		IfRange:         pointer.GetString(params.IfRange),
+		IfNoneMatch:     pointer.GetString(params.IfNoneMatch),
+		IfModifiedSince: pointer.GetString(params.IfModifiedSince),
+	}, c.Writer)
+	if err != nil {
+		s.responseError(c, err)
+	}
+}
+
+func (s *StorageService) DownloadHead(c *gin.Context, uid storageComponents.Uid, params storage.DownloadHeadParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`DownloadHead`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	err = s.usecase.DownloadHead(c.Request.Context(), &biz.DownloadRequest{
+		UID:             uid,
+		Version:         pointer.GetInt(params.Version),
+		IfNoneMatch:     pointer.GetString(params.IfNoneMatch),
+		IfModifiedSince: pointer.GetString(params.IfModifiedSince),
+	}, c.Writer)
 	if err != nil {
 		s.responseError(c, err)
 	}
@@ -110,6 +177,10 @@ func (s *StorageService) Upload(c *gin.Context, params storage.UploadParams) {
 		Reader:   c.Request.Body,
 		Size:     c.Request.ContentLength,
 		Filename: params.Filename,
+
+		Visibility:     visibilityOf(params.Visibility),
+		Digest:         pointer.GetString(params.Digest),
+		ChecksumSHA256: pointer.GetString(params.XChecksumSHA256),
 	}
 
 	file, err := s.usecase.Upload(c.Request.Context(), uploadFile)
@@ -118,44 +189,109 @@ func (s *StorageService) Upload(c *gin.Context, params storage.UploadParams) {
 		return
 	}
 
-	response := &storageComponents.UploadResponse{
-		Filename:   file.Filename,
-		MimeType:   pointer.ToString(file.MimeType),
-		ObjectPath: file.ObjectPath,
-		Size:       pointer.ToInt(file.Size),
-		Uid:        file.UID.String(),
-		UserId:     file.UserID,
+	s.responseOK(c, uploadResponse(file))
+}
+
+func (s *StorageService) FilesList(c *gin.Context, params storage.FilesListParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`FilesList`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	files, err := s.usecase.FilesList(c.Request.Context(), status, pointer.GetBool(params.All))
+	if err != nil {
+		s.responseError(c, err)
+		return
 	}
 
-	s.responseOK(c, response)
+	s.responseOK(c, filesListResponse(files))
 }
 
-func (s *StorageService) FilesList(c *gin.Context) {
+func (s *StorageService) Usage(c *gin.Context) {
 	var err error
-	defer s.watcher.OnPreparedMethod(`FilesList`).Results(func() (context.Context, error) {
+	defer s.watcher.OnPreparedMethod(`Usage`).Results(func() (context.Context, error) {
 		return c.Request.Context(), err
 	})
 
-	files, err := s.usecase.FilesList(c.Request.Context())
+	usage, err := s.usecase.Usage(c.Request.Context())
 	if err != nil {
-		s.responseError(c, errors.InternalServer(`internal_server`, err.Error()))
+		s.responseError(c, err)
 		return
 	}
 
-	filesList := make([]storageComponents.FileItemCompact, len(files))
+	response := &storageComponents.UsageResponse{
+		Bytes: usage.Bytes,
+		Files: usage.Files,
+	}
+	if usage.Quota.GetBytes() > 0 {
+		response.QuotaBytes = pointer.ToInt64(usage.Quota.GetBytes())
+	}
+	if usage.Quota.GetFiles() > 0 {
+		response.QuotaFiles = pointer.ToInt64(usage.Quota.GetFiles())
+	}
+
+	s.responseOK(c, response)
+}
+
+func filesListResponse(files []*ent.File) *storageComponents.FilesListResponse {
+	filesList := make([]storageComponents.FileItemCompact, 0, len(files))
 	for _, file := range files {
 		item := storageComponents.FileItemCompact{
-			Filename:   file.Filename,
-			MimeType:   pointer.ToString(file.MimeType),
-			ObjectPath: file.ObjectPath,
-			Size:       pointer.ToInt(file.Size),
-			Uid:        file.UID.String(),
+			Filename:         file.Filename,
+			MimeType:         pointer.ToString(file.MimeType),
+			DetectedMimeType: pointer.ToStringOrNil(file.DetectedMimeType),
+			ScanStatus:       (*storageComponents.PropertyScanStatus)(pointer.ToString(file.ScanStatus.String())),
+			ObjectPath:       biz.ObjectPathOf(file),
+			Size:             pointer.ToInt(file.Size),
+			Uid:              file.UID.String(),
+			Version:          pointer.ToInt(file.Version),
+			Visibility:       (*storageComponents.PropertyVisibility)(pointer.ToString(file.Visibility.String())),
+			Status:           (*storageComponents.PropertyFileStatus)(pointer.ToString(file.Status.String())),
+			CreatedAt:        pointer.ToTime(file.CreatedAt),
+			DeletedAt:        file.DeletedAt,
 		}
 		filesList = append(filesList, item)
 	}
-	response := &storageComponents.FilesListResponse{
+	return &storageComponents.FilesListResponse{
 		Files: filesList,
 	}
+}
+
+// visibilityOf takes optional visibility of uploading file, its value is validated by usecase
+func visibilityOf(visibility *storageComponents.Visibility) string {
+	if visibility == nil {
+		return ""
+	}
+	return string(*visibility)
+}
 
-	s.responseOK(c, response)
+func checkUID(uid string) error {
+	if uid == "" {
+		return fmt.Errorf(`UID is empty`)
+	}
+	if len(uid) > uidMaxLength {
+		return fmt.Errorf(`UID must have 36 symbols or less`)
+	}
+	return nil
+}
+
+func uploadResponse(file *ent.File) *storageComponents.UploadResponse {
+	return &storageComponents.UploadResponse{
+		Filename:         file.Filename,
+		MimeType:         pointer.ToString(file.MimeType),
+		DetectedMimeType: pointer.ToStringOrNil(file.DetectedMimeType),
+		ScanStatus:       (*storageComponents.PropertyScanStatus)(pointer.ToString(file.ScanStatus.String())),
+		ObjectPath:       biz.ObjectPathOf(file),
+		Size:             pointer.ToInt(file.Size),
+		Uid:              file.UID.String(),
+		UserId:           file.UserID,
+		Sha256:           pointer.ToStringOrNil(file.Sha256),
+		Md5:              pointer.ToStringOrNil(file.Md5),
+		Version:          pointer.ToInt(file.Version),
+		Visibility:       (*storageComponents.PropertyVisibility)(pointer.ToString(file.Visibility.String())),
+	}
 }
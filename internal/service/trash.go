@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) DeleteFile(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`DeleteFile`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	if err = s.usecase.DeleteFile(c.Request.Context(), uid); err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseNoContent(c)
+}
+
+func (s *StorageService) RestoreFile(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`RestoreFile`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	file, err := s.usecase.RestoreFile(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, uploadResponse(file))
+}
+
+func (s *StorageService) TrashList(c *gin.Context) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TrashList`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	files, err := s.usecase.TrashList(c.Request.Context())
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, filesListResponse(files))
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"storage/internal/biz"
+	storage "storage/schema"
+	storageComponents "storage/schema/storage"
+)
+
+const (
+	tusVersion     = `1.0.0`
+	tusExtensions  = `creation,termination`
+	tusContentType = `application/offset+octet-stream`
+	tusLocation    = `/api/1/tus/%s`
+
+	filenameMinLength = 3
+	filenameMaxLength = 255
+)
+
+func (s *StorageService) TusOptions(c *gin.Context) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TusOptions`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	c.Header(`Tus-Resumable`, tusVersion)
+	c.Header(`Tus-Version`, tusVersion)
+	c.Header(`Tus-Extension`, tusExtensions)
+	s.responseNoContent(c)
+}
+
+func (s *StorageService) TusCreate(c *gin.Context, params storage.TusCreateParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TusCreate`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.checkTusResumable(c, params.TusResumable); err != nil {
+		return
+	}
+	if params.UploadLength == nil {
+		err = fmt.Errorf(`Upload-Length header is required`)
+		s.responseValidationError(c, err)
+		return
+	}
+	metadata, err := tusMetadata(params.UploadMetadata)
+	if err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+	filename, err := tusFilename(metadata)
+	if err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.TusCreate(c.Request.Context(), filename, metadata[`visibility`], *params.UploadLength)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	c.Header(`Location`, fmt.Sprintf(tusLocation, upload.Multipart.UID.String()))
+	tusUploadHeaders(c, upload)
+	c.Status(http.StatusCreated)
+}
+
+func (s *StorageService) TusOffset(c *gin.Context, uid storageComponents.Uid, params storage.TusOffsetParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TusOffset`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.checkTusResumable(c, params.TusResumable); err != nil {
+		return
+	}
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.TusOffset(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	c.Header(`Cache-Control`, `no-store`)
+	c.Header(`Upload-Length`, strconv.FormatInt(upload.Length, 10))
+	tusUploadHeaders(c, upload)
+	c.Status(http.StatusOK)
+}
+
+func (s *StorageService) TusPatch(c *gin.Context, uid storageComponents.Uid, params storage.TusPatchParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TusPatch`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.checkTusResumable(c, params.TusResumable); err != nil {
+		return
+	}
+	if c.ContentType() != tusContentType {
+		err = errors.New(
+			http.StatusUnsupportedMediaType,
+			`UNSUPPORTED_MEDIA_TYPE`,
+			fmt.Sprintf(`Content-Type must be %s`, tusContentType),
+		)
+		s.responseError(c, err)
+		return
+	}
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+	if params.UploadOffset == nil {
+		err = fmt.Errorf(`Upload-Offset header is required`)
+		s.responseValidationError(c, err)
+		return
+	}
+
+	upload, err := s.usecase.TusPatch(
+		c.Request.Context(),
+		uid,
+		*params.UploadOffset,
+		c.Request.Body,
+		c.Request.ContentLength,
+	)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	tusUploadHeaders(c, upload)
+	s.responseNoContent(c)
+}
+
+func (s *StorageService) TusTerminate(c *gin.Context, uid storageComponents.Uid, params storage.TusTerminateParams) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`TusTerminate`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = s.checkTusResumable(c, params.TusResumable); err != nil {
+		return
+	}
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	if err = s.usecase.TusTerminate(c.Request.Context(), uid); err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	c.Header(`Tus-Resumable`, tusVersion)
+	s.responseNoContent(c)
+}
+
+// checkTusResumable responds with 412 Precondition Failed if client uses unsupported version of tus protocol
+func (s *StorageService) checkTusResumable(c *gin.Context, version *string) error {
+	c.Header(`Tus-Resumable`, tusVersion)
+	if version != nil && *version == tusVersion {
+		return nil
+	}
+	err := errors.New(
+		http.StatusPreconditionFailed,
+		`TUS_VERSION_MISMATCH`,
+		fmt.Sprintf(`Tus-Resumable header must be %s`, tusVersion),
+	)
+	c.Header(`Tus-Version`, tusVersion)
+	s.responseError(c, err)
+	return err
+}
+
+func tusUploadHeaders(c *gin.Context, upload *biz.TusUpload) {
+	c.Header(`Upload-Offset`, strconv.FormatInt(upload.Offset, 10))
+	if upload.Completed() {
+		c.Header(`Upload-File-Uid`, upload.Multipart.FileUID.String())
+	}
+}
+
+// tusMetadata decodes Upload-Metadata header, which consists of comma separated pairs
+// of key and base64 encoded value
+func tusMetadata(metadata *string) (map[string]string, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf(`Upload-Metadata header with filename is required`)
+	}
+	values := map[string]string{}
+	for _, pair := range strings.Split(*metadata, `,`) {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), ` `)
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf(`Upload-Metadata value of key [%s] is not base64 encoded`, key)
+		}
+		values[key] = string(value)
+	}
+	return values, nil
+}
+
+// tusFilename takes filename from metadata, clients usually send it with "filename" or "name" key
+func tusFilename(values map[string]string) (string, error) {
+	filename := values[`filename`]
+	if filename == "" {
+		filename = values[`name`]
+	}
+	if len(filename) < filenameMinLength || len(filename) > filenameMaxLength {
+		return "", fmt.Errorf(
+			`filename in Upload-Metadata must have from %d to %d symbols`,
+			filenameMinLength,
+			filenameMaxLength,
+		)
+	}
+	return filename, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	storageComponents "storage/schema/storage"
+)
+
+func (s *StorageService) FileVersions(c *gin.Context, uid storageComponents.Uid) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`FileVersions`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	files, err := s.usecase.FileVersions(c.Request.Context(), uid)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, filesListResponse(files))
+}
+
+func (s *StorageService) RollbackFile(c *gin.Context, uid storageComponents.Uid, version storageComponents.VersionPath) {
+	var err error
+	defer s.watcher.OnPreparedMethod(`RollbackFile`).Results(func() (context.Context, error) {
+		return c.Request.Context(), err
+	})
+
+	if err = checkUID(uid); err != nil {
+		s.responseValidationError(c, err)
+		return
+	}
+
+	file, err := s.usecase.RollbackFile(c.Request.Context(), uid, version)
+	if err != nil {
+		s.responseError(c, err)
+		return
+	}
+
+	s.responseOK(c, uploadResponse(file))
+}
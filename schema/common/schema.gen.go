@@ -35,28 +35,64 @@ type Filename = string
 // ErrorBadRequest defines model for errorBadRequest.
 type ErrorBadRequest = ErrorCommon
 
+// ErrorConflict defines model for errorConflict.
+type ErrorConflict = ErrorCommon
+
+// ErrorForbidden defines model for errorForbidden.
+type ErrorForbidden = ErrorCommon
+
+// ErrorGone defines model for errorGone.
+type ErrorGone = ErrorCommon
+
+// ErrorInsufficientStorage defines model for errorInsufficientStorage.
+type ErrorInsufficientStorage = ErrorCommon
+
 // ErrorInternal defines model for errorInternal.
 type ErrorInternal = ErrorCommon
 
+// ErrorLocked defines model for errorLocked.
+type ErrorLocked = ErrorCommon
+
+// ErrorNotFound defines model for errorNotFound.
+type ErrorNotFound = ErrorCommon
+
+// ErrorPayloadTooLarge defines model for errorPayloadTooLarge.
+type ErrorPayloadTooLarge = ErrorCommon
+
+// ErrorPreconditionFailed defines model for errorPreconditionFailed.
+type ErrorPreconditionFailed = ErrorCommon
+
+// ErrorRangeNotSatisfiable defines model for errorRangeNotSatisfiable.
+type ErrorRangeNotSatisfiable = ErrorCommon
+
 // ErrorTooManyRequests defines model for errorTooManyRequests.
 type ErrorTooManyRequests = ErrorCommon
 
 // ErrorUnauthorized defines model for errorUnauthorized.
 type ErrorUnauthorized = ErrorCommon
 
+// ErrorUnsupportedMediaType defines model for errorUnsupportedMediaType.
+type ErrorUnsupportedMediaType = ErrorCommon
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xUwW7UPBB+FWv+/+jtpi17IFIPFIHUA1IFywkQGpJJ1lViu7ZTdqly4YZ4B96BQyWE",
-	"aHkF942QnWQ3SwsSl70lM/PN9814Zi4hU7VWkqSzkF6CRoM1OTLxrxAVSawpfOdkMyO0E0pCCk8HDwcR",
-	"/s8bMivg0EVvgBwMnTfCUA6pMw1xsNmCavxrRlpirauQx4pM7+m8AA5upaPFGSFLaDksJwq1mGQqp5Lk",
-	"hJbO4MRhGZVfYCVydAExCOC1kEeHvMbl0cFsBm3bBnFWK2kpYsgYZY4xf07nDVkXTJmSjmT8RK0rkWFQ",
-	"Oz2zQfLlqJb/DRWQwn/TTTunnddOY97Hqq6V7Fi3C3+QJOwYczbQthzGiB2p6Dzs0ekJi5FrGSfSkZFY",
-	"7UrILEnYwMlekLkgw55sKZor9Qzlqu+X3dk7HTxkc6VY4GZr8kHUS4mNWygjPoRR39Xk7LMt3hDSY9cD",
-	"vRkjzHMRkFidGqXJOBHmvsDKEgc9MvXIf8SERby71vHpWPSNFnuWJOuNFtJRSfF5a7IWyz9mGdzjCzFf",
-	"kCEmLLOqJrcQsmTvjZLlfRfDEPbt384+X2nKWedlqugXYMwSevx2MP+Wtx3fuFfQl9pzbWp6swaqd2eU",
-	"uTvALv09YW24sYW6q9t/8V9vP/lv/or57/7G//A//Y2/9lf++vbj7efXgd4JFyvIuv0eXXsOF2Rsl2l/",
-	"L9lLQouUJolaQAqH0cRBo1tYSGVTVe2vAAAA//8BezgJLgYAAA==",
+	"H4sIAAAAAAAC/7yWwY7bNhCGX2XA9lTIu/J63SYGcmiCbhEgWSyybi/dohiLI5mpxJFJyrWz8KW3ou/Q",
+	"d+ghQFE06Sto36ggJdlydxMgF91sDmf+j6MhZ25FwkXJmrSzYnYrSjRYkCMT/qUqJ40F+d+SbGJU6RRr",
+	"MRMXnSUSyv9fVWS2IhLN7oNjJAytKmVIipkzFUXCJksq8KMRaYNFmfs4ViXlSSlTEQm3LcOKM0pnYheJ",
+	"zYixVKOEJWWkR7RxBkcOs0C+xlxJdN6jA4gKpZ9MogI3T86mU7Hb7TycLVlbCj5kDJunKF/RqiLr/FLC",
+	"2pEOP7Esc5Wgpz19bT3ybe8snxtKxUx8dnpI52ljtach7jMuCtaN6vHBz+MYnqKETnYXib7HQBSNBb6+",
+	"eg5hZw9Dp7lKBkzHY9hrdhAXbBZKStLDUUzgINphfMuaBiMYxxD0OvHn2lZpqhJF2l07NpgNxjKNv4K+",
+	"PLT6EawqdgicQmXJgLJAm4RIkuxhOzIa8+FYY+g04ZrMmgx8c1TRLzj5meRQPOdnE2gUI/DPok/SqkKD",
+	"2ilNEirtVA7K+XWboPZriy1481qZyu6xL9ldcKWHA4/P4ZIdNKIdxRVuc0Y5Z36BJhvwNkyglYY5MwTx",
+	"CKx6Q776WoS2+izkqlCH1+PKUMJaKh/rAlU+4Mcfn0FfHVr5SCwJZdtl55UdfU/Gqkb4OIKtypKNIwnr",
+	"Zov153WVhdKw44Rz0e+ph9Y5PolP4vt9M0AG6leoM7pkd41O2VThIh/wa34JQT4UWB/gKDHPGpRR2PpA",
+	"atqPH24VWrgRi60jC1+cesuN+EBiuk3js0fnk3j86GM5mjO/RL1tW7Md7s14HKrca8NevIP6TmPllmzU",
+	"mwELOR7Dke4BZl+hL0kqnIdUDlVGU+jpQwCAQOA3t1H2o91hoELZXEfMrwyXZJwiK2Yp5pYiUfaWWs9P",
+	"9PEj6f1yDU0Igq034k7jeF9/SjvKKDSqgqzF7INROnMvkJgvyYTuYrkgt1Q6g18M6+yh2dkQ2odeG587",
+	"CY3VX63m9H0VXwI/dcv/vzf9af8H0R611Tqc6ce9Iy9eU+LuOTbhH9i2i4TSKd/nrv+o/7z7rf6rfgv1",
+	"3/X7+p/63/p9/a5+W7+7+/Xu9xsv75QLJ0iaSfdQbiIS6+75bZ/NXSS4JI2lEjMxaV/SEt3Sipmu8nz3",
+	"3wBJ0aAfOA0AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -26,26 +26,260 @@ const (
 	JwtScopes          = "jwt.Scopes"
 )
 
+// ReconcileParams defines parameters for Reconcile.
+type ReconcileParams struct {
+	// Apply apply changes found by reconciliation, by default it is a dry run which only reports them
+	Apply *externalRef1.ReconcileApply `form:"apply,omitempty" json:"apply,omitempty"`
+}
+
+// DirectUploadInitiateParams defines parameters for DirectUploadInitiate.
+type DirectUploadInitiateParams struct {
+	// Filename Filename
+	Filename externalRef0.Filename `form:"filename" json:"filename" validate:"required,min=3,max=255"`
+
+	// Size size of uploading file in bytes
+	Size externalRef1.Size `form:"size" json:"size"`
+
+	// Visibility who may download uploaded file, files are public by default
+	Visibility *externalRef1.Visibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// DownloadParams defines parameters for Download.
+type DownloadParams struct {
+	// Version number of file version, the latest version is used by default even if uid belongs to an older version
+	Version *externalRef1.Version `form:"version,omitempty" json:"version,omitempty"`
+
+	// Mode download mode, proxy streams file through the service, redirect answers with 302 to presigned url of s3 object, default mode is set in config
+	Mode *externalRef1.DownloadMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// W width of image rendition in pixels, image files are resized to fit it, height is derived from aspect ratio when it is not requested, images are never enlarged
+	W *externalRef1.RenditionWidth `form:"w,omitempty" json:"w,omitempty"`
+
+	// H height of image rendition in pixels, image files are resized to fit it, width is derived from aspect ratio when it is not requested, images are never enlarged
+	H *externalRef1.RenditionHeight `form:"h,omitempty" json:"h,omitempty"`
+
+	// Fit way to fit image rendition into requested width and height, contain fits the whole image, cover fills the whole box and crops the rest around the center, contain is used by default
+	Fit *externalRef1.RenditionFit `form:"fit,omitempty" json:"fit,omitempty"`
+
+	// Format format of image rendition, png is used by default for png and gif images, jpeg is used for the rest
+	Format *externalRef1.RenditionFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Range byte ranges of file for partial download, for example "bytes=0-1023" or "bytes=0-99,-100", several ranges are returned as multipart/byteranges
+	Range *externalRef1.Range `json:"Range,omitempty"`
+
+	// IfNoneMatch ETag values of cached file, 304 is returned when one of them matches current ETag
+	IfNoneMatch *externalRef1.IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince time of cached file, 304 is returned when file was not modified since, ignored with If-None-Match
+	IfModifiedSince *externalRef1.IfModifiedSince `json:"If-Modified-Since,omitempty"`
+
+	// IfRange ETag or time of cached file, Range header is ignored and whole file is returned when it does not match
+	IfRange *externalRef1.IfRange `json:"If-Range,omitempty"`
+}
+
+// DownloadHeadParams defines parameters for DownloadHead.
+type DownloadHeadParams struct {
+	// Version number of file version, the latest version is used by default even if uid belongs to an older version
+	Version *externalRef1.Version `form:"version,omitempty" json:"version,omitempty"`
+
+	// IfNoneMatch ETag values of cached file, 304 is returned when one of them matches current ETag
+	IfNoneMatch *externalRef1.IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince time of cached file, 304 is returned when file was not modified since, ignored with If-None-Match
+	IfModifiedSince *externalRef1.IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// FilesListParams defines parameters for FilesList.
+type FilesListParams struct {
+	// Status status of listed files, only users which role lists all files may list files in statuses other than active, such files of all users are listed from the oldest
+	Status *externalRef1.FileStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// All list the latest versions of active files of all users instead of own ones, only users which role lists all files may use it
+	All *externalRef1.FilesAll `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// MultipartInitiateParams defines parameters for MultipartInitiate.
+type MultipartInitiateParams struct {
+	// Filename Filename
+	Filename externalRef0.Filename `form:"filename" json:"filename" validate:"required,min=3,max=255"`
+
+	// Visibility who may download uploaded file, files are public by default
+	Visibility *externalRef1.Visibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// TusCreateParams defines parameters for TusCreate.
+type TusCreateParams struct {
+	// TusResumable version of tus protocol used by client, must be 1.0.0
+	TusResumable *externalRef1.TusResumable `json:"Tus-Resumable,omitempty"`
+
+	// UploadLength total size of uploading file in bytes
+	UploadLength *externalRef1.UploadLength `json:"Upload-Length,omitempty"`
+
+	// UploadMetadata comma separated pairs of key and base64 encoded value, filename is taken from "filename" or "name" key, visibility of file is taken from "visibility" key
+	UploadMetadata *externalRef1.UploadMetadata `json:"Upload-Metadata,omitempty"`
+}
+
+// TusTerminateParams defines parameters for TusTerminate.
+type TusTerminateParams struct {
+	// TusResumable version of tus protocol used by client, must be 1.0.0
+	TusResumable *externalRef1.TusResumable `json:"Tus-Resumable,omitempty"`
+}
+
+// TusOffsetParams defines parameters for TusOffset.
+type TusOffsetParams struct {
+	// TusResumable version of tus protocol used by client, must be 1.0.0
+	TusResumable *externalRef1.TusResumable `json:"Tus-Resumable,omitempty"`
+}
+
+// TusPatchParams defines parameters for TusPatch.
+type TusPatchParams struct {
+	// TusResumable version of tus protocol used by client, must be 1.0.0
+	TusResumable *externalRef1.TusResumable `json:"Tus-Resumable,omitempty"`
+
+	// UploadOffset offset in bytes from which chunk in request body starts
+	UploadOffset *externalRef1.UploadOffset `json:"Upload-Offset,omitempty"`
+}
+
 // UploadParams defines parameters for Upload.
 type UploadParams struct {
 	// Filename Filename
 	Filename externalRef0.Filename `form:"filename" json:"filename" validate:"required,min=3,max=255"`
+
+	// Visibility who may download uploaded file, files are public by default
+	Visibility *externalRef1.Visibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+
+	// Digest checksums of uploading content like "SHA-256=base64,MD5=base64", upload is rejected when they do not match, unknown algorithms are ignored
+	Digest *externalRef1.Digest `json:"Digest,omitempty"`
+
+	// XChecksumSHA256 hex or base64 encoded sha-256 checksum of uploading content, upload is rejected when it does not match
+	XChecksumSHA256 *externalRef1.ChecksumSHA256 `json:"X-Checksum-SHA256,omitempty"`
+}
+
+// ShareDownloadParams defines parameters for ShareDownload.
+type ShareDownloadParams struct {
+	// XSharePassword password of share link, required only for links with password, it is passed in header to keep it out of urls in access logs and browser history
+	XSharePassword *externalRef1.SharePassword `json:"X-Share-Password,omitempty"`
+
+	// Range byte ranges of file for partial download, for example "bytes=0-1023" or "bytes=0-99,-100", several ranges are returned as multipart/byteranges
+	Range *externalRef1.Range `json:"Range,omitempty"`
+
+	// IfNoneMatch ETag values of cached file, 304 is returned when one of them matches current ETag
+	IfNoneMatch *externalRef1.IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince time of cached file, 304 is returned when file was not modified since, ignored with If-None-Match
+	IfModifiedSince *externalRef1.IfModifiedSince `json:"If-Modified-Since,omitempty"`
+
+	// IfRange ETag or time of cached file, Range header is ignored and whole file is returned when it does not match
+	IfRange *externalRef1.IfRange `json:"If-Range,omitempty"`
+}
+
+// ShareDownloadHeadParams defines parameters for ShareDownloadHead.
+type ShareDownloadHeadParams struct {
+	// XSharePassword password of share link, required only for links with password, it is passed in header to keep it out of urls in access logs and browser history
+	XSharePassword *externalRef1.SharePassword `json:"X-Share-Password,omitempty"`
+
+	// IfNoneMatch ETag values of cached file, 304 is returned when one of them matches current ETag
+	IfNoneMatch *externalRef1.IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince time of cached file, 304 is returned when file was not modified since, ignored with If-None-Match
+	IfModifiedSince *externalRef1.IfModifiedSince `json:"If-Modified-Since,omitempty"`
 }
 
+// CreateShareLinkJSONRequestBody defines body for CreateShareLink for application/json ContentType.
+type CreateShareLinkJSONRequestBody = externalRef1.ShareLinkRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/1/admin/reconcile)
+	ReconcileStatus(c *gin.Context)
+
+	// (POST /api/1/admin/reconcile)
+	Reconcile(c *gin.Context, params ReconcileParams)
+
+	// (POST /api/1/direct)
+	DirectUploadInitiate(c *gin.Context, params DirectUploadInitiateParams)
+
+	// (POST /api/1/direct/{uid}/complete)
+	DirectUploadComplete(c *gin.Context, uid externalRef1.Uid)
+
 	// (GET /api/1/download/{uid})
-	Download(c *gin.Context, uid externalRef1.Uid)
+	Download(c *gin.Context, uid externalRef1.Uid, params DownloadParams)
+
+	// (HEAD /api/1/download/{uid})
+	DownloadHead(c *gin.Context, uid externalRef1.Uid, params DownloadHeadParams)
 
 	// (OPTIONS /api/1/download/{uid})
 	DownloadOptions(c *gin.Context, uid externalRef1.Uid)
 
 	// (GET /api/1/files/list)
-	FilesList(c *gin.Context)
+	FilesList(c *gin.Context, params FilesListParams)
+
+	// (GET /api/1/files/trash)
+	TrashList(c *gin.Context)
+
+	// (DELETE /api/1/files/{uid})
+	DeleteFile(c *gin.Context, uid externalRef1.Uid)
+
+	// (POST /api/1/files/{uid}/restore)
+	RestoreFile(c *gin.Context, uid externalRef1.Uid)
+
+	// (GET /api/1/files/{uid}/shares)
+	ShareLinks(c *gin.Context, uid externalRef1.Uid)
+
+	// (POST /api/1/files/{uid}/shares)
+	CreateShareLink(c *gin.Context, uid externalRef1.Uid)
+
+	// (GET /api/1/files/{uid}/versions)
+	FileVersions(c *gin.Context, uid externalRef1.Uid)
+
+	// (POST /api/1/files/{uid}/versions/{version}/rollback)
+	RollbackFile(c *gin.Context, uid externalRef1.Uid, version externalRef1.VersionPath)
+
+	// (POST /api/1/multipart)
+	MultipartInitiate(c *gin.Context, params MultipartInitiateParams)
+
+	// (DELETE /api/1/multipart/{uid})
+	MultipartAbort(c *gin.Context, uid externalRef1.Uid)
+
+	// (GET /api/1/multipart/{uid})
+	MultipartStatus(c *gin.Context, uid externalRef1.Uid)
+
+	// (POST /api/1/multipart/{uid}/complete)
+	MultipartComplete(c *gin.Context, uid externalRef1.Uid)
+
+	// (PUT /api/1/multipart/{uid}/parts/{partNumber})
+	MultipartUploadPart(c *gin.Context, uid externalRef1.Uid, partNumber externalRef1.PartNumber)
+
+	// (DELETE /api/1/shares/{shareUid})
+	RevokeShareLink(c *gin.Context, shareUid externalRef1.ShareUid)
+
+	// (OPTIONS /api/1/tus)
+	TusOptions(c *gin.Context)
+
+	// (POST /api/1/tus)
+	TusCreate(c *gin.Context, params TusCreateParams)
+
+	// (DELETE /api/1/tus/{uid})
+	TusTerminate(c *gin.Context, uid externalRef1.Uid, params TusTerminateParams)
+
+	// (HEAD /api/1/tus/{uid})
+	TusOffset(c *gin.Context, uid externalRef1.Uid, params TusOffsetParams)
+
+	// (PATCH /api/1/tus/{uid})
+	TusPatch(c *gin.Context, uid externalRef1.Uid, params TusPatchParams)
 
 	// (POST /api/1/upload)
 	Upload(c *gin.Context, params UploadParams)
+
+	// (GET /api/1/usage)
+	Usage(c *gin.Context)
+
+	// (GET /s/{token})
+	ShareDownload(c *gin.Context, token externalRef1.ShareToken, params ShareDownloadParams)
+
+	// (HEAD /s/{token})
+	ShareDownloadHead(c *gin.Context, token externalRef1.ShareToken, params ShareDownloadHeadParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -57,17 +291,36 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// Download operation middleware
-func (siw *ServerInterfaceWrapper) Download(c *gin.Context) {
+// ReconcileStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReconcileStatus(c *gin.Context) {
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReconcileStatus(c)
+}
+
+// Reconcile operation middleware
+func (siw *ServerInterfaceWrapper) Reconcile(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "uid" -------------
-	var uid externalRef1.Uid
+	c.Set(JwtScopes, []string{})
 
-	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReconcileParams
+
+	// ------------- Optional query parameter "apply" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "apply", c.Request.URL.Query(), &params.Apply)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apply: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -78,20 +331,56 @@ func (siw *ServerInterfaceWrapper) Download(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Download(c, uid)
+	siw.Handler.Reconcile(c, params)
 }
 
-// DownloadOptions operation middleware
-func (siw *ServerInterfaceWrapper) DownloadOptions(c *gin.Context) {
+// DirectUploadInitiate operation middleware
+func (siw *ServerInterfaceWrapper) DirectUploadInitiate(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "uid" -------------
-	var uid externalRef1.Uid
+	c.Set(IntegrationsScopes, []string{})
 
-	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DirectUploadInitiateParams
+
+	// ------------- Required query parameter "filename" -------------
+
+	if paramValue := c.Query("filename"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument filename is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "filename", c.Request.URL.Query(), &params.Filename)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filename: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "size" -------------
+
+	if paramValue := c.Query("size"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument size is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "visibility" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "visibility", c.Request.URL.Query(), &params.Visibility)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter visibility: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -102,11 +391,22 @@ func (siw *ServerInterfaceWrapper) DownloadOptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DownloadOptions(c, uid)
+	siw.Handler.DirectUploadInitiate(c, params)
 }
 
-// FilesList operation middleware
-func (siw *ServerInterfaceWrapper) FilesList(c *gin.Context) {
+// DirectUploadComplete operation middleware
+func (siw *ServerInterfaceWrapper) DirectUploadComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(IntegrationsScopes, []string{})
 
@@ -119,36 +419,152 @@ func (siw *ServerInterfaceWrapper) FilesList(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.FilesList(c)
+	siw.Handler.DirectUploadComplete(c, uid)
 }
 
-// Upload operation middleware
-func (siw *ServerInterfaceWrapper) Upload(c *gin.Context) {
+// Download operation middleware
+func (siw *ServerInterfaceWrapper) Download(c *gin.Context) {
 
 	var err error
 
-	c.Set(IntegrationsScopes, []string{})
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
 
-	c.Set(JwtScopes, []string{})
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UploadParams
+	var params DownloadParams
 
-	// ------------- Required query parameter "filename" -------------
+	// ------------- Optional query parameter "version" -------------
 
-	if paramValue := c.Query("filename"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "version", c.Request.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument filename is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "filename", c.Request.URL.Query(), &params.Filename)
+	// ------------- Optional query parameter "w" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "w", c.Request.URL.Query(), &params.W)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filename: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter w: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "h" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "h", c.Request.URL.Query(), &params.H)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter h: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fit", c.Request.URL.Query(), &params.Fit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range externalRef1.Range
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Range, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Range: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch externalRef1.IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince externalRef1.IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Modified-Since, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Modified-Since: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange externalRef1.IfRange
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Range, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Range", runtime.ParamLocationHeader, valueList[0], &IfRange)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Range: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -156,7 +572,1113 @@ func (siw *ServerInterfaceWrapper) Upload(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Upload(c, params)
+	siw.Handler.Download(c, uid, params)
+}
+
+// DownloadHead operation middleware
+func (siw *ServerInterfaceWrapper) DownloadHead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadHeadParams
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", c.Request.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch externalRef1.IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince externalRef1.IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Modified-Since, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Modified-Since: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DownloadHead(c, uid, params)
+}
+
+// DownloadOptions operation middleware
+func (siw *ServerInterfaceWrapper) DownloadOptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DownloadOptions(c, uid)
+}
+
+// FilesList operation middleware
+func (siw *ServerInterfaceWrapper) FilesList(c *gin.Context) {
+
+	var err error
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FilesListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", c.Request.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter all: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FilesList(c, params)
+}
+
+// TrashList operation middleware
+func (siw *ServerInterfaceWrapper) TrashList(c *gin.Context) {
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TrashList(c)
+}
+
+// DeleteFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteFile(c, uid)
+}
+
+// RestoreFile operation middleware
+func (siw *ServerInterfaceWrapper) RestoreFile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreFile(c, uid)
+}
+
+// ShareLinks operation middleware
+func (siw *ServerInterfaceWrapper) ShareLinks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ShareLinks(c, uid)
+}
+
+// CreateShareLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateShareLink(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateShareLink(c, uid)
+}
+
+// FileVersions operation middleware
+func (siw *ServerInterfaceWrapper) FileVersions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FileVersions(c, uid)
+}
+
+// RollbackFile operation middleware
+func (siw *ServerInterfaceWrapper) RollbackFile(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version externalRef1.VersionPath
+
+	err = runtime.BindStyledParameter("simple", false, "version", c.Param("version"), &version)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RollbackFile(c, uid, version)
+}
+
+// MultipartInitiate operation middleware
+func (siw *ServerInterfaceWrapper) MultipartInitiate(c *gin.Context) {
+
+	var err error
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MultipartInitiateParams
+
+	// ------------- Required query parameter "filename" -------------
+
+	if paramValue := c.Query("filename"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument filename is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "filename", c.Request.URL.Query(), &params.Filename)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filename: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "visibility" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "visibility", c.Request.URL.Query(), &params.Visibility)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter visibility: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MultipartInitiate(c, params)
+}
+
+// MultipartAbort operation middleware
+func (siw *ServerInterfaceWrapper) MultipartAbort(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MultipartAbort(c, uid)
+}
+
+// MultipartStatus operation middleware
+func (siw *ServerInterfaceWrapper) MultipartStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MultipartStatus(c, uid)
+}
+
+// MultipartComplete operation middleware
+func (siw *ServerInterfaceWrapper) MultipartComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MultipartComplete(c, uid)
+}
+
+// MultipartUploadPart operation middleware
+func (siw *ServerInterfaceWrapper) MultipartUploadPart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "partNumber" -------------
+	var partNumber externalRef1.PartNumber
+
+	err = runtime.BindStyledParameter("simple", false, "partNumber", c.Param("partNumber"), &partNumber)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter partNumber: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MultipartUploadPart(c, uid, partNumber)
+}
+
+// RevokeShareLink operation middleware
+func (siw *ServerInterfaceWrapper) RevokeShareLink(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "shareUid" -------------
+	var shareUid externalRef1.ShareUid
+
+	err = runtime.BindStyledParameter("simple", false, "shareUid", c.Param("shareUid"), &shareUid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shareUid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeShareLink(c, shareUid)
+}
+
+// TusOptions operation middleware
+func (siw *ServerInterfaceWrapper) TusOptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TusOptions(c)
+}
+
+// TusCreate operation middleware
+func (siw *ServerInterfaceWrapper) TusCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TusCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable externalRef1.TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Tus-Resumable, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, valueList[0], &TusResumable)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Tus-Resumable: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.TusResumable = &TusResumable
+
+	}
+
+	// ------------- Optional header parameter "Upload-Length" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Length")]; found {
+		var UploadLength externalRef1.UploadLength
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Upload-Length, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upload-Length", runtime.ParamLocationHeader, valueList[0], &UploadLength)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Upload-Length: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.UploadLength = &UploadLength
+
+	}
+
+	// ------------- Optional header parameter "Upload-Metadata" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Metadata")]; found {
+		var UploadMetadata externalRef1.UploadMetadata
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Upload-Metadata, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upload-Metadata", runtime.ParamLocationHeader, valueList[0], &UploadMetadata)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Upload-Metadata: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.UploadMetadata = &UploadMetadata
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TusCreate(c, params)
+}
+
+// TusTerminate operation middleware
+func (siw *ServerInterfaceWrapper) TusTerminate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TusTerminateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable externalRef1.TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Tus-Resumable, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, valueList[0], &TusResumable)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Tus-Resumable: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.TusResumable = &TusResumable
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TusTerminate(c, uid, params)
+}
+
+// TusOffset operation middleware
+func (siw *ServerInterfaceWrapper) TusOffset(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TusOffsetParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable externalRef1.TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Tus-Resumable, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, valueList[0], &TusResumable)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Tus-Resumable: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.TusResumable = &TusResumable
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TusOffset(c, uid, params)
+}
+
+// TusPatch operation middleware
+func (siw *ServerInterfaceWrapper) TusPatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid externalRef1.Uid
+
+	err = runtime.BindStyledParameter("simple", false, "uid", c.Param("uid"), &uid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter uid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TusPatchParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Tus-Resumable" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tus-Resumable")]; found {
+		var TusResumable externalRef1.TusResumable
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Tus-Resumable, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Tus-Resumable", runtime.ParamLocationHeader, valueList[0], &TusResumable)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Tus-Resumable: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.TusResumable = &TusResumable
+
+	}
+
+	// ------------- Optional header parameter "Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Offset")]; found {
+		var UploadOffset externalRef1.UploadOffset
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Upload-Offset, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upload-Offset", runtime.ParamLocationHeader, valueList[0], &UploadOffset)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Upload-Offset: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.UploadOffset = &UploadOffset
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TusPatch(c, uid, params)
+}
+
+// Upload operation middleware
+func (siw *ServerInterfaceWrapper) Upload(c *gin.Context) {
+
+	var err error
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadParams
+
+	// ------------- Required query parameter "filename" -------------
+
+	if paramValue := c.Query("filename"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument filename is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "filename", c.Request.URL.Query(), &params.Filename)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filename: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "visibility" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "visibility", c.Request.URL.Query(), &params.Visibility)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter visibility: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Digest" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Digest")]; found {
+		var Digest externalRef1.Digest
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Digest, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Digest", runtime.ParamLocationHeader, valueList[0], &Digest)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Digest: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Digest = &Digest
+
+	}
+
+	// ------------- Optional header parameter "X-Checksum-SHA256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Checksum-SHA256")]; found {
+		var XChecksumSHA256 externalRef1.ChecksumSHA256
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Checksum-SHA256, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Checksum-SHA256", runtime.ParamLocationHeader, valueList[0], &XChecksumSHA256)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Checksum-SHA256: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XChecksumSHA256 = &XChecksumSHA256
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Upload(c, params)
+}
+
+// Usage operation middleware
+func (siw *ServerInterfaceWrapper) Usage(c *gin.Context) {
+
+	c.Set(IntegrationsScopes, []string{})
+
+	c.Set(JwtScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Usage(c)
+}
+
+// ShareDownload operation middleware
+func (siw *ServerInterfaceWrapper) ShareDownload(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token externalRef1.ShareToken
+
+	err = runtime.BindStyledParameter("simple", false, "token", c.Param("token"), &token)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ShareDownloadParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Share-Password" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Share-Password")]; found {
+		var XSharePassword externalRef1.SharePassword
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Share-Password, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Share-Password", runtime.ParamLocationHeader, valueList[0], &XSharePassword)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Share-Password: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XSharePassword = &XSharePassword
+
+	}
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range externalRef1.Range
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Range, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Range: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch externalRef1.IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince externalRef1.IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Modified-Since, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Modified-Since: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange externalRef1.IfRange
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Range, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Range", runtime.ParamLocationHeader, valueList[0], &IfRange)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Range: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ShareDownload(c, token, params)
+}
+
+// ShareDownloadHead operation middleware
+func (siw *ServerInterfaceWrapper) ShareDownloadHead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token externalRef1.ShareToken
+
+	err = runtime.BindStyledParameter("simple", false, "token", c.Param("token"), &token)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ShareDownloadHeadParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Share-Password" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Share-Password")]; found {
+		var XSharePassword externalRef1.SharePassword
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Share-Password, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Share-Password", runtime.ParamLocationHeader, valueList[0], &XSharePassword)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Share-Password: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XSharePassword = &XSharePassword
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch externalRef1.IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince externalRef1.IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Modified-Since, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Modified-Since: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ShareDownloadHead(c, token, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -186,53 +1708,248 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/1/admin/reconcile", wrapper.ReconcileStatus)
+	router.POST(options.BaseURL+"/api/1/admin/reconcile", wrapper.Reconcile)
+	router.POST(options.BaseURL+"/api/1/direct", wrapper.DirectUploadInitiate)
+	router.POST(options.BaseURL+"/api/1/direct/:uid/complete", wrapper.DirectUploadComplete)
 	router.GET(options.BaseURL+"/api/1/download/:uid", wrapper.Download)
+	router.HEAD(options.BaseURL+"/api/1/download/:uid", wrapper.DownloadHead)
 	router.OPTIONS(options.BaseURL+"/api/1/download/:uid", wrapper.DownloadOptions)
 	router.GET(options.BaseURL+"/api/1/files/list", wrapper.FilesList)
+	router.GET(options.BaseURL+"/api/1/files/trash", wrapper.TrashList)
+	router.DELETE(options.BaseURL+"/api/1/files/:uid", wrapper.DeleteFile)
+	router.POST(options.BaseURL+"/api/1/files/:uid/restore", wrapper.RestoreFile)
+	router.GET(options.BaseURL+"/api/1/files/:uid/shares", wrapper.ShareLinks)
+	router.POST(options.BaseURL+"/api/1/files/:uid/shares", wrapper.CreateShareLink)
+	router.GET(options.BaseURL+"/api/1/files/:uid/versions", wrapper.FileVersions)
+	router.POST(options.BaseURL+"/api/1/files/:uid/versions/:version/rollback", wrapper.RollbackFile)
+	router.POST(options.BaseURL+"/api/1/multipart", wrapper.MultipartInitiate)
+	router.DELETE(options.BaseURL+"/api/1/multipart/:uid", wrapper.MultipartAbort)
+	router.GET(options.BaseURL+"/api/1/multipart/:uid", wrapper.MultipartStatus)
+	router.POST(options.BaseURL+"/api/1/multipart/:uid/complete", wrapper.MultipartComplete)
+	router.PUT(options.BaseURL+"/api/1/multipart/:uid/parts/:partNumber", wrapper.MultipartUploadPart)
+	router.DELETE(options.BaseURL+"/api/1/shares/:shareUid", wrapper.RevokeShareLink)
+	router.OPTIONS(options.BaseURL+"/api/1/tus", wrapper.TusOptions)
+	router.POST(options.BaseURL+"/api/1/tus", wrapper.TusCreate)
+	router.DELETE(options.BaseURL+"/api/1/tus/:uid", wrapper.TusTerminate)
+	router.HEAD(options.BaseURL+"/api/1/tus/:uid", wrapper.TusOffset)
+	router.PATCH(options.BaseURL+"/api/1/tus/:uid", wrapper.TusPatch)
 	router.POST(options.BaseURL+"/api/1/upload", wrapper.Upload)
+	router.GET(options.BaseURL+"/api/1/usage", wrapper.Usage)
+	router.GET(options.BaseURL+"/s/:token", wrapper.ShareDownload)
+	router.HEAD(options.BaseURL+"/s/:token", wrapper.ShareDownloadHead)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZ3W4bxxV+lcG2F3awy11SkuMIyIWdxIDaGjYiCS0gCfWIOyLH4u6sZ5eSaYOFfuyk",
-	"ho2mKQq0N0EaFL2nZTGi9UO/wplX6JMUZ2Z3ufxTaNf1TXMjcHfnnDk/3/nOmdFjqyqCSIQsTGJr8bEV",
-	"UUkDljCpn7Z4g4U0YPjbZ3FV8ijhIrQWrVvZF9vi+PygyWTLsi2zeiBoW5I9aHLJfGsxkU1mW3G1zgJ6",
-	"qUb2kAZRA/XEvBqVIn/Lsq2kFek3ieRhzWrb1kNH0Ig7VeGzGgsd9jCR1EloTVu+QxvcpwlKZAbYAQ8/",
-	"nbMD+vDTysKC1W7bVpP744ag7aQZ8gdNRrjPwoRvcSbJldXVpc+vZv5GNKkP3EU9l3n6S8m2rEXrF+4g",
-	"2K75GruRFBGTSWuV+1YbjUI1LE5uCp8z7UszagiqDa2KMGFhgj8/cj+yFh/j+vEwklQF2RR+i+zypE5S",
-	"SQejSMQW0U7S0Cc8jJoJ8WlCyZYUAdlsJSzOVow5ZeyLIxHGxjZf7IZvYV22nIhtTCGTUsib1P/S2Dui",
-	"hEZRg1cpirr3Y5R/PGNMtd7PRBCI0MR02Ip5zyM3qU+ybTNLlsKEyZA2PpQdC55Hsj3JMpM7TJIvUCS3",
-	"aEWI2zRspYbGHyxAlU/IihAE9yb55plRqyFtJnUh+SPmf7iUlcnQvm1bF2r8G/4ecZNr/DLF+CRT9CLS",
-	"4AY4E4vz3S0w6i7b3qzQBdTOOEYDoxhBtMH3OYrQxl3DMJpNtmgjZrYVFV6lkm8pg7Q7zp0avUR/K9D4",
-	"gufl/M3DhNWYRnjA4pjWpmrJPhf7wUqdSUZ4TGIRsKTOwxrZlSKsTeoPktE09MPaV1oR84n5ikRnvC/u",
-	"gjD7ffZ6RG+7SIlrVupqutfAp41cUGzeZ9VkTNCoH19mYL2UsOAzEUS0mvxkXiZ0L56wwBrNWLGZz9KQ",
-	"8oaMqeIBW9GGziZ7O1vftlPP7mLDnFH6zkACIc4fzbzvMq4dNPbZ++5wckw/LwwxBR+mpWyYN94uaXlN",
-	"E8miRmti6vQPTGw8C4sVAdTOLaZS0taYt0b7JL/GoDBWTPAddOAEjqADF9CDLlFPoAOv4Qw6RO0TtQcd",
-	"ta/+CD21B12zBM5t/ekAOnAKPTgncDGsBM5zNQReqkM4hq46IGofTqGjvsZlQ/UKP5QI/EPvtA9dOLMJ",
-	"fF8i8B301R4cQQ9ekT8Q+DvKq0NcpA7gSJtzoZ7DawI9uFAHKAln0IVTs1cfjrTR58WVb6APx+op/iVX",
-	"bizdvuFUrtqk4kAXtZzAceaDTSqe9/G02XWsTsYCe3vp9heOOoAevCnEdJimCl3mJ/a5M1SDIynUkdOe",
-	"nUEffszyVEwlZogszznqKWZUfz+DnnoG3SGLym7sSBrHrOFETijkDq85PN5uxnGyw8KwxR2k/0aDbSdO",
-	"LHYkC8zbSPjbdeE7lAfUqTgVhzn8kU9DzpxZoricMsS4X3CC6VN7Q74cEXiJT4hA9bToQLlyfX7OK1+f",
-	"1K2KfDG+1z91TE6hA2fqxQBWxxhMzKJ6Yj6rAwTliDnqiUbquf7cJXjQGA5rZY7NL1z72GHXP9l0yhV/",
-	"zqHzC9ec+cq1a+X58sfznuddFp/VmMml92C1wYh6ASdYHMZaOFPfDIVwUuxGppqfO9r/vqPZVjPP+kxC",
-	"ZvXkTpiqmr0lopOs2pQ8aS3jRmn3QkRITVjxOBp/9dsVJLw+nCL8CBwjtAbc/Er3kq+gB69L6yH8Bfq6",
-	"uJGwLqCfYfNQfQ09daBeGMrSkkj6z0xX2UcyQO5HZscupMXOoa+eqRfqTwRO9SMy3LF6vrgeroeE3Lt3",
-	"b5PGdfxZ9Ym7Q6W7u7vr1mjCdmmLrDc9r3LN/CUB3Wbk/m6Syq2H2YVBnVGfycGVwe+cpUI0nBWxzcJB",
-	"DdOI/5q1MIv3d/UEuMmoZPKWkAFNTKys0TqBP8ORKVTdiUys+nnrKoT2Cg64eserJULWQ/h+NHaFuGIY",
-	"17BlZsyvvsJm15mwWW/jiouq3asl7bjGGLpjrB+4V0+SyJxteLglxqGwPEeWEyFpjZEbd5cyKOTZ65nu",
-	"TKpU1oTeKeGJuS8yUpZt7TAZG2XlUrlU1mUbsZBG3Fq05kpeaQ7phCZ1jUSXRtwtu9n1hPu4yf32BML8",
-	"IZ1AenpY0UNJNqjgSFOEVwe7TBdOCLxRe5ov8e0p9KbEzoSsxnS6sSY1MrCArc+zS5aRy5eK500r73xd",
-	"7hNGYH4WgdFLGS1XnlFu9Iw+X/lkRsnRq462bS3MbG5+c4OYMneAazkYNnTuc9aZHNs76YL/LsRTdi/e",
-	"qq5N1jZY4iLntjdsK24GAZWtcdxNnLSHsIfRSzGtp3u3kV6TjODZsOiRptZnKaL1NP7SNHwzzl6o5+pp",
-	"Tsg4LvwIx9CHVwPeHZ0JXuhh3SwZGGoTxDtSuTpMB82LTM/UCXN6XdzKL4DeJWuD66P/m8rIerJG4XA3",
-	"XttIe83aBmJvEooLeBw0jPywgCeInjmiDTKOLJ2xd7EV64wP02A/hfbF5cD6pgDtwd3bCKz/VgDZCE2b",
-	"saBQLO/bvhKZXFV9PAXDS9zCJtBV+4jvqeWDdnanmGAOu/nRZqikOvrAOlp3BN/k/Vsnjfx7769Ejzqn",
-	"ah9P4eoQzknZ84g+8L7SHGAOSX04QqcwaibnHcz3oc54Vz3VZxgdzwv1rfFUH6/1KbpAIXCO/k6r/6Kx",
-	"6TKDpx7R7KC+hfP86HaCsSlwk+mdb8ey+SiLcI9EPIFfVqO862b/kmlNL7vCf20yZLbfhZcGsj+T0luR",
-	"0qDqT4ZZqDOh6vM+owkMaUWbIncy+AxTCo6hIiRxQms8rBEW7nApwoCF2HuaspEOtfGi66bWlfR0Wtp0",
-	"JIvrJdm02vYUpQ1RpQ3Cwy1JpypDy3iVxSW9uC50oi/V57PNZm1I36Lr5tKL1z3PswpBHePQf0246UnP",
-	"Lln82xvt/wQAAP///W6MkEAeAAA=",
+	"H4sIAAAAAAAC/+x97XIbx7Xgq3Rh94eVHZAgSOqDVf4h68NWVrJVEpX4Xku1GQINYExgBp4ZkGJU3BLF",
+	"yIqXirhOZSupW5ubeJOt/QvRhAVJJPQKPa9wn2TrnO6e6Z7pAQYkLVs2/9giZqb79OnTp8/3eVCqeZ2u",
+	"51I3DEpLD0otatepj/+s2bUWveS5oe+14e86DWq+0w0dzy0t4VPHbZKu13ZqGxbBt+uk4bQp6fSCkKxQ",
+	"4tM1u+3U7ZDWyQpteD4lvYCWrFJQa9GODYPS+3an26alpVLXd9bskFrE9co4WMkqhRtdeBSEvuM2S5ub",
+	"VomGdjMLDHVDJ9wgod0kXoPDUPPckLphzmR3S4v1hbmFStVeqS2sVO1zZ1cunJu7UL8wN1eZO1dbvFC9",
+	"WzLO37aD8IZXdxoOrWfhCJ0OBQjCFiXwJungqzUbnhcE7de0bpHqHPmkFpJqZW6RVM4tVc8vVSrkwxvL",
+	"Zpg8PkEWHrte92kQwMw1n+I+hL2A9Lptz67nzD9rd53ZudmwF8zOVefpwuLZc2V6/sJKea5any/bC4tn",
+	"ywvVs2fnFubOLVQqFSNEYS+4cj+kbmCEKuh1u54PwFD5EoIIoHV9L/RqXjsHOFyF47lWSP2O4+K/8yC4",
+	"RYNex15p0ywEa9QPxI6okwJ11snKBgmov0b9HBjmZiozucv+FR953KLF5EWXnD8d38arTpvecQzE2HPq",
+	"CcmJ3bcbIfUT8qy1eu6qRbo+DagbEs9tb5CG5xPgCW0KH/A5gjzYjkogfNjr1G2GLcMx8kK7TQLnt3iY",
+	"+LvAa3ApjktWNkKaA9Jc9fzCfGXuvFVqeH7HDktLJccNzy4kUDhuSJvUV8D4pNEIaGhgcV4PkNIgdtun",
+	"dn2DBKHn0/q46RerC9Xz5ytFZt+0Sl3btzs0lPy2RWurQa9z+6OL1cWzWXBa9D7xfLJiB/TsAqFuzavT",
+	"Ogladrm6eJbIr3WMCVZjiZ+IExCffk5rsLXrLeoSJyR1jwbE9ULSscNaq2SVHD4bXAQlq+TaHQD80/Il",
+	"MUNZAGgmicXG+UZloXHWnrfPX6jatr2yUq+vnK01qufmz19YWLgwf+7c/IWzlfqCPV9dXJmrLDYoXThL",
+	"aWNhvrLQmDOSS91p0sC0QwKkwLhq0nZWKblbuv3RRUDR+xxz1o3Li+Kfd0v5iAlbdIPUvQQxFum5q663",
+	"7hK73fR8J2x1AmL7lDhNF8jirpuHusscejO+JHCfLpy/cuGLT7zVL77w1+phcN795Je3fvnx/Ce/vnzH",
+	"2/j1/Q8a51ZXehcuf3DzyvtmHHnrLizlhlc3cDz5FG4kCifeuw/07FO7E/BzFbZ8r9dsIXMA/ufUqEV8",
+	"Wnd8WguJ7Qbr1A/IuhO2yHylSkIP2YbTdIFL+G3YgWCeeCuARIvUacPutfECpIDcgIZwcmue23CaCaq+",
+	"6FF/I8EUvK3h6T/7tFFaKv2n2URKmeVPg9mu73WpH25cVhcOmIDl3A7tsBcY2DD+DsC2nSAUAktgcdbX",
+	"C3CJLafWIr7XpvhOQOx2m79GOvYG/ib+dFzCx6MB8cIWclbbJXYtdNaoRYJerSXeRCbSFhMA0cjZfa+D",
+	"GPfadRqEuYjh00yNmqsJJiRi+IBptFyVT8zTN5LHPv2i5/gg/IR+j6oA5Y6YEHvg1Loz3XojS8BW6X7Z",
+	"s7tOGbhak7plej/07XJoN3ETpRxZWooBsDqO+/681bHvv19dXIzXF1xsG6RV3DR+7YU0CLU7mG+XaaMc",
+	"NwipjbconHvPnY5SegElTv6e2m39yhdHprTUsNsBjTG04nltaru4QKchZc/bjluj+QKoIo1bZL6ywPlb",
+	"2PNdyd/gEVm3BecXo5IAhrUkS+On/Vqj/LHn0vKNcdfDtUZZglbmsJ2UdOs0YHY+eWa9V5btJlmz2z0a",
+	"FFu250oBvcPZOg1Iref7cF3AYGPWpyHhRJUKp3HLdps0Z3meT4zbit8QDigsVG6a7cJagSi5vJRGQeEr",
+	"/1qjzOE64eV2bT/8uNdZoX52xS7+DmuFt4DDdnrt0ME/YrUFoe3aYSuBVRlzHI8qwjRvJkMBtL55a0AI",
+	"JPgsiKVsEJ0BEMduE3nhWvirwBq5i98F71fKc5Xq/N0SbG7y24ULVnmuUgGxJKBr1Lfbcga4MuJdtIME",
+	"KbPwLX8pXwAZt4sqPMbd8mnNc2tOm17sdtsbBh0Tfia1Fge04fVcVKHkZw7X1+AnKRM4IRClTer+BvF7",
+	"rmCjyFd9CkpSgOczn3EiIFOyTp+6dQdAueoYBMl1ewMkmgaA1rGblMSvE8cNPQIURfHGXnfqYQvPWIs6",
+	"zVZoobRpO8BROeDi8OE48HSN+kAebfXhincfx6j5Xpf/7sO1ZPuIPvi7Rt2Q+snoThBrp2K1ufhpOOHU",
+	"ksItFT06voQmk0YZ13CA9lMYs0jXbRrg5cfDbeLCm474LrDI512avA8vSXzkr5DDdPRF8u+1dX6E22lS",
+	"u+B3wzqBOXWd+7QdWOIRv/r5WQXttR7TVGgJwnECUqe+syZlPzvognjtwymJ2bPDmXNMdGJ8PrQLnIFQ",
+	"t237TVrPRVHOJVVdqFiljuM6nV6ntDRn1IrjFf4aQDYcFlzJsREiEPvWMLJuxsh8dTJGgpbt05t2EKx7",
+	"vsHS0hVPUAdqcfEe7CryHkoMK/C7UKLkR5ZYH/xN64BDcaWHHlmltAuPvR4SYM9vo9Jh12pg1Wt7zQAP",
+	"04rvrQfUJy0HLBQb+RfBp+XbAF45XoqKkSzrx7Use6vUZM+iNZ+GJISn+rrNFzS+eOy7+XYCUQyg0fiV",
+	"gEN6rvNFjxKnTt0QBFSfvHfnzrXLZ8xwxkMeF1QYA2F0fmsQHyYbt4xqIIw1DrAidrAJtH48y2mt7aC9",
+	"SboApPXSSI7LvaCczDW1+dO074jF6Xa8d4KbfTJ2TSOy7uDrZTH2kTe9MsYQeoOGdt0ObZMptNOxSUDB",
+	"YglSUNd2fJR7V+kGZ0G6VRJVMotIuwHwt9AGToEs/m5sUJASsPj3Kt2wyJoTOCtOGxw6UrBOf568wj+6",
+	"607AWrwyM5HFcNaq7c9rlxbX//XDf3l/jOE6z2Ls4e/xVnJwuXiLpnZ4Iu4wsuKhRdn2w0lbLmab0uA8",
+	"YcfX8hwViQaGqBfvWQbriUnGo2twYTcIOB9WaNtzmwFcZLaLFi5ffprD35Kn0x0/6XVRFnbTDltFF2fm",
+	"C8nD4/EGDbiYcA1iVctDs1FsquW0Fuv7iTDV7a20nZqC9jx0JrNNDXTy6SaXCZFqP/DqDkWLXNgLLgFJ",
+	"w7+lQ3PpAepmwt85y0/Df/FqIQ3L3NZcWnoAo+kLx3HiPeEnBw8G8kY4QumDoG9IfCxTsPxi9hfG+cA0",
+	"qR9DFMjEl2U4LDE0wNsct9sLCbAPDg2HULyRhQaxFXQ9N+CY4hb0OyYIVWx9HvDTWGyf1EFvidlKm9m1",
+	"Bm2PK178A+nukMsLPTDag9RoN2lJ8SIURGVMqx6IfUrwwCWwVJWV6AHTYsT7s1qkwaaFZq9J32AgwKZV",
+	"um4HYVn1yI/7SPPeb6pek4+oXTfpfvhdjK54uUAwIJQnrvyTWvslQYV5MoSUHk7cF/oDIP1jb0wshRpQ",
+	"4uhm6neb1G5yC2HBE5ZYnnRjYwoHgmpy7Mj4qUo1kkFZxF5B1z+wCN3mOM5eSLi9cFZSmEFUUld8S3gR",
+	"DYCJJ0Z3IgLLHYoAs8KotLVfzw1/GTfiWNUXgKe+7/kfAPC4ASfGuXHcS16ngyJBZr8XKhXygV0ncloJ",
+	"ySXPbbSd2luE4wKJ55RAXPX8Fadep+7bg2KeJJNKMD70XPrWIJirEJxPTn7NDXqNhlMDPfe2oMe3BMti",
+	"5RxRpydifot80fNCG5XKgDuD6P0apXVaV8AOqe/a7bcHa4XIOcltjKciV+CTGKLrXm2V1t/aPlbnCZ/R",
+	"iu+UL3q2b7uhgxzCDZ22MMUFNdt1uV4Dj9ccvxfEYH/shVfBSv/2jsAC+dgLCZ9UQnHT3gDOuux518Hq",
+	"+fZOwzwRU5NlzyM4uRWbNAQIgvoC0nY6TsI9bqJPSFjgbaf9Fjd/rkrU2YmYXrtHwBr19sL34isGr+yP",
+	"vfC2HTpBw5Fmt7eDlrPCiwwEpgIwjXChSaR2IP2Z5Bez8AR9wPmCxC8myRC4hGXPu2G7G+JODN4ez7iA",
+	"VA5zk3hyCdQd1+6FLc8Hv8bbYwZzRJs3ASam0Bu07tjLiMq3RUaLRJmfIAAEIZBBOdedE5Sg4hHHKb74",
+	"EoblABCxv/zEgIhHHAdEOnaBGxpEGGkXTX8qcDe/FwBxVANwChhwfNOwAmSudykBRf/a9WLlVw0SSOLu",
+	"TmQRqXHHWjpCO0Q+pEceoAWHhxVokflBD51ojV6bCOkOPT/XHXf1xKCPRxwLd+I4U4EITh6KoBgYiYrJ",
+	"vUGXeOx4lgSSVAIQmkSIObgzAyITDxz0V0oNjcQG7hz1baxCLd/btFKOowkfag6tTeHiyo35FrFgMTVp",
+	"+RIpaeGIIFjSsA+GyLJwX477XA/0T75PbESTvxbvJh8nGJj8sXg3Rh5iKzBTROwSrNldG03IDrdaxEkV",
+	"KSxqmSITsJi8ezwqsNLS3oRPExs+x8BNDB00Wa24mycQTD7RNcZlYPz4KOuoxNE7WSt3b6J9W9qzkXn2",
+	"gpNUxnG0sXPDC0jZXA1H/7fgH71AmFXFaGlHQDwugFjnWondvsm9L+hiEaFsRzfnC9qr2S444mNn0soG",
+	"uXlnOfZ/gOGtF97x21w2kbI+SG8yEAWSTjaUkEOwJpfRHXLzk9v6SF6QDAXB0PDDVYe26zxQBQFqwN/o",
+	"se0qywXVoOv4NLho4Mrsj9FDNmAH0a5F2Bs2irbYazYg7BUbRY/YKHrIRuxbNiLRVrQV7bDX7BUbErbP",
+	"Xke7hL1gffZt9DDaZi/472/YAIaLtqJHrB89ix7BqwP2En/YYyO2x/rRo+hpSTGc1+2QlkOnQ02h62pc",
+	"fdGwfHwfhD+nQ6WwXuTbG/L9TavELZnS01nk60+SLyAQN96dfDJ8kF1uam/+xkaI6Oh3uBMH0Y6l7Ey0",
+	"Axt1GG2z79ghG8XYZ/scyYTtsQOxGQMSbcEwffaSvWYjdkDYm+ghG6b3cEDwk0dsxPbxNaDDZGM4WuQC",
+	"7/iGfAD2P9k+p4FcMonh6JtmI9ETsY4XycK3TcTR7eWAIGiaDdghO2T9aFcl3/5R4LqzbAJABiAViq+C",
+	"d5PYmmlCXxIv6GcipEbJGlEoVSF5SwY0CRwlG6bRpqWwhnuGXVbV00nsNMVy4Mspv6kZ86vQtEnwmZLw",
+	"slgxBF9YpQ4N5E1lGkU+VgYqLbeozxOpvA4NMfN63ffcpmnDfWoHJhsW4LxO+FO4Mvjq1VnAsPDf5M+m",
+	"UPBki8VSxVzJmrIblPqQD2/aR6CXayHtXPI6XbsWTtwXQ+SXE9JO5mIRotfFsChJX4o/QG7XplN9fTn+",
+	"AL8OManwxpRs/nL6u3f4mgFzemIXKMSGki+OyMCCqeZT8+SmZ35aMNVUEUHpgKCpI3OKs928A6cb0qY7",
+	"crHwDQYWTMnQDx6OD/+AYxkUMeupx38zhtj2fTu7Wj66aV0Zg9o0EvYEq1jmCgnt5qSVya27IgIJ9ASo",
+	"aXOSjnIgUqjTsqXEHYwLGYvNI1LJRBQ2kgoGU5y5d1boRrNv0UORsuKmj8S0jO6GHO7o3G4qUS/OW+ar",
+	"NpFX9tIdo/6RaIuN2AtQHtghG0r5+A0bRlugJ4wS8XhQWH3LXtxjIdjG2V+zgYBAFcj3uObzkL1gQ1B6",
+	"jgBDVl7QQblx7caVcvSIDdkbZWqLsBF7IxSrAXsdfQ1aRbTDXqLCLNWuvWgHtKrn8Blov+yAY3Qfn37H",
+	"huyAq9IwXvQo2oq28b+P2F60DcqGRUBpYq9BEREwGL9PgcOGXNc7ZIMEgaNoK3p619UlUMVck5Mobsz7",
+	"z+7Y39mAAwQQvmL96Akbgmaf2TWY34VA6c9KWBYBpVoRsHRPhS3+dQxQV4z1idi/sRE7jB6hoeJ19DTR",
+	"97bZATtgffIeBKKdAaw9j/4HG7BXsDuEDRHTbMCtG09YH/diCIjsk9vz5ehx9JAvCXH8FVK9UoxjQoLu",
+	"uJVcHVNFgX0D4EWPom1VYe8vkS7kgrlN8h8P/6Rqrt+xPhBPtAUGHJFvj6/sIxE8iraBPtmhRRromE9/",
+	"/4JrxBr1ACqeQqEJPLT4hXwGxG+Rbs9vGh4Ajl/hdgA6H7GB2JBR1hKRomw4O3ddlVz4aktWia8JTrsM",
+	"LBBwoXILcOikFL8/Fv3mWg3sr6zPXkhSZgNlAxDqh4ia37MhHj98hR1Y+AhO/Cs8E+xQH4QdxMMQ9hzx",
+	"NYgeJUenzw41ymLfzBD2d5xpC1BoEfa3GcL+isxvjw3Zt+S/E/YX+B6IRJjWBglTAvaIyEdO+orPNWJ7",
+	"qlEkZl9sP3oM/yXvXbx242K5esYi1TKYgYbJXcAGFqlWKudmJrCNG/XFoxzQG5cX81idfgFEvxc0BBje",
+	"j77kRAZmrOgJkBkgHxC0f4Jnddrb4qgsNy0+jGcM7ADX+y0bxZwL/n6ZsWkpRyo+GHEJKjhdKxhYMO0R",
+	"+kST1DLXQz/aQtoCS+N38qRom1mMyc7NBmXfDgLaLnfLruevOc2yE6z2giBco6674ZQdN6TtNl0Ny4G3",
+	"5tMO/7Xr1VdbXr1sOx27XC1Xy7Ts/LZuuw4tF6Hjm2OqKMA5RF72ULs0ptmPOIjcKnXs+yJhsFKpTEiW",
+	"tUrZZGtTwj37RtjxR+w5nAcuwQmLMJxquAgB68nOsD0OKIgVo+j3MYsYIMtjL/iCox2FmkTyPJIT+B41",
+	"AuI/jcHwrUkp8Oyf3PALcBPkFAN2GD2VsHHcwl3zhg3Z0LiuaFcBFxLh4cJwmzqk4vdcQG9rBpZxJxJx",
+	"B4w4esiNyTnCWx+5M8hLQyALfO1giQSrTrcrr2e4jdUBo10p01laZCW+PMLx97kckIWDizQDTR7AK5kb",
+	"wU0SnEVqbWq78n7/Fkd8IcA6xEO8jzgeWcRxG7y0lwA8edhXvgUpOjsTGyRkN4i+4kNqYoDASskqKcsG",
+	"mgP4MDWLz54iP/E0f1dbtrEO3OS7StQU+57vqxMv9GaVDGneJs4B8sJDdH1wEQF9IK9gZ1IeQNxfsVoN",
+	"+PkvquVz6x9+2rn+xY2VawuNX1Xq7uq5f+3OfXK+drG+eP/CrdXKv6xX6fJCMBZOY243+3vCjtLaYax5",
+	"RY81Lhun6uQzVGOeO/sH0ugrlG6fJsIVUjcc4eh3/DH3xqX3/ncJA2MDAknSJevY9R0VkAPqXzsBqPk9",
+	"HT1lL6R3FsX23dRFlY+73Lhe5aYUXGwLebWmWR+KWXezOklK9n6EkjQwd4IHboBc7UAxW0Rfc1EXpN79",
+	"aDt6Fn0F/1Vmj56py6oWvW9/NSa1lP1J56v476caMeQz2iWZb4rMcw/l/QOLgK+IuiFIjpKx9tke3zBx",
+	"0/EhDrnNIWcLYShv3aU+Z+SPxFuvuD72GngSV9GiL6Nti5eZENNlHhPu3dfiAPowgeaOPoC3RuyQgyTM",
+	"E+w1KKczBBzD8AYufQuZrCBCGInwW5xrLt/isy8BRfBEu7qiHYGpGMfRjq46IkpLVklDY8kqIS5KIhAw",
+	"dWXIZwaPnwiTPKKJtu40GtSnbo0GZIWG61QUiONRG9xWF4gKj3F0iePGFZxcj7sknUAUgqqrYZe8Nt56",
+	"yw7JutdrQ2I4qXsuNcSAoC5iiqtif2Ev4vMkLH8YG5D8iHEHQ7wNnwDi/wD7JkWN55LJ8KscJJpXpWyh",
+	"KKnA3xFlb00in9hOy2CgUMgseixEEXiJn+3fowKBdgvC40xioyIbah+jZCUY0xO+NhxN2DH2cKgDpFDg",
+	"gYUsySnzedqO3HGCwHGbXGeatHDFShXtaJBHjw2GQx5js0eMitRJAO/53Zbt0no+9P+uQZwBBLg33sqw",
+	"SwNpLR0if+ir0qsQPVGyyAS5sEHCeWKDF/yODGcfiFMVR6Zaue5ESCPApx1vbdz6/5EYwITSBDzahIj0",
+	"5j6XMS4yKmfvJEFPeRPk+U+fw+wWZ9acIWGTsyEvnnxaH6E51DzJ1YXMsXrHcQOs7uT3XIyDXrFrq00s",
+	"8VbKjURJB1cJQXzIKS7mAfCTEgrH9uERiMJbiVZlDlVznaA10cmhca2E4U6arpivg18LhUP/kxBMq+T3",
+	"XBdGMWkFEhCQbKMdYdo5jHaTg7hHxBkWd8EBZ7FwnxvvAixCUQxX4kph/RPCUupcyHWbaFrJMogzlacg",
+	"ZszRCzIlvL7P2Mx+Ogbz6+PHYHbs+9IhFOTozK/xJA3kFCZd/+X3tIak2txEcT63yhz7G9xPXD5OibQv",
+	"x0dY8iBKw3Kz4jLcyB37vswsOMfVD/nnnIlOxxHk0dhrTIdKsYMxIV3FncWqdaAwZdVPgKyMeM5u/o//",
+	"mB0tVqNlB/nVE9mfclXPLNokoQ+4/hYfCCP//nHzhOz2+3TNW51838D8L6IdGOpoFB1Ky9q0JRePEhvX",
+	"mxB/PcE7byKBEfdcoLFEc+D25aiIGR7xwB0LmllrNpg9lgEwNwSG142UpS57GMis0r7KTCyFiY291oNj",
+	"s9E4o89SEo/45OJ5E8qy+tikQme0bZmKWEjiNyQ8ThD4+fim9feOkyeTHwz8ownIrS8W/Qw81u96CG/s",
+	"yyjIcuDtt5S7AHlj0kxd6CP+9o8t7FcsYpr4Xz3DbcrY33TyG+Z8YR0aiyQ6Bf6ARQtFoamkuQ786Hoh",
+	"4SX99FPKq5qZzThfoUCl+nw160SupbmY/2ViqTQrCWsuIk8UgUyFZKFqmhLR+EEOUv43RPWg3+JAd6iM",
+	"vi9sVc7Nn1uYO4/FxAsgDKG/6rSLQs9GQtA6PjpFyEKRAnjqkZJV9fJizIE10VrPd4DRtWiHrwxH8+2c",
+	"5Ohf/nq5rHgqZX5Xxp3AXs7cddkfUXU5QNOj0OpgsdsgKMlYyj53OEGE1VfSzcRlHvgvxrAJM/Io+ip6",
+	"Gj3jSD1AMWs/2lm66951CfnNb36zYgct+GetTmbXbH92fX19tmmHFPoi3O1VKtWz/L+kY69S8vl6KL4b",
+	"V2r8moKN8rKQiaQw0HX+K0XL5efrKO2uUNunvgy0AFyVrKzMqLqXOK5GsTVHQe174FTBGc/MEHLXZX9L",
+	"407BK6DxM+nqQfHzS6C+vmGy4b33ZmHo2TMzd+MitahzIPTJ8lph2OUZw47b8LKkcHtelu4iF29eU5xv",
+	"icQKBF6z/aaHM4VOyBsoxQXw4runNDczNzOHl3yXunbXAff2TGVmHqOuwxZSomjqiDbJxLJmjBYBLOyx",
+	"QyGOYxBg7MJCitJCVNGbZoqR6i+pLgw+UJ4LQ5obhzxukQ3SrpUht7jzIEDYwwJWaqJ4cZM3NOhHbG+G",
+	"AHHw4B2gkSeCBp7lGhHz/UkZo4zmzcQ95RxZYGOkh6JgrMUMSbkqTCuLw1l3o2ccLEufK4nR5k4wzv5R",
+	"NeLm3BERMbDgZ/822o55z4C9nCHsa873ox2+3CF3bSJqRuh2FbFX0WOOALlTI/YKC2agYQkjgg7ML0lf",
+	"CR5I1pfLMBGXAQFcIecKdya7O+N0Q/fZDNEtxDCPQk170Vb0tcE/xWfiWIQEX577q1t8+WYOoq9lRAUH",
+	"AQxuAu/bXGXdU45CtVJVVzHi5n0jyYn3JDvK0l129IXKhRny4ZVl7mZ8gY7Gfkx40ZbYz1G0K6Met1LB",
+	"WODDe8LtFwZ79jaGTgIx8SA39fMZko42SNFmf5yHnTPWJq89ARIh3h8gnJdupWoMpcosVyuVPFk6fi9d",
+	"Twi45kJlbvJ32YJf+OV8wS+1EpYL1QsFP0uXPdu0oKxiwY/jgo+qsFJa+uwBv3I/u7cJYg321/ssvlfu",
+	"iaT5Mdgv6X1LPzMDk7wym2ogBdOmNq76jmxcpejGqYVTf+zbHfQ6HdvfSPvQ9AtnyxwHzQ4ASCFc5NX4",
+	"Zd9osU+JMx1in9hQMjNTjQNkRSYWlskTGBSr/ZGK7o4vKR6BZV6kRTi7irZVGQ0VKfmu8JDOEPbndEiI",
+	"oXQEGI7lJDy8Q1xk0a56ueLtpypuOAIbEiWmX4gM36hL3xORZY9ElAIXWABV38GvGP2tKIjitkXh5XX0",
+	"LHpiMHFv4z0HyDwkseLFBrxfBxtqNm4Z7oGX4D5n/HALW1gyQ8sz4VL4QCcKlM9T+8alNGmBz175ie8L",
+	"btAX0shucGMjHJpY9ggXua+QPImFnQG332s5SvyGmo4FNhLD38R3A+e3hd5TTEib9/K49mWl5NA11wkd",
+	"O6RHuji1JgbIC4syJqWE9vGY9lxRpp2ujftDMGH46lzhr7IVpTNcXLctfHbPmoKv/1WkcL0W8XDA8oyJ",
+	"H6nwYCM7zHD82Qc9p745KxN2jI7rgchFEz4bPTdQyfXKmbMoExMSOC7BSicncDGdMzTVHKZz1JidvU5p",
+	"v5kIT+S8f1Mlc3OIsCEiVXMoGkNtDkWRI6kImGL51WSlGcL+l9T5YoC5koUoeINit5T/jbeNxaNiE+Uy",
+	"0XnzQsXSlZlA00mZrGIrCjBk1MKyyxCpJErYwtdiZrXKkmIdyahlQptVciKkTIA5EdFOnBMBu315MTa5",
+	"8IzIbF5Enn4qQ4cRYkl5GNmn4EtLiuXXuJ58+Z3cmFR26VHuFrD+F7sALskTepQLoPdDsv6jyusLBT+L",
+	"a7r/GOT143D6P2flnZPl+MJ9zXm+UdZXnfka/+S+/sQ+3cdo+4yVNbGxKYxbmN7YKMWF+b+1ZYDdMdpm",
+	"z4XvQDAC1YSpgpicbGEfNNp9rVihkIYwwWMwyjsOSFGt+7luFZHSbzD7WyqY6ewGnqOhpxikbH6cEw7H",
+	"plpIBpkJ2n7DtSx8uqvnfg9jeTp6gmsdahjVou5N2hrid6EyP0PYHwlyc876ByRu/6Kk3qcC2J6MyVJ8",
+	"E8sVPD0PaF9CLw1qqpoIWl5yEUfb/KXkvsyhf3HN7ysgxMQdZ9eKZLlBTgYhki6ePZFxvIcAYEl9C2bm",
+	"VxkMOJSCAegfW+xVvMdD2Ce4a/p4nb9AqyFXz6WhEVBsUJexjzwbEq2DUTrHqc/tehw4fmyUfNstbv3X",
+	"+uDDkJnG/1lrJDTil1bcvGIjA6PwgmfwQHAykaHz0ZWLl3PsmtpxSCGbDRURie1JZVdq33pCaj8nA9bS",
+	"4UurhnhCtfoSaqpKKt/VTCcZS251XmwOciPMHFaC+HP5Wu54lXnh8shPt4OD8Tp6xp7zG8OcFseHVmU1",
+	"3VAc7Ua7yrfRrrLJiY1ezeXuG0vYgJyKJmXBPLOZ4S/JL29e+dAiNz/+EPD64bWrcckbjjA0wfB/kXV4",
+	"pZUF3pC9jcK8yN4WQt02t4lzQwy/IzgGxT3BJeUrn167ammJ36+iP/CCHNw4IqPRc3Sdocyy42YJjR0+",
+	"myQYJ/wVBuLdPeTldchZCC+jIpyhO+otyGt6JKn0ySJe8tS3aEt4TcTdZ96PrIELLzTuw9sSUvpLJLjH",
+	"krr3USb5NnW3SE8SG4BlRxVqMlOImTP0XhnjUpDRoVML3WtxKM/EV+tqmaIC76e6r0/zhWhhP80nV50p",
+	"3xed8wt8AqRX5EWnATcKXijFXpf3DV43xT65xYG5dyTTlySSTatUrZwt/oFs9LdpleYr1eLfxe3y8MOF",
+	"4h+q/RR/Bora3NmCX5laLKGmVxRa0arsB9IOTTpfS/QrNfOzj+j3y9OOe2SPdQ5xcadHY7wN492lbC9p",
+	"LmImbtl95Hi8PGf2o9neNB9qVgpPFYnQjCCKcQWDDWfbolVWOv/D5AUFxfc5r5ARJzZGj2N57xVKZfuJ",
+	"umwyCzwVOqZuAbUMJno5Tm5ZLKEoZ+YAA8oUhgepcfK6JChlxw69dFEFK/bjcpNC9NAaE2EiBEoI+uEB",
+	"LI+4HI3joDLK/aaZWEs9BoZXozKpRRh3hjDKpWWRMeC1Tl6n4tOKfCUqYIm95gaDraS+ExsY0QGaquJk",
+	"0HPApT6mxeUNkqg81QubL0hfjZu8HcU1Gsd0FHo7uNhuH/EKSXrRvVN3wLtskU5CbwdJrBmv9pYJM0mc",
+	"MklQsyhHZiwsM56x7WZYa+jbQasgb1WPZs74llZXVB6pdAlgEboqUvi5ISHNT6LHmaGixzOE/R/hMtJV",
+	"4kyhtGgnRp2xUBr3xin87Xm0I9gpDpOJ7Yx2xpz1ZcCiOOvHPoJHPko/5TORIYVUNFbmfBQ9AIrXRrrq",
+	"UzIW/n6Vh/mlNreAIJr0i/wZyNjvZjTg1HSbE8txwAYZXpllfkuGoKoRmirj2jhGK/okXibCOIwxERDd",
+	"LERTLYYhE3gmaj6nOaCMVBAlc/jf5qg1cfwUVx0bRF/GYlWOwG0UhtW7LOWHS6L9jSLwcYIGFKb0D7Ws",
+	"vaa0mNkIkFzo+dSY0K+Ez+jMLF3qTteNZKW9jJcXsxL+aL6spKle8TXkEFsmkj0vbSJ5kwf2Z2r+cw/G",
+	"c3QkjrKq0oig8wTeH4jygVzZiWHMWdAPTWup44AO1XHq18kHrNziVGW+gk7jVH5+94/CovIYQLpuNzjh",
+	"tHtoJ4eFYb0Cc9FkJaBaOyY8uDldYke5AdmhSIwZJlUvt5VeA7u8AQd67qQ/USnDLVqZGBX5JIPKOKwW",
+	"CN6XgeBfJ8EQRrZrKeaihD+81Ivn9K1UtLbqfIv5gwh+wwFecSepWvxkKHKZRNEWXip1aEz0NXbtEE5I",
+	"BY5Yj0HA434GfexEMOD8UDB+tQ8Jh1OL0LMM4Z28x8m4eqQinpFjKQ6S0RIRhikaAGhR35MSP6bDJWvK",
+	"nRG5vRLwma+g3U56iB+FgyotyE+j/X58/PAkL1vecymmlxJPgqdB+IFX3/g+2uAL8sj2Ur5uqEJn4X+x",
+	"sITXC0nYoh3iBMRes502+PBIzw2dNhFVk0pqBn/o9+jmsYj/lPZ/3LLAN9lsr4mVpMaFeOZICMIpGhT1",
+	"yKQvEZ4IbDIT5hcbH28s5Bnaf33LJcktGYbzndH/oencSjRRpvCC+pV6k+dfdCet4UzhXJrg8PiVpI2f",
+	"kSvi9JItZk2ZYOJVDQGTLS2SCc0+EP/anPW9dhvKCU9Ot+XWBzzPeoDms7GmGGN+ipYJKrUW5UhbPERQ",
+	"ZvzsptZptMxBxYvokYg+/dnYP6yi4Ti8Alu+uUTQwam95NRekpGR4nOlXaevtCOrsJy416y556CUSvhB",
+	"MfQSQybzngjNxxI53EYT7fLclOjZmWy+oKEHyQm5f2cI+39J7zOtGaVSbwbjwAdxzuCBfFeKCdI6oFiE",
+	"MeA5rr8KTgeeAZhYhxP/Aq7zUFoGtuPMv5gPKrH8Sqe2oUwZwAR6YMCGvHjA3haJE2f6JFWmh+c8/FPy",
+	"0jdY72VPz+yclIdqDAH5/pLfCyW1x10Ij5XRnlD7D8P7TjPSZUZ60baEZkEp3sfJ3u2YcC5CQ8lTD/dP",
+	"TmwvoqHnXVzZTPZ0O+Ax3BttxWI8zLUdxoIp3DBqIj84FMcolukWq+8iazsl0u9Vt/wmU75NozPWNxK5",
+	"kaXm89Fx9Ty+QeluiGdgkFfNQxdokrocPAt4S3pEeC4aj8Z9HGvGcLTQ0STSuXjRiqR5ywEbGmbNRDGr",
+	"KZ+vMJauH+2W4W/wzycnFvtpyAojxjgBNTuQLPI/v8Xoa14hl1clfGFKvx+hFP6ajSQLkDUiMPMRIHmF",
+	"e7NQqVhxBI3ih9OKLx0mjX159YuXCpotUYZQCW9R91uRIbmAOIrdU1qVDuM6ckp3RFs6LUxIbRQW2EyC",
+	"92mZj+M5cuJb47TGR7HUsdNaUidZYeQErhv4ZzD7oBt3NTdVF/mzqsRrBRj0bqbRlsY2ZQvSmHmKsnkF",
+	"ijcpd1jamaKNxr/MyHmE/ZFbDFUePeGmkSo/hnXHEQ5KQahxl9D3aJBMtoYzot44PnSnKzNgs05lMxnL",
+	"VxyNER1D+L35MxGA3yledtKsKO+smiwFPNpr9gH+/84EW8EtjCrQQyNOjQU/YWOBFrScKhI0yMYjT4hS",
+	"N1Tsjtt2kbhohUin1Ev9CYfYSKljqsNz1GCx6W4FeUrS2ue/x8sY02s/OXSiZVCmQ/6Ax9Znqn6JGloj",
+	"vMehlvzcTIW81wrDbrA0C6PNOB40xQm9mtdG8uh1IBiozO+MM3hNP0RGgBVXpCkb5XNseuV4Lgj4IfU7",
+	"jot/Gur26tERSTqkdAvExXxFMUWt1BA85BdgmXdOFNGcL9TKPENjKbNUV3ZT6Re2Jwe/QUO7bof2tFZ+",
+	"rXhuWgThKhKqbt/K7qiSgnJzs5d7QW5adgFuEiaf5yZmG/We5V7AY9imJm/e/ZfTThHph5MX387i78sd",
+	"MuWrzhXCC1/eD1Zzt1pUtsAC7aJGDLZuPtWzjhvrbvKiTgz5EqWvdFYabRNRND/hypPdJcu9YFkwyeOe",
+	"r3un4tMkKf6YJ+0dF7/yq/qZ+4Dl+2IgzUpRiJXr8pNGI6ChhRLCH0Td95HmP9d97opBM3XgZsjNi8uX",
+	"PuK6OkZ18XAAkQ2pX55JMb106WnRhUAP90wBK9cie/Fw46gW/6CFK3CZMIMcNSgzp1C0zHEDOeSp9nWm",
+	"CkZRqYG8Z8hiGbIXZYAZdnYPG83snLH0Zevl+JR92Bf1R4Tz2NxtXbW1DLMmceCLslposjDFEDQSpqEx",
+	"reRx+y29ah3+RoRRHncgK2wp5emiZ7xRD2+8ICsJJrvZR+eAJCodPSmzvUwUTeF/hly+cv3K8hWDDzLl",
+	"6+TSnbmmFIh2OOuJXwGVYoIhn/z0CvjpXQFH9IBg2TETnd7EJ29HE5Bkee8I5k0Q61s9nmNyJGUJF0pP",
+	"ozZzozZ/UL1lbrEwZoNet+v50Faa1h1bdmv+eWs+mfAG4RPJ9NVIBz2YTBuTVSF+nIs5mxSBqa+3gjr5",
+	"2FVj8FIcMYB1hdRyBabgd2FbNIKgl/pNxceippn1lmdq7mYr+orMYaXAHTeGmjps/sfDPxF2kLT/hVfI",
+	"XKWSF10RoxgaX/XHlNg31QYemBOHFY9aYkWzUg1iMokDe0RopsK+p9co7puSpOPuU8XjQebmucAbPcGl",
+	"iXw2YwX1vMYqPN5CB9bSzIqCGAfp8LX3anBD0foZS/YAPRQtmjWr4qMk1Xs4ZmmaVKwt0xKROSlbdoLP",
+	"LTbMxSaWyIKmPTqVqkXEU+0jDKbhZ0leXJyvLVG0xx3HcR9smTdnKGGf9B0ylqUxZJ8IxjRELeghagWw",
+	"VCOIBkXqo+Ub18upxsV9s/kbvdbdeuOMNVU4Em/lyduMRo9E9MqAqCHyg+grNYQdzyavsmgywqfKvYla",
+	"hollu/AJH45rny0plnMnqLkuQxWG+f0plNX3RZX8P6V7COaFmRlDiKYMVVLZuaYPqmf1tdIoD/Y0PSTb",
+	"yzoj+tFjctlp0iCUIamfli+1aG016HXKtz+6WF08y8vgKFgbaF2jYtxlbmAjLZ1MnwVTlO1LrZpU3FvX",
+	"2C1GVMJ/EW0D9nmNzDG7v0eCmu3ymFv1Ikh1dDZZSrQ1DUU0ocjESJxHh9zg0Y8exkuWHRIz9gf2Uh+U",
+	"J3K8tajJ5IDzWiS8U3X0NXDCPc4MX7CBMnD0ONv01+DMGpOlHbMCpZmE3rwBrpgkOyRfqDC3a0NZhTdV",
+	"OPhR5LCITorTwlHHg1wEipo44vyEH01PPU4YTu+0VeS7Gt6X51nKKD3p/reqVhXA4AWLJpgs/DwE97no",
+	"EKzJ47oQkSsjpNI3pEImjM6iptw/TG1QZJ5vbo3nMR2b8iotZ5TTVPVnrWCVbDyfNIcfpq4e0VNFaTUk",
+	"lT5hwP43scy+gbdOI2aZlCYpkmiStrzh40ZpSmLlBG3XMolxvN1M8onsK4xEEWHGjvQfbGcDONSbm/tM",
+	"NY1C1AOJdpRBppCNFyvn4pu7WKuyvNZi+YlAd/AEHYn1BrZgZT/P8rp/ibbSlCZrMGR7mCoWG6VgW7b6",
+	"Ou90Hsw+CL1V6hZrfZhfcU1Ig1K0lNVWRLk2tVmsNb5sjcg3QM06UaXzW9CNbfq1p3fn6/re/Q1LFeL7",
+	"vPNUuuUdlrhJWsfltsFDK0XmVxE8HW2prDg5fFqzbinz/l42mNXsGFxF+JIXehNmiVgefE8Ri6uVyhkr",
+	"tRJk0+9VK2fPpNfDn8xXFs6kGuJZohkdv6pwa/ZiSAZZ5hD3PdPXqtXL6cf1KvTwOlN3XJO6NyCfljFq",
+	"tXzTDoJ1z6/r8YQSOlmqI/qaveI1/+MOXzwZ503SDYAgPg+4dcJUlk8FFfovCKoUbuhMlGC2HJJ6FWZ8",
+	"pHOVWBnXBuO1kbRqgnF+kUTj7riOlHMYC4C3ttSfwNwDdkluAfinWnQ9o70OcrRXwxKwi9+EBn5y2gmF",
+	"/Y7cs+y0IZfWkOs4zYPeEX9y0Wv4Q8+lpx21jNEP2pE7Ulutn0CvrJ8qub/D1HqEWP5lEFyLkCC+LaWH",
+	"KftbcbNccksPhGjvr0lQdan54s1rxHNJENpNx20S6q45vud2qBuWrFLPb5eWSjL2Xyx/pmb7TW9mpezT",
+	"oDXj93BFxkHbXs1uE8dt+HbuYACZU6PBDL7c8oJw0nh1utJrauMtzc7GXy+dr1QqJUVJyagJ/zetA5as",
+	"EpoWl+IN3ry3+f8HAJ4uTBiYBQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -11,12 +11,102 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
+// Defines values for PropertyDownloadMode.
+const (
+	Proxy    PropertyDownloadMode = "proxy"
+	Redirect PropertyDownloadMode = "redirect"
+)
+
+// Defines values for PropertyFileStatus.
+const (
+	PropertyFileStatusActive  PropertyFileStatus = "active"
+	PropertyFileStatusDeleted PropertyFileStatus = "deleted"
+	PropertyFileStatusFailed  PropertyFileStatus = "failed"
+	PropertyFileStatusPending PropertyFileStatus = "pending"
+	PropertyFileStatusPurged  PropertyFileStatus = "purged"
+)
+
+// Defines values for PropertyMultipartStatus.
+const (
+	PropertyMultipartStatusAborted   PropertyMultipartStatus = "aborted"
+	PropertyMultipartStatusActive    PropertyMultipartStatus = "active"
+	PropertyMultipartStatusCompleted PropertyMultipartStatus = "completed"
+)
+
+// Defines values for PropertyRenditionFit.
+const (
+	Contain PropertyRenditionFit = "contain"
+	Cover   PropertyRenditionFit = "cover"
+)
+
+// Defines values for PropertyRenditionFormat.
+const (
+	Jpeg PropertyRenditionFormat = "jpeg"
+	Png  PropertyRenditionFormat = "png"
+)
+
+// Defines values for PropertyScanStatus.
+const (
+	Clean       PropertyScanStatus = "clean"
+	Infected    PropertyScanStatus = "infected"
+	Quarantined PropertyScanStatus = "quarantined"
+	Skipped     PropertyScanStatus = "skipped"
+)
+
+// Defines values for PropertyVisibility.
+const (
+	Authenticated PropertyVisibility = "authenticated"
+	Owner         PropertyVisibility = "owner"
+	Public        PropertyVisibility = "public"
+	Shared        PropertyVisibility = "shared"
+)
+
+// DirectUploadResponse slot for direct upload of file to s3 storage, file can be uploaded by PUT request to putUrl with Content-Type header or by multipart/form-data POST request to postUrl with all postFields and file field
+type DirectUploadResponse struct {
+	// ExpiresAt Время, после которого ссылки для загрузки перестают действовать
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Filename Название файла с расширением, с таким названием файл будет скачан
+	Filename PropertyFilename `json:"filename"`
+
+	// MimeType MIME-тип файла
+	MimeType PropertyMimeType `json:"mimeType"`
+
+	// ObjectPath Расположение файла на S3-хранилище
+	ObjectPath PropertyObjectPath `json:"objectPath"`
+
+	// PostFields Поля формы, которые нужно передать вместе с файлом при загрузке методом POST
+	PostFields map[string]string `json:"postFields"`
+
+	// PostUrl Адрес для загрузки файла методом POST через форму
+	PostUrl string `json:"postUrl"`
+
+	// PutUrl Временная ссылка для загрузки файла методом PUT
+	PutUrl string `json:"putUrl"`
+
+	// Size Размер файла в байтах
+	Size PropertySize `json:"size"`
+
+	// Uid Уникальный идентификатор файла в формате UUID
+	Uid PropertyUid `json:"uid"`
+}
+
 // FileItemCompact file item
 type FileItemCompact struct {
+	// CreatedAt Время создания записи о файле
+	CreatedAt *PropertyCreatedAt `json:"createdAt,omitempty"`
+
+	// DeletedAt Время удаления файла в корзину
+	DeletedAt *PropertyDeletedAt `json:"deletedAt,omitempty"`
+
+	// DetectedMimeType MIME-тип файла, определённый по первым байтам содержимого, отсутствует, если тип содержимого определить не удалось
+	DetectedMimeType *PropertyDetectedMimeType `json:"detectedMimeType,omitempty"`
+
 	// Filename Название файла с расширением, с таким названием файл будет скачан
 	Filename PropertyFilename `json:"filename"`
 
@@ -26,24 +116,48 @@ type FileItemCompact struct {
 	// ObjectPath Расположение файла на S3-хранилище
 	ObjectPath PropertyObjectPath `json:"objectPath"`
 
+	// ScanStatus Статус проверки содержимого антивирусом: skipped — не проверялось, quarantined — ожидает проверки и недоступно для скачивания, clean — угроз не найдено, infected — найдена угроза, скачивание запрещено
+	ScanStatus *PropertyScanStatus `json:"scanStatus,omitempty"`
+
 	// Size Размер файла в байтах
 	Size *PropertySize `json:"size,omitempty"`
 
+	// Status Статус файла: pending — загружается, active — доступен, failed — загрузка не удалась, deleted — удалён, purged — удалён окончательно вместе с содержимым
+	Status *PropertyFileStatus `json:"status,omitempty"`
+
 	// Uid Уникальный идентификатор файла в формате UUID
 	Uid PropertyUid `json:"uid"`
+
+	// Version Номер версии файла, новая загрузка файла с тем же именем создаёт следующую версию
+	Version *PropertyVersion `json:"version,omitempty"`
+
+	// Visibility Доступность файла для скачивания: public — всем, authenticated — авторизованным пользователям, owner — только владельцу, shared — владельцу и по ссылкам, которыми он поделился. Администраторам и интеграциям доступны все файлы
+	Visibility *PropertyVisibility `json:"visibility,omitempty"`
 }
 
 // FileItemFull file item
 type FileItemFull struct {
+	// DetectedMimeType MIME-тип файла, определённый по первым байтам содержимого, отсутствует, если тип содержимого определить не удалось
+	DetectedMimeType *PropertyDetectedMimeType `json:"detectedMimeType,omitempty"`
+
 	// Filename Название файла с расширением, с таким названием файл будет скачан
 	Filename PropertyFilename `json:"filename"`
 
+	// Md5 Контрольная сумма MD5 содержимого файла в шестнадцатеричном виде
+	Md5 *PropertyMd5 `json:"md5,omitempty"`
+
 	// MimeType MIME-тип файла
 	MimeType *PropertyMimeType `json:"mimeType,omitempty"`
 
 	// ObjectPath Расположение файла на S3-хранилище
 	ObjectPath PropertyObjectPath `json:"objectPath"`
 
+	// ScanStatus Статус проверки содержимого антивирусом: skipped — не проверялось, quarantined — ожидает проверки и недоступно для скачивания, clean — угроз не найдено, infected — найдена угроза, скачивание запрещено
+	ScanStatus *PropertyScanStatus `json:"scanStatus,omitempty"`
+
+	// Sha256 Контрольная сумма SHA-256 содержимого файла в шестнадцатеричном виде
+	Sha256 *PropertySha256 `json:"sha256,omitempty"`
+
 	// Size Размер файла в байтах
 	Size *PropertySize `json:"size,omitempty"`
 
@@ -52,6 +166,12 @@ type FileItemFull struct {
 
 	// UserId Уникальный идентификатор пользователя
 	UserId PropertyUserId `json:"userId"`
+
+	// Version Номер версии файла, новая загрузка файла с тем же именем создаёт следующую версию
+	Version *PropertyVersion `json:"version,omitempty"`
+
+	// Visibility Доступность файла для скачивания: public — всем, authenticated — авторизованным пользователям, owner — только владельцу, shared — владельцу и по ссылкам, которыми он поделился. Администраторам и интеграциям доступны все файлы
+	Visibility *PropertyVisibility `json:"visibility,omitempty"`
 }
 
 // FilesListResponse upload ok reply
@@ -59,15 +179,91 @@ type FilesListResponse struct {
 	Files []FileItemCompact `json:"files"`
 }
 
+// MultipartPart stored part of multipart upload
+type MultipartPart struct {
+	// Etag Контрольная сумма (ETag) объекта или его части на S3-хранилище
+	Etag PropertyEtag `json:"etag"`
+
+	// PartNumber Номер части многочастной загрузки
+	PartNumber PropertyPartNumber `json:"partNumber"`
+
+	// Size Размер файла в байтах
+	Size PropertySize `json:"size"`
+}
+
+// MultipartResponse multipart upload
+type MultipartResponse struct {
+	// FileUid Уникальный идентификатор файла в формате UUID
+	FileUid *PropertyUid `json:"fileUid,omitempty"`
+
+	// Filename Название файла с расширением, с таким названием файл будет скачан
+	Filename PropertyFilename `json:"filename"`
+
+	// MimeType MIME-тип файла
+	MimeType *PropertyMimeType `json:"mimeType,omitempty"`
+
+	// ObjectPath Расположение файла на S3-хранилище
+	ObjectPath PropertyObjectPath `json:"objectPath"`
+	Parts      []MultipartPart    `json:"parts"`
+
+	// Status Статус многочастной загрузки
+	Status PropertyMultipartStatus `json:"status"`
+
+	// Uid Уникальный идентификатор файла в формате UUID
+	Uid PropertyUid `json:"uid"`
+}
+
+// PropertyCreatedAt Время создания записи о файле
+type PropertyCreatedAt = time.Time
+
+// PropertyDeletedAt Время удаления файла в корзину
+type PropertyDeletedAt = time.Time
+
+// PropertyDetectedMimeType MIME-тип файла, определённый по первым байтам содержимого, отсутствует, если тип содержимого определить не удалось
+type PropertyDetectedMimeType = string
+
+// PropertyDownloadMode Режим скачивания файла
+type PropertyDownloadMode string
+
+// PropertyEtag Контрольная сумма (ETag) объекта или его части на S3-хранилище
+type PropertyEtag = string
+
+// PropertyFileStatus Статус файла: pending — загружается, active — доступен, failed — загрузка не удалась, deleted — удалён, purged — удалён окончательно вместе с содержимым
+type PropertyFileStatus string
+
 // PropertyFilename Название файла с расширением, с таким названием файл будет скачан
 type PropertyFilename = string
 
+// PropertyMd5 Контрольная сумма MD5 содержимого файла в шестнадцатеричном виде
+type PropertyMd5 = string
+
 // PropertyMimeType MIME-тип файла
 type PropertyMimeType = string
 
+// PropertyMultipartStatus Статус многочастной загрузки
+type PropertyMultipartStatus string
+
 // PropertyObjectPath Расположение файла на S3-хранилище
 type PropertyObjectPath = string
 
+// PropertyPartNumber Номер части многочастной загрузки
+type PropertyPartNumber = int
+
+// PropertyRenditionFit Способ вписать изображение в запрошенные размеры
+type PropertyRenditionFit string
+
+// PropertyRenditionFormat Формат уменьшенной копии изображения
+type PropertyRenditionFormat string
+
+// PropertyScanStatus Статус проверки содержимого антивирусом: skipped — не проверялось, quarantined — ожидает проверки и недоступно для скачивания, clean — угроз не найдено, infected — найдена угроза, скачивание запрещено
+type PropertyScanStatus string
+
+// PropertySha256 Контрольная сумма SHA-256 содержимого файла в шестнадцатеричном виде
+type PropertySha256 = string
+
+// PropertyShareToken Секретный токен ссылки на файл
+type PropertyShareToken = string
+
 // PropertySize Размер файла в байтах
 type PropertySize = int
 
@@ -77,43 +273,330 @@ type PropertyUid = string
 // PropertyUserId Уникальный идентификатор пользователя
 type PropertyUserId = int
 
+// PropertyVersion Номер версии файла, новая загрузка файла с тем же именем создаёт следующую версию
+type PropertyVersion = int
+
+// PropertyVisibility Доступность файла для скачивания: public — всем, authenticated — авторизованным пользователям, owner — только владельцу, shared — владельцу и по ссылкам, которыми он поделился. Администраторам и интеграциям доступны все файлы
+type PropertyVisibility string
+
+// ReconcileResponse differences between files and objects of s3 storage, in dry run nothing is changed and report lists what would be done
+type ReconcileResponse struct {
+	// Applied Изменения применены, иначе это пробный запуск
+	Applied bool `json:"applied"`
+
+	// FailedUploads Файлы, загрузка которых не завершилась вовремя и которые помечены неудавшимися
+	FailedUploads []PropertyUid `json:"failedUploads"`
+
+	// MissingObjects Файлы, объекты которых отсутствуют в хранилище
+	MissingObjects []PropertyUid `json:"missingObjects"`
+
+	// OrphanedObjects Объекты хранилища старше периода ожидания, на которые не ссылается ни один файл
+	OrphanedObjects []PropertyObjectPath `json:"orphanedObjects"`
+
+	// RemovedObjects Удалённые из хранилища объекты без файлов
+	RemovedObjects []PropertyObjectPath `json:"removedObjects"`
+}
+
+// ReconcileStatusResponse state of reconciliation requested by admins, it runs in background
+type ReconcileStatusResponse struct {
+	// Error Причина неудачи последней сверки
+	Error *string `json:"error,omitempty"`
+
+	// FinishedAt Время завершения последней сверки
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Report differences between files and objects of s3 storage, in dry run nothing is changed and report lists what would be done
+	Report *ReconcileResponse `json:"report,omitempty"`
+
+	// Running Сверка выполняется в данный момент
+	Running bool `json:"running"`
+
+	// StartedAt Время запуска последней сверки
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
+// ShareLinkRequest limits of share link
+type ShareLinkRequest struct {
+	// ExpiresAt Время, после которого ссылка перестаёт действовать
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// MaxDownloads Количество скачиваний, после которого ссылка перестаёт действовать
+	MaxDownloads *int `json:"maxDownloads,omitempty"`
+
+	// Password Пароль, который нужно передать при скачивании по ссылке
+	Password *string `json:"password,omitempty"`
+}
+
+// ShareLinkResponse share link of file
+type ShareLinkResponse struct {
+	// CreatedAt Время создания ссылки
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Downloads Количество скачиваний по ссылке
+	Downloads int `json:"downloads"`
+
+	// ExpiresAt Время, после которого ссылка перестаёт действовать
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// FileUid Уникальный идентификатор файла в формате UUID
+	FileUid PropertyUid `json:"fileUid"`
+
+	// HasPassword Для скачивания по ссылке нужен пароль
+	HasPassword bool `json:"hasPassword"`
+
+	// MaxDownloads Количество скачиваний, после которого ссылка перестаёт действовать
+	MaxDownloads *int `json:"maxDownloads,omitempty"`
+
+	// RevokedAt Время отзыва ссылки
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Token Секретный токен ссылки на файл
+	Token PropertyShareToken `json:"token"`
+
+	// Uid Уникальный идентификатор файла в формате UUID
+	Uid PropertyUid `json:"uid"`
+
+	// Url Адрес скачивания файла по ссылке относительно адреса сервиса
+	Url string `json:"url"`
+}
+
+// ShareLinksResponse share links of file, the last created links go first
+type ShareLinksResponse struct {
+	Links []ShareLinkResponse `json:"links"`
+}
+
 // UploadResponse file item
 type UploadResponse = FileItemFull
 
+// UsageResponse usage of storage with quota, limits of quota are absent when they are not set
+type UsageResponse struct {
+	// Bytes Общий размер файлов пользователя в байтах
+	Bytes int64 `json:"bytes"`
+
+	// Files Количество файлов пользователя
+	Files int `json:"files"`
+
+	// QuotaBytes Максимальный общий размер файлов пользователя в байтах
+	QuotaBytes *int64 `json:"quotaBytes,omitempty"`
+
+	// QuotaFiles Максимальное количество файлов пользователя
+	QuotaFiles *int64 `json:"quotaFiles,omitempty"`
+}
+
+// ChecksumSHA256 defines model for checksumSHA256.
+type ChecksumSHA256 = string
+
+// Digest defines model for digest.
+type Digest = string
+
+// DownloadMode Режим скачивания файла
+type DownloadMode = PropertyDownloadMode
+
+// FileStatus Статус файла: pending — загружается, active — доступен, failed — загрузка не удалась, deleted — удалён, purged — удалён окончательно вместе с содержимым
+type FileStatus = PropertyFileStatus
+
 // Filename Название файла с расширением, с таким названием файл будет скачан
 type Filename = PropertyFilename
 
+// FilesAll defines model for filesAll.
+type FilesAll = bool
+
+// IfModifiedSince defines model for ifModifiedSince.
+type IfModifiedSince = string
+
+// IfNoneMatch defines model for ifNoneMatch.
+type IfNoneMatch = string
+
+// IfRange defines model for ifRange.
+type IfRange = string
+
+// PartNumber Номер части многочастной загрузки
+type PartNumber = PropertyPartNumber
+
+// Range defines model for range.
+type Range = string
+
+// ReconcileApply defines model for reconcileApply.
+type ReconcileApply = bool
+
+// RenditionFit Способ вписать изображение в запрошенные размеры
+type RenditionFit = PropertyRenditionFit
+
+// RenditionFormat Формат уменьшенной копии изображения
+type RenditionFormat = PropertyRenditionFormat
+
+// RenditionHeight defines model for renditionHeight.
+type RenditionHeight = int
+
+// RenditionWidth defines model for renditionWidth.
+type RenditionWidth = int
+
+// SharePassword defines model for sharePassword.
+type SharePassword = string
+
+// ShareToken Секретный токен ссылки на файл
+type ShareToken = PropertyShareToken
+
+// ShareUid Уникальный идентификатор файла в формате UUID
+type ShareUid = PropertyUid
+
+// Size defines model for size.
+type Size = int64
+
+// TusResumable defines model for tusResumable.
+type TusResumable = string
+
 // Uid Уникальный идентификатор файла в формате UUID
 type Uid = PropertyUid
 
+// UploadLength defines model for uploadLength.
+type UploadLength = int64
+
+// UploadMetadata defines model for uploadMetadata.
+type UploadMetadata = string
+
+// UploadOffset defines model for uploadOffset.
+type UploadOffset = int64
+
+// Version Номер версии файла, новая загрузка файла с тем же именем создаёт следующую версию
+type Version = PropertyVersion
+
+// VersionPath Номер версии файла, новая загрузка файла с тем же именем создаёт следующую версию
+type VersionPath = PropertyVersion
+
+// Visibility Доступность файла для скачивания: public — всем, authenticated — авторизованным пользователям, owner — только владельцу, shared — владельцу и по ссылкам, которыми он поделился. Администраторам и интеграциям доступны все файлы
+type Visibility = PropertyVisibility
+
+// DirectUpload slot for direct upload of file to s3 storage, file can be uploaded by PUT request to putUrl with Content-Type header or by multipart/form-data POST request to postUrl with all postFields and file field
+type DirectUpload = DirectUploadResponse
+
 // FilesList upload ok reply
 type FilesList = FilesListResponse
 
+// Multipart multipart upload
+type Multipart = MultipartResponse
+
+// ReconcileStatus state of reconciliation requested by admins, it runs in background
+type ReconcileStatus = ReconcileStatusResponse
+
+// ShareLink share link of file
+type ShareLink = ShareLinkResponse
+
+// ShareLinks share links of file, the last created links go first
+type ShareLinks = ShareLinksResponse
+
 // Upload file item
 type Upload = UploadResponse
 
+// Usage usage of storage with quota, limits of quota are absent when they are not set
+type Usage = UsageResponse
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xX3W7bNhR+FYLbRVtI8U/cNPNd91MgwIIWy3K17YKx6ISNRDIklc4NPCRugm1ogd0O",
-	"A4pt2Au4Wby4sZO+wuEbDaT8I8VG56zALobeGJJ4zuF3vu87lHyAGyKRglNuNK4fYEkUSaihyt81WUw5",
-	"Sai7jqhuKCYNExzXpysBZu5+L6WqhQOcReeXFd1LmaIRrhuV0gDrxg5NiKv4oaJNXMcflKYQStmqLkkl",
-	"JFWm9WBcqN0OcMqi+UhQytleShGLKDesyahCtzY31z69PYYnidmZonN13hXYJotw24FyZag2H4uIUU9a",
-	"KmNBPNCG4IZy4y7vlO7g+oGLL6J3/aFRCbQlohZ6wswOGmWGpiUpEk3kmyQ8QozL1KCIGIKaSiRoq2Wo",
-	"HkfMNJXh01JwnWGLxBN+A3TjcCR2cTvwVOvPmTbX0omUMWsQl1R6rF3mwYJsTip+MUKZcTqrsEax29eZ",
-	"YB69/x5BVu5t22cRnoL22CWT6VgzNPlEJJI0MhxRxFwaiR9lPvGeaJJY03ldIWZoggMsc8HFqbvZjAQ4",
-	"YQn9siUXzl0fx7cDLLYe04Z55EZlweyH0wxHDXu68L4bLnY60otPXN7iX40mOXfa5Hr4JsDGMzF6OHaw",
-	"k+xBGsfv9foP9ApwqqlaWzwpi56v86jUTQUvHjA3U30y/EhRGbfmau8vnDP0Isdd/sRoTxATpUhrpu2s",
-	"+ry+Zrw0816El9CFcziFLlxCH3rIHkMXXsMAusgeIXsIXXtkf4C+PYReFgLDwC91oAsX0IchgstiERhO",
-	"yiB4ZZ/BGfRsB9kjuICu/d6F4QDTb0kiYwcYfl9C8Jvf6Qh6MAgQ/LqE4CVc2UM4hT78ib5D8LPLt89c",
-	"kO3AqYdzaZ/DawR9uLQdlwkD6MFFttcVnHrQw3zkG7iCM3viftGt+2vr98Pq7QBVQ+i5KudwNu4hQNVy",
-	"+d6SjJp4Qqw2ivHtPLHrucEsEru+tv5ZaDvQhzc5Tgtt519H/7DPw8IQX5PQM+c7G8AV/DXWKS+lUwht",
-	"LIf2xCnq1wfQtz9Cr4CoUtKhIlrTOJQhF2qfbYdM76Zam33KeYuFjBsax3TXhFrsK5pkT6WIdndEFBKW",
-	"kLAaVkMasqcR4YyGi7C4MTpiZvuCcyefPSz0corglbtzDrQn+QYq1dXacrmyOtnLwd2mKr/Z5rzPQ/jD",
-	"c3IBXRjYF1NbnTkynYr2OFu2HWfKa3DssXfq0C/3kPumLNJaXaa1uyv3Qrr60VZYqUbLIandXQlr1ZWV",
-	"Sq1yr1Yul9/Gz+bkcHw31JlH7As4d8ORoYWB/alA4Tzurn3+vH8l/t9fia4M400xx3O/wBUMvZMuM5vZ",
-	"52jmWOl+zZ2hmYkzRwtFtinK/YkM8D5VOitZWSovlb0uknIiGa7jZf8o8P/INK7zNI7bfwcAAP//Z6tl",
-	"HoUOAAA=",
+	"H4sIAAAAAAAC/+x83XIbx5Xwq3TN910k2YEJgqAossoXsmTFSpmWSj+xkygXDUwDaHMwA033kGJc3BKp",
+	"OLZX2mizlYvU1madVPYBaJqwaImEXqHnFfZJts7pnj+gBz8knbWrcsMipv/OOX3O6fPX/YnTDvuDMGCB",
+	"FM7GJ06PUY9F+G+btnvsehjIKPTht8dEO+IDycPA2cBWHnTJIPR5e9cl2NsjHe4z0o+FJC1GIrZNfe5R",
+	"yTzSYp0wYiQWzHEd0e6xPoVJ2WPaH/jM2XAGEd+mkrkkCGs4meM6cncATUJGPOg6e3uuwyTtTgLDAsnl",
+	"LpG0S8KOhqEdBpIFsmKxh86q11xu1hu01W62GnTtSmt9bXndW19eri+vtVfXGw8d6/o+FXIz9HiHM28S",
+	"Dsn7DCCQPUagJ+lj1zaF9jlB+5B5Lmksk9ttSRr15VVSX9toXN2o18lPN+/bYQr1ApPwUM+LmBCwcjti",
+	"uA8yFiQe+CH1KtZfogO+tLwkY7G03FhhzdUrazV2db1VW254KzXaXL1SazauXFluLq816/W6FSIZi3cf",
+	"SxYIK1QiHgzCCIBhaScEEUAbRKEM26FfARxiwcPAlSzq8wD/r4LgLhNxn7Z8NgnBNouE2ZHiosCdHmnt",
+	"EsGibRZVwLD8Vv2tSrR/rmeehrRZfF6Uq5fT23iT++wBtzBjzL2c5czu045kUc6e7V4cbLlkEDHBAknC",
+	"wN8lnTAioBN8BgP0GqIKtvMyiJ72fRZ0Zc8iRqGkPhH8NyhMui/oGkSFB6S1K1kFSMuNq82V+vJV1+mE",
+	"UZ9KZ8PhgbzSzKHggWRdFhXAuN3pCCYtKi6MgSgdQv2IUW+XCBlGzJu2/Gqj2bh6tT7P6nuuM6AR7TOZ",
+	"6tsea2+JuH/vvWuN1SuT4PTYYxJGpEUFu9IkLGiHHvOI6NFaY/UKSUeXKWZUjWs+ES5IxD5mbdjanR4L",
+	"CJfEC5kgQShJn8p2z3EdrleDg8BxnYD2AfCPatfNCjUDoJ0lVjtXO/Vm5wpdoVfXG5TSVsvzWlfancba",
+	"ytX1ZnN9ZW1tZf1K3WvSlcZqa7m+2mGseYWxTnOl3uwsW9nF410mbDtkQBJWrInPtxh56Nx77xqQ6G1N",
+	"OXfzxqr596FTTRjZY7vEC3PCuCQOtoJwJyDU74YRl72+IDRihHcDYIuHQRXpbmjo7fRKgfuoefXd9Ue3",
+	"w61Hj6JtT4qrwe2f3f3ZByu3P7zxINz98PE7nbWtVrx+4507775tp1G4EwAqm6Fn0XhpK5xIDCQ+fAz8",
+	"HDHaF1quZC8K424PlQPoP95mLomYxyPWloQGYodFguxw2SMr9QaRIaoN3g1AS0Q+7IBYIWELiOgSj3Vo",
+	"7OMByIC4gkmQ3HYYdHg3J9WjmEW7OaWgd4lO/z9iHWfD+X9LuZWypFvF0iAKByySuzeKiAMlAJ17kspY",
+	"WNQwfgdgfS6kMViEq1VfLBDFHm/3SBT6DPsIQn1fdyN9uovfzE8eED0fEySUPdSsNCC0Lfk2c4mI2z3T",
+	"E5WIbxYApklXj8I+Ujz0PSZkJWH0MguT5mZOiZQwesJxsmQt9uULzRF7FPOIec6GjGJ2HoBwohQccc23",
+	"GJdIY31KSSZk6cjU1LXRlQdCMoqHHohpGCy2sbFghFdvAfXLJ7ThcGejQ33BMolshaHPaIAI8k5qKt7j",
+	"QZtV24sF49klK/WmVkcyjoJUHUET2aFGUZtZiYBp3VQDaeG81al9EAastjlNm9/q1FLQahq2yzJGeQdW",
+	"14tP4Pvufdol29SPmZgP7TBI7em+1sJMkHYcRaDdYbIp+JWIcKk+AO/cpUGXVaAXRsS6rTiGaEAB0XTT",
+	"aAC4AlNq82acBHOf0Lc6NQ3XJaM7oJH8IO63WDSJcYDfAVfoBQqxH/uS44/My0BoB1T2clgLc15UpdzJ",
+	"pwJoI/vWgM1GsE1kRjFYugAIpz5Jz0cXvxqqkYc4Trxdry3XGysPHdjc/Nv6ultbrtfBihBsm0XUT1cA",
+	"DZ/tIhU5UZZgrO5UbS9M28UiPNbdilg7DNrcZ9cGA3/X4hLCZ9LuaUA7YRygx5MO49q9gk/pEc4lMCUl",
+	"XrRLojgwahT1asTApxEon9WKEwFZUHVGLPA4gHKTW+y+HboLBkgHQOvTLiNZd8IDGRLgKIYH7A73ZA9l",
+	"rMd4tyddNA4pB42qATfCh/NA6zaLgD38YmMrfIxztKNwoL9HcCzRCMkHv9sskCzKZ+cicyYNtpX06XC5",
+	"8MF+t0ieMr2M4zFxwuN34P0xirlkEHQt8GrxCLqIeJebccIlHw9Y3h86pfSoxlDDdH4k9fgSnu/hdtq8",
+	"JPhuwROU04A/Zr5wTZM++rWsgrPpZTwlXcM4XBCPRXw7NdWoGIA1HIGUZOqZa+WcMZ2ZX08dgGYgLPBp",
+	"1GVeJYkqDqlGs+46fR7wftx3NpatTmyG4YcAskVYEJMLE8QQ9u9GkR07RVYasykiejRid6gQO2FkCYwM",
+	"TAu6LD1tjUMYJD2H8jgIfDc+TzrINfjBb+YBDc2RLkOyxdgAmsMYGTCOfPQRaLsNQTg/7AoUplYU7ggW",
+	"kR6HgMJu9UHwUe0egFfLUClSZFL1Iy73wy1mCz+xdsQkkdBaxtt+QGPHC5/N93KIMgCtsaocHBIH/FHM",
+	"CPdYIMFAjciPHjy4dePHdjizKS8KKsyBMPLfWMyH2bEoq9cGc00DbJ6w1Qxev1igs+1zDA+lEfs02Ghl",
+	"x/uxqOVrLRyttO07UnGxHY8vcbMvJwxpJdYD7F4zc5970+tT4pabTFKPSmqLXPb7lAgGAUawggaUR2j3",
+	"brFdrYLKQUR0yVySuvmg3yQFTYEq/mHm/6cWsPl/i+26ZJsL3uI+5F9Sw3p8eN5FD3oYzKBahpmdyTI4",
+	"2w3/4/b11Z1f/vQXb0+JM1cFeEP8nm2lBlebtxgZhxZzhpFWiAFgGslZW25WWzA+PGPHt6vyCrkHhqQ3",
+	"/VxL9MRm47FtOLA7BHIFLeaHQVfAQUYDDEhF6dAK/Za3LiZ+aZKkgNgdKnvzImfXC3njxXRDCbiMcS1m",
+	"VS/EsFEWWdW8lvn7uTE1iFs+bxfIXkXOfLWFgc6H7mmbELn2ndDjDKOgMhbXgaXh/zT/uPEJ+mYmPbmk",
+	"peGfwrZksqZDw87GJzBbGXGcJ9sTLTkoGKgbQYTGBaG8IZlYjsHyk6WfWNeDmGFZDNEgMyNrICwZNKDb",
+	"eDCIJQH1oaHREJoek9AgtcQgDISmlA54P7BBWKTWx0JL43z7VJz0rlnN2ZvEVfihdrz0gDQ7kaInQ4ix",
+	"g9VIu8wpBP3nJGXGqyGYfYVc/3WIVNUKyX4bMqb/UqkwYM/FsNesMZi333Od96mQtWICfdqgUrJ9r5jk",
+	"eI9Rz+b74biMXBm6wDBglOeZ98vC/brhwiobIrUeLj11+X9A9A/CKaUPxfoPXg5T/7BZ7Y6OEM4pYXnk",
+	"qRxsHKOB4ZqKODIOLXJNqqBcQluYqQcVUY45TosXEh0vXEo5zGIqFTG+a5J+FsBMizX7h8Dq/B/AXFBU",
+	"Jdzfr6xWmTbjVNc3Sya9z4Uc26jz6+xsxmkKGzthOglYLovzXhoQ2YzTgBiPuesD0lQrDNBkLQJ35zsB",
+	"EGe1HWk5GLCn47ACZEF4PQelPDoIM6VdDG7n6d1LQWJs3qkntKQSZbMcMUfLQ4fDSwVgIsbgTyf2IZfl",
+	"pEGQ93mwdWnQZzNOhTsP+BSBEJcPhZgPjFw16ijGdV2iZHHDs4o1OFhMJROE4QRJ69s4xtlSzUIyx6xC",
+	"7Uw9CNJ+e+5YwGPGwFIgZs+EZipLi0wOM+OmUlleAewLgOCmDikY0DUTdps2vFxPlo/PbZvZo03ffHBO",
+	"gdmDTd+MeEgtYeeILJTVpgOKrg/Xp21WuzdGxVJB4gwq5n0vxgV6cKEmcMbQ3PfUFLiDKW+btaXDE8Io",
+	"eTezu6YV+n3/OOu8zBFfrncWz/TLUj8MlWcsaJdd3uIw29S1oQNytjanMG5r9EcsjDtgZht3YLN5AURP",
+	"532of0dHDTA0YFKw53dDDe+1aQAB5CwI0toldx7cz/x2MBhj+SDytW2SGsD3wXk3CRSobdwtpMrBC6qh",
+	"G3/n9r3yTKHIp4IiHvhwkzPf0wkWnd2H3xhpHBTQBdt4wCMmrlm0svr35IkaqtPkhUvUGzVK9tVrNSTq",
+	"lRolB2qUPFEj9bUakWQ/2U+eqdfqlToh6li9Tl4Q9VIdqq+TJ8lT9VJ/f6OGMF2ynxyow+T3yQF0Hapv",
+	"8cORGqkjdZgcJM+dgsPnUclqkvctxe/l8q3Fiq0gvNhn93cHc4/dTPvvuY62wNMI3Tyjb+cj9lwn351q",
+	"NvxkEt2xvflSjZDQyW9xJ06TZ25hZ5JnsFFnyVP1jTpTo4z66lgTmagjdWo2Y0iSfZjmUH2rXquROiXq",
+	"TfJEnYzv4ZDgkAM1UsfYDfgw3xhNlhTBB5Gljk39mzrWPFDJJhkch7bVSPKZweNljvhTG3MM4goQDE+r",
+	"oTpTZ+oweVFk38PzwPXgvg2ANHE2V14Q+uY5oUVSNnn07lcmFVQoTixwaoHl3TQRZ2iUb1iJN92Cavi1",
+	"ZZdhnVuS9a+H/QFty2petqpUfUBL1p9QSObIvibnJcX1bABKic8WGn0jG4CjJdY8by6oHm6Mj/sBqyfR",
+	"pkHuT87FvvmIczK+WGi9Yhnv4kJTSh4tlAEZT4AsnImYX1ynCdzN2PcvTdq+Nxzvrc7N7N7qD15GetTc",
+	"aZmzbAR6/50OFTDoWXRr/kG69/dNrgwSiwpYOcK5mJRlXhFEvrDGsyxrOD/8A5Io5om3Fs/XvQxiGkV0",
+	"Em09uw2viUjnIq7PjHDlOI7phdR59vBdk5koV1QvWuR8HqkYI12p/NoYR4jIVGqek0tmkrCT32BcQGh/",
+	"sN4QxuPnFYqx8Pq4SCxqSWym053fnFjIBs/uLWmsbew1adVO8ctJsq9G6iV4depMnaSOyxt1kuyDAzfK",
+	"/Zbh3H71pGU8FYKnuPprNTQQFD2lI+2SPlEv1Ql4o+eAYdI8KYOyeWvz3VpyoE7Um8LSLlEj9cZ4vEP1",
+	"OvkDuHvJM/UtRjJSf/goeQbu7lcwDMIS6lRT9Bhbv1En6lTHOGC+5CDZT57i3wN1lDwFL9Al4M2q1+Ah",
+	"Ghis48fAUSfaCT9Tw5yAo2Q/eY4RmjxfWYyjDbzOVFJNvfCo/qKGGiCA8JU6TD5TJxBymdg1WD+Ayqtf",
+	"OXgtEktETAb010XYsq9TgHrX+j6B+g81UmfJAUaQXifPc0f8qTpVp+qQ/Agy2z8Gqn2V/IsaqlewO0Sd",
+	"IKXVUIedPlOHuBcnQMhDcm+llnyaPNEoIY2/QK4vXMadceNnGiY3p9yiVH8F8JKD5GkxknK4QQYswBLJ",
+	"/3nyx2JI4Rt1CMyT7ENkzVzgwy7HyAQHyVPgT3Xmkg7lPvPGx7/UoYoS9wApnrvE+L84Im0D5nfJII66",
+	"lgag8SvcDiDngRqaDRlNhojGOBtk52FQZBeNreM6GieQdoTfyfxyjDoAHGVWyvpPJb/9rqb6szpUL1NW",
+	"VsPCBiDUT5A0n6sTFD/sok5dbAKJf4Uyoc7Kk6jTbBqivkJ6DZODXHQO1VmJs9Rf3yLqL7jSPpDQJerL",
+	"t4j6Myq/I3Wivib/TNSfYDwwiYl5DnOlBOoRiY+a9JVea6SOitGqTH2p4+RT+Et+dO3W5rVa48cuadQg",
+	"PneSnwVq6JJGvb721gy1semtWmg6U0A3b6xWqbryAZB8bngIKHyc/E4zGcQXk8+AzYD4QKDjS5TVRU+L",
+	"86rccfNhumJQp4jv12qUaS74/e1EsLEgUplgZE9QgHS18OWMRUXodslSmzgeDpN95C0IAX+TSkppM+dT",
+	"sstLohZRIZhfG9SCMNrm3RoXW7EQcpsFwS6v8UAy32dbsibC7Yj19ddB6G31Qq9GeZ/WGrVGjdX4bzwa",
+	"cFabh4/vTLmWCXKIuuxJ6dBYZD+yqjTX6dPH5gZCvV6fcfvGdSZvb9lu8Km/mgTLSH0F8qAtOBOqB6mG",
+	"gxConu+MOtKAglkxSj7PVMQQVZ56qRFOnhW4ydzGQ3aCpHCJgfSnKRS+O+tOnfpvHZEHuAlqiqE6S56n",
+	"sGnawlnzRp2oEyteyYsCuHCzznGdQdAtQ2q+VwJ6rxSdmSaRSDtQxMkTHeWvMN4OUTuDvXQCbIHdTjeI",
+	"2OKDQXo8w2lcnDB5kdp0LnkU04gGkgdp5xHOf6ztgEk4tEkzLNkDeCTr7ITNgnNJG26Lpuf71zjjSwPW",
+	"GQrxMdJ45BIedPTTHgbwvPGwMBas6MmV1DBnu2HyhZ6yZAYYqjiuU0AbeA7gw1pvvfoY+5nW6l3NYmaL",
+	"nlXmTZHv+Ly69IdeXMdyb8ymOcBeeII5KW0iYHLqFezMWGoW99dgWwJ+5VGjtrbz04/67z/abN1qdn5e",
+	"94KttV8Olm9fbV/zVh+v392q/2Knwe43xVQ4rZfF1F9ydTTuHWaeV/JpSctmtb/VCtV6cU79DXn0FVq3",
+	"z3PjCrkbRDj5rW7WadLxvf9trsDUkMCtK8e98PtOBZCzwOrFoNbndPJcvUzT5mi2vxg7qKppV/ksV+Gk",
+	"NFpsH3V1ybM+M6u+mPRJxmzvA7SkQbkTFLgharXTQtgi+YM2dcHqPU6eJr9PvoC/hdWT3xfRasx73v58",
+	"yl0V9ceyXsX/n5eYoVrRbqQXWFB5HqG9f+oSGsseCyRYjqliPVRHesPMSaenONMxh4othKnCnYBFWpEf",
+	"mF6vtD/2GnSSdtGS3yVPXX1v1Sw30Ux02UWpQOMQFijVCZxCr5E60yCZ8IR6Dc7pWwQy9tADUd9HJWuY",
+	"EGYi+hTXnsvX2PY7IBG0lI6u5JmhVEbj5FnZdUSSOq5TIqPjOkgLx1Rojh0ZaduEvGX1q+cM0Xq802ER",
+	"C9pMkBaTO8y8OKPLaXSsTpgXnrKyHx5kT0IEocTHILkwL0t4xXpY/djOTo9KshPGPtw0I14YMEtxDvoi",
+	"toI39Sf1MpMnE/nDoo38IxaEnOBp+BkQ/l9h31JT46tUyeijHCyaV87kyxOpA//APHtnM/nMdrqWAEWB",
+	"zZJPjSkCnbRsf44OBMYtiC4AyoKK6qQ0GC0ro5g+07jhbCaOcYRTnSKHgg6cK5I8Fj4fjyP3uRA86Gqf",
+	"aRbihShV8qwEefKpJXCoi5+OiNWRugzgw2jQowHzqqH/rxLEE4CA9sZTGXZpmEZLT1A/HBatV2N6omUx",
+	"UX2khrnmyQJe8B0VzjEwZ9EcWQjzchJhnAAR64fb0/D/Wx4AM04T6GgbIcY396u0+Cgtlzq6TNDHsgmp",
+	"/I/L4eQWT+A8wcK2ZENVof+iOUL7HYD88k9rl1CvzwOBz0VEcYAF6i3a3urimzGTacQoCm2O/JfGED/R",
+	"HJfpAPhUqFFUx9AEpvB+7lXZawgDLnozkxwlrZUr3FnLzZfr0MfC3Hcy8tpY14niIIBZbF5BCghYtskz",
+	"E9o5S17kgnhEjAybs+BUq1g4z61nAd5qnY9W5khRh5dEpTG5SPG28XTh+gey34LM7PM+N4d78U2Q77Jo",
+	"9nC8OPYPFy+O7dPHaUJIVPjMr1GShukSNl//2+8Ih/z5mpnmfOWzNepLOJ+0fTxm0n47vfRVV7da0J00",
+	"l+FE7tPH6ZWPNe1+pD+XbXw6jSHPp14zPizcnpxSMzl/srgYHZibs7xLYCsrnSc3//svZuer1ehRUf0c",
+	"k/pjpes5SbaU0Yfaf8sEwqq/v986YXL7I7Ydbs0+b2D9l8kzmOp8HC3TyNqibzidq7huRmH8jOy8jQVG",
+	"OnOBwZJSAvcwnRUpoysedGKhFNZaEksXCgBWlsDoh6jSt7NirDAv8n5RmbgFJTb1WBcXVqPZVUu3cCNM",
+	"L27au/DOWyTkhKL10zuic1n8lpuoMwx+Pb8N//giF5j+Uf/7j/rff9T/zqr/LV89XLD2d/xWIl7GexSH",
+	"krok9ynwA76CZF6uyB/Xh49BKIl+I6gspfqZFHsY5ws0qIo531J0ojLSPF/+ZebbK25e1jyPPTEPZEVI",
+	"mg3bkkjGdyqI8p9Q1YN5i9NyQmX0XVGrvray1ly+iq+TzkEwhP4m9+eFXo2MoXVxcpqShXle1CmKVPpM",
+	"T1WNOfTnQSes4IJTBE1Hpe3xRox9Sy59ZmrOQYRy8XcKysW8qQhafMACOuCQv8RPLj6BJpyNIPb9vf8d",
+	"AK6MpXnLaQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file